@@ -1,2 +1,11 @@
 # Query all Members in Account
 data "prefect_account_members" "all_members" {}
+
+# Query only the Admins of the Account
+data "prefect_account_role" "admin" {
+  name = "Admin"
+}
+
+data "prefect_account_members" "admins" {
+  account_role_id = data.prefect_account_role.admin.id
+}
@@ -0,0 +1,8 @@
+data "prefect_artifact" "existing_by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Returns the most recent artifact with this key
+data "prefect_artifact" "latest_report" {
+  key = "daily-report"
+}
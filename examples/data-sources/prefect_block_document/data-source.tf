@@ -0,0 +1,4 @@
+data "prefect_block_document" "flow_results" {
+  name            = "flow-results"
+  block_type_slug = "s3-bucket"
+}
@@ -0,0 +1,15 @@
+# Get the latest schema of a block type by slug
+data "prefect_block_schema" "s3_bucket" {
+  block_type_slug = "s3-bucket"
+}
+
+# Get a specific version of the schema of a block type
+data "prefect_block_schema" "s3_bucket_v2" {
+  block_type_slug = "s3-bucket"
+  version         = "2.20.0"
+}
+
+# List the required fields of the block type
+output "s3_bucket_required_fields" {
+  value = try(jsondecode(data.prefect_block_schema.s3_bucket.fields).required, [])
+}
@@ -0,0 +1,4 @@
+# Get the latest schema of a block type by slug
+data "prefect_block_type" "s3_bucket" {
+  slug = "s3-bucket"
+}
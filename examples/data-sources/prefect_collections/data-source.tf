@@ -0,0 +1,11 @@
+# Get all Collections
+data "prefect_collections" "all" {}
+
+# Get a single Collection by name
+data "prefect_collections" "aws" {
+  name = "prefect-aws"
+}
+
+output "prefect_aws_version" {
+  value = data.prefect_collections.aws.collections[0].latest_version
+}
@@ -0,0 +1,14 @@
+# Discover the account of the API key, such as a Service Account key
+data "prefect_current_account" "current" {}
+
+data "prefect_account" "my_organization" {
+  id = data.prefect_current_account.current.id
+}
+
+# Look up a workspace when the API key has access to several
+locals {
+  production_workspace_id = one([
+    for workspace in data.prefect_current_account.current.workspaces : workspace.id
+    if workspace.handle == "production"
+  ])
+}
@@ -0,0 +1,9 @@
+data "prefect_deployment" "existing_by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Deployment names are unique within a flow
+data "prefect_deployment" "existing_by_name" {
+  flow_name = "my-flow"
+  name      = "production"
+}
@@ -0,0 +1,9 @@
+# Get a flow by name, for example to create a deployment of it
+data "prefect_flow" "etl" {
+  name = "my-etl-flow"
+}
+
+resource "prefect_deployment" "etl" {
+  name    = "production"
+  flow_id = data.prefect_flow.etl.id
+}
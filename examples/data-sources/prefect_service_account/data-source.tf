@@ -7,3 +7,13 @@ data "prefect_service_account" "bot" {
 data "prefect_service_account" "bot" {
   name = "my-bot-name"
 }
+
+# Reference a service account by name,
+# e.g. to grant it access to a workspace
+data "prefect_service_account" "ci" {
+  name = "github-ci"
+}
+
+output "ci_service_account_id" {
+  value = data.prefect_service_account.ci.id
+}
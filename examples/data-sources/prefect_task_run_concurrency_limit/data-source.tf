@@ -0,0 +1,8 @@
+data "prefect_task_run_concurrency_limit" "database" {
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+  tag          = "database"
+}
+
+output "database_slots_in_use" {
+  value = "${length(data.prefect_task_run_concurrency_limit.database.active_slots)}/${data.prefect_task_run_concurrency_limit.database.concurrency_limit}"
+}
@@ -0,0 +1,7 @@
+# Get all Variables in the Workspace
+data "prefect_variables" "all" {}
+
+# Get the Variables that have all of the given tags
+data "prefect_variables" "production" {
+  tags = ["env:production"]
+}
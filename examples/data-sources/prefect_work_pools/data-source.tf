@@ -1,2 +1,7 @@
 # Query all Work Pools in Account/Workspace
 data "prefect_work_pools" "all_pools" {}
+
+# Query only the Kubernetes Work Pools in Account/Workspace
+data "prefect_work_pools" "kubernetes_pools" {
+  type = "kubernetes"
+}
@@ -0,0 +1,11 @@
+# Query all Work Queues of a Work Pool
+data "prefect_work_queues" "kubernetes" {
+  work_pool_name = "my-kubernetes-pool"
+}
+
+# Map each Work Queue to its concurrency limit
+output "work_queue_concurrency_limits" {
+  value = {
+    for queue in data.prefect_work_queues.kubernetes.work_queues : queue.name => queue.concurrency_limit
+  }
+}
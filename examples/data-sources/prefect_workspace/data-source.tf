@@ -1,3 +1,20 @@
+# Get workspace by ID
 data "prefect_workspace" "production_environment" {
   id = "00000000-0000-0000-0000-000000000000"
 }
+
+# Get workspace by handle
+data "prefect_workspace" "staging_environment" {
+  handle = "staging"
+}
+
+# Get workspace by name
+data "prefect_workspace" "development_environment" {
+  name = "Development"
+}
+
+# Get workspace by handle in another account, referenced by its handle
+data "prefect_workspace" "partner_environment" {
+  handle         = "production"
+  account_handle = "partner-account"
+}
@@ -0,0 +1,15 @@
+# Read down all Workspace Roles of the account
+data "prefect_workspace_roles" "all" {}
+
+# Read down a single Workspace Role by name
+data "prefect_workspace_roles" "developer" {
+  name = "Developer"
+}
+
+# Grant a service account the Developer role without hardcoding its ID
+resource "prefect_workspace_access" "example" {
+  accessor_type     = "SERVICE_ACCOUNT"
+  accessor_id       = prefect_service_account.example.id
+  workspace_id      = "00000000-0000-0000-0000-000000000000"
+  workspace_role_id = data.prefect_workspace_roles.developer.workspace_roles[0].id
+}
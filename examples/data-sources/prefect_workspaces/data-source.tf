@@ -0,0 +1,24 @@
+# Query all Workspaces in Account
+data "prefect_workspaces" "all_workspaces" {}
+
+# Query Workspaces whose handle starts with "staging-"
+data "prefect_workspaces" "staging_workspaces" {
+  filter {
+    handle = "staging-"
+  }
+}
+
+# Query Workspaces tagged with both "team:data" and "env:production"
+data "prefect_workspaces" "data_team_production" {
+  filter {
+    tags = ["team:data", "env:production"]
+  }
+}
+
+# Import all Workspaces in Account, to adopt them into Terraform
+# (see the "Importing existing resources" guide)
+import {
+  for_each = { for workspace in data.prefect_workspaces.all_workspaces.workspaces : workspace.handle => workspace }
+  to       = prefect_workspace.adopted[each.key]
+  id       = each.value.id
+}
@@ -16,9 +16,23 @@ provider "prefect" {
 
 # You can also pass in your API key and account ID
 # implicitly via environment variables, such as
-# PREFECT_CLOUD_API_KEY and PREFECT_CLOUD_ACCOUNT_ID.
+# PREFECT_API_KEY and PREFECT_CLOUD_ACCOUNT_ID.
 provider "prefect" {}
 
+# If your API key is mounted as a file, such as a Kubernetes
+# secret in CI, the provider can read the key from that file.
+provider "prefect" {
+  api_key_file = "/var/run/secrets/prefect/api-key"
+  account_id   = var.prefect_account_id
+}
+
+# If your automation is issued short-lived bearer tokens, such as
+# by a token-vending service, pass the token instead of an API key.
+provider "prefect" {
+  auth_token = var.prefect_auth_token
+  account_id = var.prefect_account_id
+}
+
 # You also have the option to link the provider instance
 # to your specific workspace, if this fits your use case.
 provider "prefect" {
@@ -27,6 +41,47 @@ provider "prefect" {
   workspace_id = var.prefect_workspace_id
 }
 
+# If your traffic is routed through an authenticating proxy,
+# you can attach custom headers to every request.
+provider "prefect" {
+  api_key    = var.prefect_api_key
+  account_id = var.prefect_account_id
+  headers = {
+    "X-Proxy-Token" = var.proxy_token
+  }
+}
+
+# If a Prefect server is fronted by an API gateway that serves it
+# under a custom path prefix, set the prefix with base_path.
+provider "prefect" {
+  endpoint  = "https://gw.internal"
+  base_path = "/prefect/api"
+}
+
+# To tag the provider's traffic in your own logs, append
+# a suffix to the User-Agent header sent with every request.
+provider "prefect" {
+  api_key           = var.prefect_api_key
+  account_id        = var.prefect_account_id
+  user_agent_suffix = "data-platform-ci"
+}
+
+# To fail fast on a misconfigured account, verify that the
+# account exists and that the API Key has access to it.
+provider "prefect" {
+  api_key        = var.prefect_api_key
+  account_id     = var.prefect_account_id
+  verify_account = true
+}
+
+# To keep the behavior of the API stable across Prefect upgrades,
+# pin the API version requested with every request.
+provider "prefect" {
+  api_key     = var.prefect_api_key
+  account_id  = var.prefect_account_id
+  api_version = "0.8.4"
+}
+
 # Finally, in rare occasions, you also have the option
 # to point the provider to a locally running Prefect Server,
 # with a limited set of functionality from the provider.
@@ -0,0 +1,2 @@
+# Prefect Account Members can be imported using the account membership's UUID
+terraform import prefect_account_member.example 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,9 @@
+data "prefect_account_role" "member" {
+  name = "Member"
+}
+
+# Invite a user to the account with the default Member role
+resource "prefect_account_member" "contractor" {
+  email           = "contractor@example.com"
+  account_role_id = data.prefect_account_role.member.id
+}
@@ -0,0 +1,2 @@
+# Prefect Account Roles can be imported using the account role's UUID
+terraform import prefect_account_role.example 00000000-0000-0000-0000-000000000000
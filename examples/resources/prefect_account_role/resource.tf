@@ -0,0 +1,8 @@
+resource "prefect_account_role" "example" {
+  name        = "Workspace Viewer"
+  description = "Can see the workspaces of the account"
+  permissions = [
+    "see_workspaces",
+    "see_members"
+  ]
+}
@@ -0,0 +1,49 @@
+resource "prefect_automation" "notify_on_failure" {
+  name        = "notify-on-failure"
+  description = "Notify the on-call channel when a flow run fails"
+  enabled     = true
+
+  trigger = jsonencode({
+    type    = "event"
+    posture = "Reactive"
+    match = {
+      "prefect.resource.id" = "prefect.flow-run.*"
+    }
+    expect    = ["prefect.flow-run.Failed"]
+    threshold = 1
+    within    = 0
+  })
+
+  actions = jsonencode([
+    {
+      type              = "send-notification"
+      block_document_id = prefect_block_document.slack.id
+      subject           = "Flow run failed"
+      body              = "{{ flow_run.name }} failed"
+    }
+  ])
+}
+
+# Common trigger types can also be configured with typed attributes,
+# which are validated at plan time
+resource "prefect_automation" "late_deployments" {
+  name = "late-deployments"
+
+  metric_trigger = {
+    match = jsonencode({
+      "prefect.resource.id" = "prefect.deployment.*"
+    })
+    metric    = "lateness"
+    operator  = ">"
+    threshold = 600
+  }
+
+  actions = jsonencode([
+    {
+      type              = "send-notification"
+      block_document_id = prefect_block_document.slack.id
+      subject           = "Deployment is running late"
+      body              = "{{ deployment.name }} is more than 10 minutes late"
+    }
+  ])
+}
@@ -0,0 +1,20 @@
+resource "prefect_block_document" "example" {
+  name            = "my-secret"
+  block_type_slug = "secret"
+  data = jsonencode({
+    value = var.secret_value
+  })
+}
+
+# Blocks can hold credentials for storage, such as an S3 bucket
+resource "prefect_block_document" "s3_example" {
+  name            = "flow-results"
+  block_type_slug = "s3-bucket"
+  data = jsonencode({
+    bucket_name = "my-flow-results"
+    credentials = {
+      aws_access_key_id     = var.aws_access_key_id
+      aws_secret_access_key = var.aws_secret_access_key
+    }
+  })
+}
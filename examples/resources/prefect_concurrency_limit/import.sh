@@ -0,0 +1,11 @@
+# Prefect Concurrency Limits can be imported using the format `workspace_id,id`
+terraform import prefect_concurrency_limit.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# Prefect Concurrency Limits can also be imported via tag in the form `tag/name_of_tag`
+terraform import prefect_concurrency_limit.example 00000000-0000-0000-0000-000000000000,tag/name_of_tag
+
+# You can also import by id or tag only if you have a workspace_id set in your provider
+terraform import prefect_concurrency_limit.example 11111111-1111-1111-1111-111111111111
+
+# Prefect Concurrency Limits can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_concurrency_limit.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
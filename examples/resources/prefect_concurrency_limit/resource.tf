@@ -0,0 +1,4 @@
+resource "prefect_concurrency_limit" "database" {
+  tag               = "database"
+  concurrency_limit = 10
+}
@@ -0,0 +1,12 @@
+resource "prefect_deployment" "example" {
+  name            = "nightly-etl"
+  flow_id         = "00000000-0000-0000-0000-000000000000"
+  work_pool_name  = "kubernetes-work-pool"
+  work_queue_name = "default"
+  parameters = jsonencode({
+    source  = "s3://my-bucket/raw"
+    retries = 3
+  })
+  tags   = ["etl", "nightly"]
+  paused = false
+}
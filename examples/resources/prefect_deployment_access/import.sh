@@ -0,0 +1,6 @@
+# Prefect Deployment Access can be imported using the deployment ID
+# and the ID of the actor or team, separated by a comma
+terraform import prefect_deployment_access.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# Prefect Deployment Access can also be imported using the composite format `account_id/workspace_id/deployment_id/id`
+terraform import prefect_deployment_access.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222/33333333-3333-3333-3333-333333333333
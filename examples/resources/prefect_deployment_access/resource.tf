@@ -0,0 +1,25 @@
+resource "prefect_deployment" "etl" {
+  name         = "nightly-etl"
+  flow_id      = "00000000-0000-0000-0000-000000000000"
+  workspace_id = "11111111-1111-1111-1111-111111111111"
+}
+
+# GRANTING DEPLOYMENT ACCESS TO A SERVICE ACCOUNT
+resource "prefect_service_account" "bot" {
+  name = "a-cool-bot"
+}
+
+resource "prefect_deployment_access" "bot_run" {
+  deployment_id = prefect_deployment.etl.id
+  workspace_id  = "11111111-1111-1111-1111-111111111111"
+  actor_id      = prefect_service_account.bot.actor_id
+  role          = "run"
+}
+
+# GRANTING DEPLOYMENT ACCESS TO A TEAM
+resource "prefect_deployment_access" "team_manage" {
+  deployment_id = prefect_deployment.etl.id
+  workspace_id  = "11111111-1111-1111-1111-111111111111"
+  team_id       = "22222222-2222-2222-2222-222222222222"
+  role          = "manage"
+}
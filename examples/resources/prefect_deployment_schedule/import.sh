@@ -0,0 +1,8 @@
+# Prefect Deployment Schedules can be imported using the format `workspace_id,deployment_id/id`
+terraform import prefect_deployment_schedule.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
+
+# You can also import by deployment_id/id only if you have a workspace_id set in your provider
+terraform import prefect_deployment_schedule.example 11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
+
+# Prefect Deployment Schedules can also be imported using the composite format `account_id/workspace_id/deployment_id/id`
+terraform import prefect_deployment_schedule.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222/33333333-3333-3333-3333-333333333333
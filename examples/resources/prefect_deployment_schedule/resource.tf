@@ -0,0 +1,18 @@
+resource "prefect_deployment_schedule" "weekdays" {
+  deployment_id = prefect_deployment.example.id
+  cron          = "0 9 * * 1-5"
+  timezone      = "America/New_York"
+}
+
+# A deployment can have several schedules
+resource "prefect_deployment_schedule" "hourly" {
+  deployment_id = prefect_deployment.example.id
+  interval      = 3600
+  anchor_date   = "2024-01-01T00:00:00Z"
+  active        = false
+}
+
+resource "prefect_deployment_schedule" "monthly" {
+  deployment_id = prefect_deployment.example.id
+  rrule         = "FREQ=MONTHLY;BYMONTHDAY=1"
+}
@@ -0,0 +1,8 @@
+# Prefect Flow Run Notification Policies can be imported using the format `workspace_id,id`
+terraform import prefect_flow_run_notification_policy.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# You can also import by id only if you have a workspace_id set in your provider
+terraform import prefect_flow_run_notification_policy.example 11111111-1111-1111-1111-111111111111
+
+# Prefect Flow Run Notification Policies can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_flow_run_notification_policy.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
@@ -0,0 +1,15 @@
+resource "prefect_block_document" "slack" {
+  name            = "alerts-slack"
+  block_type_slug = "slack-webhook"
+  data = jsonencode({
+    url = var.slack_webhook_url
+  })
+}
+
+# Notify Slack whenever a production flow run fails or crashes
+resource "prefect_flow_run_notification_policy" "production_failures" {
+  block_document_id = prefect_block_document.slack.id
+  state_names       = ["Failed", "Crashed"]
+  tags              = ["production"]
+  message_template  = "Flow run {flow_run_name} entered state {flow_run_state_name}: {flow_run_url}"
+}
@@ -0,0 +1,11 @@
+# Prefect Global Concurrency Limits can be imported using the format `workspace_id,id`
+terraform import prefect_global_concurrency_limit.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# Prefect Global Concurrency Limits can also be imported via name in the form `workspace_id,name`
+terraform import prefect_global_concurrency_limit.example 00000000-0000-0000-0000-000000000000,name_of_limit
+
+# You can also import by id or name only if you have a workspace_id set in your provider
+terraform import prefect_global_concurrency_limit.example 11111111-1111-1111-1111-111111111111
+
+# Prefect Global Concurrency Limits can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_global_concurrency_limit.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
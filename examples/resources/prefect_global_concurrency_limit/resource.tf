@@ -0,0 +1,13 @@
+# A global concurrency limit, allowing up to 10 concurrent slots
+resource "prefect_global_concurrency_limit" "database" {
+  name  = "database-connections"
+  limit = 10
+}
+
+# A rate limit, allowing 5 calls to an external API
+# and releasing one slot every 2 seconds
+resource "prefect_global_concurrency_limit" "external_api" {
+  name                  = "external-api"
+  limit                 = 5
+  slot_decay_per_second = 0.5
+}
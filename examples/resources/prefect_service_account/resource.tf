@@ -15,3 +15,22 @@ resource "prefect_service_account" "example" {
   name               = "my-service-account"
   api_key_expiration = time_rotating.ninety_days.rotation_rfc3339
 }
+
+# SPECIFIC ACCOUNT ROLE
+# Use the prefect_account_role data source to look up the role ID
+data "prefect_account_role" "admin" {
+  name = "Admin"
+}
+resource "prefect_service_account" "example" {
+  name            = "my-service-account"
+  account_role_id = data.prefect_account_role.admin.id
+}
+
+# ROTATING API KEY ON DEMAND
+# Change the `key_rotation_id` to any new value in order to rotate the
+# Service Account key, invalidating the previous one.
+# Note that the new key is stored in the Terraform state in plain text.
+resource "prefect_service_account" "example" {
+  name            = "my-service-account"
+  key_rotation_id = "2024-q1"
+}
@@ -0,0 +1,2 @@
+# Prefect Teams can be imported via UUID
+terraform import prefect_team.example 00000000-0000-0000-0000-000000000000
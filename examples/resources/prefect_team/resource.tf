@@ -0,0 +1,9 @@
+data "prefect_account_member" "marvin" {
+  email = "marvin@prefect.io"
+}
+
+resource "prefect_team" "example" {
+  name        = "my-team"
+  description = "Team for my data engineers"
+  members     = [data.prefect_account_member.marvin.user_id]
+}
@@ -3,3 +3,6 @@ terraform import prefect_variable.example name/name_of_variable
 
 # Prefect Variables can also be imported via UUID
 terraform import prefect_variable.example 00000000-0000-0000-0000-000000000000
+
+# Prefect Variables can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_variable.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
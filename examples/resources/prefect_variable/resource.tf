@@ -2,3 +2,12 @@ resource "prefect_variable" "example" {
   name  = "my_variable_name"
   value = "variable value goes here"
 }
+
+# Structured values can be stored by encoding them as JSON
+resource "prefect_variable" "json_example" {
+  name  = "my_json_variable"
+  value = jsonencode({
+    environment = "production"
+    replicas    = 3
+  })
+}
@@ -0,0 +1,5 @@
+# Prefect Variables can be imported as a set via a comma-separated list of names
+terraform import prefect_variables.example environment,region
+
+# Prefect Variables can also be imported using the composite format `account_id/workspace_id/name,name`
+terraform import prefect_variables.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/environment,region
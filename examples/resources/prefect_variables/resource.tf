@@ -0,0 +1,11 @@
+resource "prefect_variables" "example" {
+  values = {
+    environment = "production"
+    region      = "us-east-1"
+
+    # Structured values can be stored by encoding them as JSON
+    replica_config = jsonencode({
+      replicas = 3
+    })
+  }
+}
@@ -0,0 +1,8 @@
+# Prefect Webhooks can be imported using the format `workspace_id,id`
+terraform import prefect_webhook.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# You can also import by id only if you have a workspace_id set in your provider
+terraform import prefect_webhook.example 11111111-1111-1111-1111-111111111111
+
+# Prefect Webhooks can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_webhook.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
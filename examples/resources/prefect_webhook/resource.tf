@@ -0,0 +1,41 @@
+resource "prefect_webhook" "github" {
+  name        = "github-push"
+  description = "Receives push events from GitHub"
+  template = jsonencode({
+    event = "github.push"
+    resource = {
+      "prefect.resource.id"   = "github.repository.{{ body.repository.full_name }}"
+      "prefect.resource.name" = "{{ body.repository.name }}"
+    }
+  })
+}
+
+# The generated URL can be passed to the external system
+output "github_webhook_url" {
+  value = prefect_webhook.github.url
+}
+
+# Automations can match the events of the webhook with its
+# `event` and `resource_id`, instead of repeating them
+resource "prefect_automation" "on_github_push" {
+  name = "on-github-push"
+
+  trigger = jsonencode({
+    type    = "event"
+    posture = "Reactive"
+    expect  = [prefect_webhook.github.event]
+    match_related = {
+      "prefect.resource.id" = prefect_webhook.github.resource_id
+    }
+    threshold = 1
+    within    = 0
+  })
+
+  actions = jsonencode([
+    {
+      type          = "run-deployment"
+      source        = "selected"
+      deployment_id = "00000000-0000-0000-0000-000000000000"
+    }
+  ])
+}
@@ -3,3 +3,6 @@ terraform import prefect_work_pool.example 00000000-0000-0000-0000-000000000000,
 
 # You can also import by name only if you have a workspace_id set in your provider
 terraform import prefect_work_pool.example kubernetes-work-pool
+
+# Prefect Work Pools can also be imported using the composite format `account_id/workspace_id/name`
+terraform import prefect_work_pool.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/kubernetes-work-pool
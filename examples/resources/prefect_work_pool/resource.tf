@@ -25,3 +25,15 @@ resource "prefect_work_pool" "example" {
   paused            = false
   base_job_template = data.prefect_worker_metadata.d.base_job_configs.kubernetes
 }
+
+# Fail fast if the Prefect API is slow to respond
+resource "prefect_work_pool" "example" {
+  name         = "my-work-pool"
+  type         = "kubernetes"
+  workspace_id = "my-workspace-id"
+
+  timeouts {
+    create = "2m"
+    delete = "10m"
+  }
+}
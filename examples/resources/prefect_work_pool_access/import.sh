@@ -0,0 +1,6 @@
+# Prefect Work Pool Access can be imported using the workspace ID, the work pool name
+# and the ID of the actor or team, separated by commas
+terraform import prefect_work_pool_access.example 00000000-0000-0000-0000-000000000000,kubernetes-pool,11111111-1111-1111-1111-111111111111
+
+# Prefect Work Pool Access can also be imported using the composite format `account_id/workspace_id/work_pool_name/id`
+terraform import prefect_work_pool_access.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/kubernetes-pool/22222222-2222-2222-2222-222222222222
@@ -0,0 +1,25 @@
+resource "prefect_work_pool" "kubernetes" {
+  name         = "kubernetes-pool"
+  type         = "kubernetes"
+  workspace_id = "00000000-0000-0000-0000-000000000000"
+}
+
+# GRANTING WORK POOL ACCESS TO A SERVICE ACCOUNT
+resource "prefect_service_account" "bot" {
+  name = "a-cool-bot"
+}
+
+resource "prefect_work_pool_access" "bot_run" {
+  work_pool_name = prefect_work_pool.kubernetes.name
+  workspace_id   = "00000000-0000-0000-0000-000000000000"
+  actor_id       = prefect_service_account.bot.actor_id
+  role           = "run"
+}
+
+# GRANTING WORK POOL ACCESS TO A TEAM
+resource "prefect_work_pool_access" "team_manage" {
+  work_pool_name = prefect_work_pool.kubernetes.name
+  workspace_id   = "00000000-0000-0000-0000-000000000000"
+  team_id        = "11111111-1111-1111-1111-111111111111"
+  role           = "manage"
+}
@@ -0,0 +1,8 @@
+# Prefect Work Queues can be imported using the format `workspace_id,work_pool_name/name`
+terraform import prefect_work_queue.example 00000000-0000-0000-0000-000000000000,kubernetes-work-pool/high-priority
+
+# You can also import by work_pool_name/name only if you have a workspace_id set in your provider
+terraform import prefect_work_queue.example kubernetes-work-pool/high-priority
+
+# Prefect Work Queues can also be imported using the composite format `account_id/workspace_id/work_pool_name/name`
+terraform import prefect_work_queue.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/kubernetes-work-pool/high-priority
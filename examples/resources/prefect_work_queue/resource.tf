@@ -0,0 +1,14 @@
+resource "prefect_work_pool" "example" {
+  name         = "my-work-pool"
+  type         = "kubernetes"
+  workspace_id = "my-workspace-id"
+}
+
+resource "prefect_work_queue" "example" {
+  name              = "high-priority"
+  work_pool_name    = prefect_work_pool.example.name
+  workspace_id      = "my-workspace-id"
+  description       = "Queue for latency-sensitive flow runs"
+  priority          = 1
+  concurrency_limit = 10
+}
@@ -2,3 +2,10 @@ resource "prefect_workspace" "example" {
   name   = "My Workspace"
   handle = "my-workspace"
 }
+
+# Tag the workspace, e.g. for cost allocation
+resource "prefect_workspace" "tagged" {
+  name   = "My Tagged Workspace"
+  handle = "my-tagged-workspace"
+  tags   = ["team:data", "env:production"]
+}
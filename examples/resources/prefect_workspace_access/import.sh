@@ -0,0 +1,6 @@
+# Prefect Workspace Access can be imported using the workspace ID, the accessor type
+# (USER, SERVICE_ACCOUNT or TEAM) and the Workspace Access ID, separated by commas
+terraform import prefect_workspace_access.example 00000000-0000-0000-0000-000000000000,SERVICE_ACCOUNT,11111111-1111-1111-1111-111111111111
+
+# Prefect Workspace Access can also be imported using the composite format `account_id/workspace_id/accessor_type/id`
+terraform import prefect_workspace_access.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/SERVICE_ACCOUNT/22222222-2222-2222-2222-222222222222
@@ -12,7 +12,7 @@ data "prefect_account_member" "marvin" {
 # Assign the Workspace Role to the Account Member
 resource "prefect_workspace_access" "marvin_developer" {
   accessor_type     = "USER"
-  accessor_id       = prefect_account_member.marvin.user_id
+  accessor_id       = data.prefect_account_member.marvin.user_id
   workspace_id      = "00000000-0000-0000-0000-000000000000"
   workspace_role_id = data.prefect_workspace_role.developer.id
 }
@@ -40,3 +40,12 @@ resource "prefect_workspace_access" "team_developer" {
   workspace_id      = "00000000-0000-0000-0000-000000000000"
   workspace_role_id = data.prefect_workspace_role.developer.id
 }
+
+# ASSIGNING WORKSPACE ACCESS WITH A DEDICATED ACCESSOR ATTRIBUTE
+# Exactly one of `team_id`, `user_id` or `service_account_id` can be set
+# instead of the `accessor_type` and `accessor_id` attributes
+resource "prefect_workspace_access" "bot_runner" {
+  service_account_id = prefect_service_account.bot.id
+  workspace_id       = "00000000-0000-0000-0000-000000000000"
+  workspace_role_id  = data.prefect_workspace_role.developer.id
+}
@@ -0,0 +1,6 @@
+# Prefect Workspace Service Account Access can be imported using the workspace ID
+# and the Workspace Access ID, separated by a comma
+terraform import prefect_workspace_service_account_access.example 00000000-0000-0000-0000-000000000000,11111111-1111-1111-1111-111111111111
+
+# Prefect Workspace Service Account Access can also be imported using the composite format `account_id/workspace_id/id`
+terraform import prefect_workspace_service_account_access.example 00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222
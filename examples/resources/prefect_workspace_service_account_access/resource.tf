@@ -0,0 +1,24 @@
+# Read down a default Workspace Role (or create your own)
+data "prefect_workspace_role" "developer" {
+  name = "Developer"
+}
+
+# Create a Service Account resource
+resource "prefect_service_account" "ci" {
+  name = "ci-bot"
+}
+
+# Give the Service Account developer access to the Workspace
+resource "prefect_workspace_service_account_access" "ci_developer" {
+  service_account_id = prefect_service_account.ci.id
+  workspace_id       = "00000000-0000-0000-0000-000000000000"
+  workspace_role_id  = data.prefect_workspace_role.developer.id
+}
+
+# The resolved actor can be used to grant access to individual Work Pools
+resource "prefect_work_pool_access" "ci_run" {
+  work_pool_name = "kubernetes-pool"
+  workspace_id   = "00000000-0000-0000-0000-000000000000"
+  actor_id       = prefect_workspace_service_account_access.ci_developer.actor_id
+  role           = "run"
+}
@@ -8,7 +8,14 @@ import (
 )
 
 type AccountMembershipsClient interface {
-	List(ctx context.Context, emails []string) ([]*AccountMembership, error)
+	List(ctx context.Context, emails []string, emailDomain string) ([]*AccountMembership, error)
+	Get(ctx context.Context, membershipID uuid.UUID) (*AccountMembership, error)
+	Update(ctx context.Context, membershipID uuid.UUID, data AccountMembershipUpdate) error
+	Delete(ctx context.Context, membershipID uuid.UUID) error
+
+	Invite(ctx context.Context, data AccountInvitationCreate) (*AccountInvitation, error)
+	GetInvitation(ctx context.Context, invitationID uuid.UUID) (*AccountInvitation, error)
+	RevokeInvitation(ctx context.Context, invitationID uuid.UUID) error
 }
 
 type AccountMembership struct {
@@ -24,14 +31,43 @@ type AccountMembership struct {
 	LastLogin       *time.Time `json:"last_login"`
 }
 
+// AccountMembershipUpdate defines the request payload
+// when updating an account membership.
+type AccountMembershipUpdate struct {
+	AccountRoleID uuid.UUID `json:"account_role_id"`
+}
+
+// AccountInvitation is a representation of an invitation to join an account.
+type AccountInvitation struct {
+	BaseModel
+	Email         string     `json:"email"`
+	AccountRoleID uuid.UUID  `json:"account_role_id"`
+	Status        string     `json:"status"`
+	Expires       *time.Time `json:"expires"`
+}
+
+// AccountInvitationStatusPending is the status of an
+// invitation that has not been accepted or rejected yet.
+const AccountInvitationStatusPending = "PENDING"
+
+// AccountInvitationCreate defines the request payload
+// when inviting a user to an account.
+type AccountInvitationCreate struct {
+	Email         string    `json:"email"`
+	AccountRoleID uuid.UUID `json:"account_role_id"`
+}
+
 // AccountMembershipFilter defines the search filter payload
 // when searching for workspace roles by name.
 // example request payload:
-// {"account_memberships": {"email": {"any_": ["test"]}}}.
+// {"account_memberships": {"email": {"any_": ["test"]}}, "limit": 200, "offset": 0}.
 type AccountMembershipFilter struct {
 	AccountMemberships struct {
 		Email struct {
-			Any []string `json:"any_"`
+			Any  []string `json:"any_"`
+			Like string   `json:"like_,omitempty"`
 		} `json:"email,omitempty"`
 	} `json:"account_memberships"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
 }
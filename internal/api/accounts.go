@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 // AccountsClient is a client for working with accounts.
@@ -27,6 +29,14 @@ type Account struct {
 	AllowPublicWorkspaces *bool    `json:"allow_public_workspaces"`
 }
 
+// AccountSummary is a summary of one of the accounts
+// that the authenticated actor has access to.
+type AccountSummary struct {
+	AccountID     uuid.UUID `json:"account_id"`
+	AccountName   string    `json:"account_name"`
+	AccountHandle string    `json:"account_handle"`
+}
+
 // AccountResponse is the data about an account returned by the Accounts API.
 type AccountResponse struct {
 	Account
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ArtifactsClient is a client for working with artifacts.
+type ArtifactsClient interface {
+	Get(ctx context.Context, artifactID uuid.UUID) (*Artifact, error)
+	GetLatestByKey(ctx context.Context, key string) (*Artifact, error)
+}
+
+// Artifact is a representation of an artifact created by a flow or task run,
+// such as a markdown report, a table, or a link.
+type Artifact struct {
+	BaseModel
+	Key         *string    `json:"key"`
+	Type        *string    `json:"type"`
+	Description *string    `json:"description"`
+	Data        any        `json:"data"`
+	FlowRunID   *uuid.UUID `json:"flow_run_id"`
+	TaskRunID   *uuid.UUID `json:"task_run_id"`
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AutomationsClient is a client for working with automations.
+type AutomationsClient interface {
+	Create(ctx context.Context, data AutomationUpsert) (*Automation, error)
+	Get(ctx context.Context, automationID uuid.UUID) (*Automation, error)
+	Update(ctx context.Context, automationID uuid.UUID, data AutomationUpsert) error
+	Delete(ctx context.Context, automationID uuid.UUID) error
+}
+
+// Automation is a representation of an automation,
+// which runs its actions when its trigger fires.
+type Automation struct {
+	BaseModel
+	AutomationUpsert
+}
+
+// AutomationUpsert is the subset of Automation used when
+// creating or replacing automations.
+type AutomationUpsert struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Enabled     bool                     `json:"enabled"`
+	Trigger     map[string]interface{}   `json:"trigger"`
+	Actions     []map[string]interface{} `json:"actions"`
+}
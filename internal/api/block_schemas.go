@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BlockSchemasClient is a client for working with block schemas.
+type BlockSchemasClient interface {
+	GetByBlockType(ctx context.Context, blockTypeID uuid.UUID, version string) (*BlockSchema, error)
+}
+
+// BlockSchema is a representation of a version of a block type's schema.
+type BlockSchema struct {
+	BaseModel
+	Checksum     string                 `json:"checksum"`
+	BlockTypeID  uuid.UUID              `json:"block_type_id"`
+	Version      string                 `json:"version"`
+	Fields       map[string]interface{} `json:"fields"`
+	Capabilities []string               `json:"capabilities"`
+}
+
+// BlockSchemaFilterSettings defines settings when searching for block schemas.
+// example request payload:
+// {"block_schemas": {"block_type_id": {"any_": ["..."]}, "version": {"any_": ["2.20.0"]}}, "limit": 1}.
+type BlockSchemaFilterSettings struct {
+	Limit        int                `json:"limit,omitempty"`
+	BlockSchemas *BlockSchemaFilter `json:"block_schemas,omitempty"`
+}
+
+// BlockSchemaFilter defines filters when searching for block schemas.
+type BlockSchemaFilter struct {
+	BlockTypeID *BlockSchemaFilterBlockTypeID `json:"block_type_id,omitempty"`
+	Version     *BlockSchemaFilterVersion     `json:"version,omitempty"`
+}
+
+// BlockSchemaFilterBlockTypeID defines filter criteria searching on block type IDs.
+type BlockSchemaFilterBlockTypeID struct {
+	Any []uuid.UUID `json:"any_"`
+}
+
+// BlockSchemaFilterVersion defines filter criteria searching on block schema versions.
+type BlockSchemaFilterVersion struct {
+	Any []string `json:"any_"`
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BlockTypesClient is a client for working with block types and their schemas.
+type BlockTypesClient interface {
+	GetBySlug(ctx context.Context, slug string) (*BlockType, error)
+	GetLatestBlockSchema(ctx context.Context, blockTypeID uuid.UUID) (*BlockSchema, error)
+}
+
+// BlockType is a representation of a block type, such as s3-bucket.
+type BlockType struct {
+	BaseModel
+	Name             string  `json:"name"`
+	Slug             string  `json:"slug"`
+	LogoURL          *string `json:"logo_url"`
+	DocumentationURL *string `json:"documentation_url"`
+	Description      *string `json:"description"`
+	IsProtected      bool    `json:"is_protected"`
+}
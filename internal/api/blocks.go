@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BlocksClient is a client for working with block documents.
+type BlocksClient interface {
+	Create(ctx context.Context, data BlockDocumentCreate) (*BlockDocument, error)
+	Get(ctx context.Context, blockDocumentID uuid.UUID) (*BlockDocument, error)
+	GetByName(ctx context.Context, blockTypeSlug string, name string) (*BlockDocument, error)
+	Update(ctx context.Context, blockDocumentID uuid.UUID, data BlockDocumentUpdate) error
+	Delete(ctx context.Context, blockDocumentID uuid.UUID) error
+}
+
+// BlockDocument is a representation of a block document,
+// which holds the configuration of a block (such as credentials).
+type BlockDocument struct {
+	BaseModel
+	Name          string                 `json:"name"`
+	Data          map[string]interface{} `json:"data"`
+	BlockSchemaID uuid.UUID              `json:"block_schema_id"`
+	BlockTypeID   uuid.UUID              `json:"block_type_id"`
+	BlockType     BlockType              `json:"block_type"`
+}
+
+// BlockDocumentCreate is the data used when creating block documents.
+//
+// The block type is referenced by slug; the client resolves it
+// to the block type's ID and its latest block schema.
+type BlockDocumentCreate struct {
+	Name          string
+	BlockTypeSlug string
+	Data          map[string]interface{}
+}
+
+// BlockDocumentUpdate is a subset of BlockDocument used when updating block documents.
+type BlockDocumentUpdate struct {
+	Data map[string]interface{} `json:"data"`
+
+	// MergeExistingData merges Data into the stored data when set,
+	// rather than replacing it.
+	MergeExistingData bool `json:"merge_existing_data"`
+}
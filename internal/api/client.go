@@ -1,6 +1,10 @@
 package api
 
-import "github.com/google/uuid"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 // PrefectClient returns clients for different aspects of our API.
 //
@@ -9,12 +13,28 @@ type PrefectClient interface {
 	Accounts(accountID uuid.UUID) (AccountsClient, error)
 	AccountMemberships(accountID uuid.UUID) (AccountMembershipsClient, error)
 	AccountRoles(accountID uuid.UUID) (AccountRolesClient, error)
+	Artifacts(accountID uuid.UUID, workspaceID uuid.UUID) (ArtifactsClient, error)
+	Automations(accountID uuid.UUID, workspaceID uuid.UUID) (AutomationsClient, error)
+	Blocks(accountID uuid.UUID, workspaceID uuid.UUID) (BlocksClient, error)
+	BlockTypes(accountID uuid.UUID, workspaceID uuid.UUID) (BlockTypesClient, error)
+	BlockSchemas(accountID uuid.UUID, workspaceID uuid.UUID) (BlockSchemasClient, error)
 	Collections() (CollectionsClient, error)
+	ConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (ConcurrencyLimitsClient, error)
+	Deployments(accountID uuid.UUID, workspaceID uuid.UUID) (DeploymentsClient, error)
+	Flows(accountID uuid.UUID, workspaceID uuid.UUID) (FlowsClient, error)
+	FlowRunNotificationPolicies(accountID uuid.UUID, workspaceID uuid.UUID) (FlowRunNotificationPoliciesClient, error)
+	GlobalConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (GlobalConcurrencyLimitsClient, error)
+	Me() (MeClient, error)
 	Teams(accountID uuid.UUID) (TeamsClient, error)
 	Workspaces(accountID uuid.UUID) (WorkspacesClient, error)
 	WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (WorkspaceAccessClient, error)
 	WorkspaceRoles(accountID uuid.UUID) (WorkspaceRolesClient, error)
 	WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (WorkPoolsClient, error)
+	WorkQueues(accountID uuid.UUID, workspaceID uuid.UUID, workPoolName string) (WorkQueuesClient, error)
 	Variables(accountID uuid.UUID, workspaceID uuid.UUID) (VariablesClient, error)
+	Webhooks(accountID uuid.UUID, workspaceID uuid.UUID) (WebhooksClient, error)
 	ServiceAccounts(accountID uuid.UUID) (ServiceAccountsClient, error)
+
+	AccountIDByHandle(ctx context.Context, handle string) (uuid.UUID, error)
+	DefaultAccountID() uuid.UUID
 }
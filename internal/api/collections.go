@@ -7,6 +7,7 @@ import (
 
 type CollectionsClient interface {
 	GetWorkerMetadataViews(ctx context.Context) (WorkerTypeByPackage, error)
+	GetBlockMetadataViews(ctx context.Context) (BlockTypesByPackage, error)
 }
 
 // { "prefect": {...}, "prefect-aws": {...} }.
@@ -23,3 +24,24 @@ type WorkerMetadata struct {
 	Description                 string          `json:"description"`
 	DefaultBaseJobConfiguration json.RawMessage `json:"default_base_job_configuration"`
 }
+
+// { "prefect-aws": { "block_types": {...} } }.
+type BlockTypesByPackage map[string]CollectionBlockTypes
+
+// { "block_types": { "aws-credentials": {...} } }.
+type CollectionBlockTypes struct {
+	BlockTypes map[string]BlockTypeMetadata `json:"block_types"`
+}
+
+type BlockTypeMetadata struct {
+	Name             string              `json:"name"`
+	Slug             string              `json:"slug"`
+	Description      string              `json:"description"`
+	DocumentationURL string              `json:"documentation_url"`
+	BlockSchema      BlockSchemaMetadata `json:"block_schema"`
+}
+
+type BlockSchemaMetadata struct {
+	// Version is the version of the collection that registered the block schema.
+	Version string `json:"version"`
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ConcurrencyLimitsClient is a client for working with tag-based task run concurrency limits.
+type ConcurrencyLimitsClient interface {
+	Create(ctx context.Context, data ConcurrencyLimitCreate) (*ConcurrencyLimit, error)
+	Get(ctx context.Context, concurrencyLimitID uuid.UUID) (*ConcurrencyLimit, error)
+	GetByTag(ctx context.Context, tag string) (*ConcurrencyLimit, error)
+	Delete(ctx context.Context, concurrencyLimitID uuid.UUID) error
+}
+
+// ConcurrencyLimit is a representation of a concurrency limit,
+// which caps the number of concurrent task runs with a tag.
+type ConcurrencyLimit struct {
+	BaseModel
+	Tag              string      `json:"tag"`
+	ConcurrencyLimit int64       `json:"concurrency_limit"`
+	ActiveSlots      []uuid.UUID `json:"active_slots"`
+}
+
+// ConcurrencyLimitCreate is a subset of ConcurrencyLimit used when creating concurrency limits.
+//
+// The server upserts concurrency limits by tag, so this is also used
+// to change the limit of an existing tag.
+type ConcurrencyLimitCreate struct {
+	Tag              string `json:"tag"`
+	ConcurrencyLimit int64  `json:"concurrency_limit"`
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeploymentsClient is a client for working with deployments.
+type DeploymentsClient interface {
+	Create(ctx context.Context, data DeploymentCreate) (*Deployment, error)
+	Get(ctx context.Context, deploymentID uuid.UUID) (*Deployment, error)
+	GetByName(ctx context.Context, flowName string, deploymentName string) (*Deployment, error)
+	List(ctx context.Context) ([]*Deployment, error)
+	Update(ctx context.Context, deploymentID uuid.UUID, data DeploymentUpdate) error
+	Delete(ctx context.Context, deploymentID uuid.UUID) error
+
+	CreateSchedule(ctx context.Context, deploymentID uuid.UUID, data DeploymentScheduleUpsert) (*DeploymentSchedule, error)
+	GetSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID) (*DeploymentSchedule, error)
+	UpdateSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID, data DeploymentScheduleUpsert) error
+	DeleteSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID) error
+
+	GetAccess(ctx context.Context, deploymentID uuid.UUID) (*ObjectAccess, error)
+	SetAccess(ctx context.Context, deploymentID uuid.UUID, data ObjectAccessControl) error
+}
+
+// Deployment is a representation of a deployment.
+type Deployment struct {
+	BaseModel
+	Name          string                 `json:"name"`
+	FlowID        uuid.UUID              `json:"flow_id"`
+	WorkPoolName  *string                `json:"work_pool_name"`
+	WorkQueueName *string                `json:"work_queue_name"`
+	Parameters    map[string]interface{} `json:"parameters"`
+	Tags          []string               `json:"tags"`
+	Paused        bool                   `json:"paused"`
+}
+
+// DeploymentFilterSettings defines settings when listing deployments.
+type DeploymentFilterSettings struct {
+	Limit  int    `json:"limit,omitempty"`
+	Offset int    `json:"offset"`
+	Sort   string `json:"sort,omitempty"`
+}
+
+// DeploymentCreate is a subset of Deployment used when creating deployments.
+type DeploymentCreate struct {
+	Name          string                 `json:"name"`
+	FlowID        uuid.UUID              `json:"flow_id"`
+	WorkPoolName  *string                `json:"work_pool_name,omitempty"`
+	WorkQueueName *string                `json:"work_queue_name,omitempty"`
+	Parameters    map[string]interface{} `json:"parameters"`
+	Tags          []string               `json:"tags"`
+	Paused        bool                   `json:"paused"`
+}
+
+// DeploymentUpdate is a subset of Deployment used when updating deployments.
+type DeploymentUpdate struct {
+	WorkPoolName  *string                `json:"work_pool_name,omitempty"`
+	WorkQueueName *string                `json:"work_queue_name,omitempty"`
+	Parameters    map[string]interface{} `json:"parameters"`
+	Tags          []string               `json:"tags"`
+	Paused        bool                   `json:"paused"`
+
+	// ClearWorkPool sends the work pool and work queue names as null,
+	// which nil names cannot express as they are left unmodified.
+	ClearWorkPool bool `json:"-"`
+}
+
+// MarshalJSON encodes the update, with explicit null work pool
+// and work queue names when ClearWorkPool is set.
+func (u DeploymentUpdate) MarshalJSON() ([]byte, error) {
+	type deploymentUpdate DeploymentUpdate
+	if !u.ClearWorkPool {
+		data, err := json.Marshal(deploymentUpdate(u))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal deployment update: %w", err)
+		}
+
+		return data, nil
+	}
+
+	data, err := json.Marshal(struct {
+		deploymentUpdate
+		WorkPoolName  *string `json:"work_pool_name"`
+		WorkQueueName *string `json:"work_queue_name"`
+	}{deploymentUpdate: deploymentUpdate(u)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal deployment update: %w", err)
+	}
+
+	return data, nil
+}
+
+// Schedule is a representation of a cron, interval, or rrule schedule.
+// Exactly one of Cron, Interval, or RRule is set.
+type Schedule struct {
+	Cron       *string    `json:"cron,omitempty"`
+	Interval   *float64   `json:"interval,omitempty"`
+	AnchorDate *time.Time `json:"anchor_date,omitempty"`
+	RRule      *string    `json:"rrule,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
+}
+
+// DeploymentSchedule is a representation of one of the schedules of a deployment.
+type DeploymentSchedule struct {
+	BaseModel
+	DeploymentID uuid.UUID `json:"deployment_id"`
+	Schedule     Schedule  `json:"schedule"`
+	Active       bool      `json:"active"`
+}
+
+// DeploymentScheduleUpsert is a subset of DeploymentSchedule
+// used when creating or updating deployment schedules.
+type DeploymentScheduleUpsert struct {
+	Schedule Schedule `json:"schedule"`
+	Active   bool     `json:"active"`
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrWorkspaceScopeRequired is returned when creating a workspace-scoped client
+// without an account or workspace ID, when the provider has no default for them either.
+var ErrWorkspaceScopeRequired = errors.New("both accountID and workspaceID must be defined")
+
+// ErrNotFound is wrapped by every Get-style client method when the server
+// responds with a 404, so that callers can distinguish a missing object
+// from other failures with errors.Is.
+var ErrNotFound = errors.New("not found")
+
+// ErrAlreadyExists is wrapped by Create-style client methods when the server
+// responds with a 409, as another object with the same identifier exists.
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrHasDependents is wrapped by Delete-style client methods when the server
+// responds with a 409, as other objects still depend on the one being deleted.
+var ErrHasDependents = errors.New("has dependent objects")
+
+// ErrAmbiguous is returned by lookups on a non-unique field, such as a name,
+// when more than one object matches.
+var ErrAmbiguous = errors.New("matches more than one object")
+
+// ErrUnsupported is returned by optional client methods when
+// the server does not provide the endpoint they rely on.
+var ErrUnsupported = errors.New("not supported by the server")
+
+// ErrRequestTimeout is wrapped by client methods when a single request to the
+// server exceeds the configured request timeout.
+var ErrRequestTimeout = errors.New("request timed out")
+
+// ResponseError is returned by client methods when the server
+// responds with an unexpected status code.
+type ResponseError struct {
+	StatusCode int
+	Status     string
+	Body       string
+
+	// Detail is the error message decoded from the body of a Prefect
+	// error response, such as the fields failing validation, if any.
+	Detail string
+
+	// RequestID is the ID the server assigned to the request, if it sent
+	// one. Prefect support can use it to trace the failed request.
+	RequestID string
+}
+
+// Error implements the error interface.
+func (e *ResponseError) Error() string {
+	message := fmt.Sprintf("status code %s, error=%s", e.Status, e.Body)
+	if e.Detail != "" {
+		message = fmt.Sprintf("status code %s: %s", e.Status, e.Detail)
+	}
+
+	if e.RequestID != "" {
+		message += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+
+	return message
+}
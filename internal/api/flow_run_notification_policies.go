@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FlowRunNotificationPoliciesClient is a client for working with flow run notification policies.
+type FlowRunNotificationPoliciesClient interface {
+	Create(ctx context.Context, data FlowRunNotificationPolicyCreate) (*FlowRunNotificationPolicy, error)
+	Get(ctx context.Context, policyID uuid.UUID) (*FlowRunNotificationPolicy, error)
+	Update(ctx context.Context, policyID uuid.UUID, data FlowRunNotificationPolicyUpdate) error
+	Delete(ctx context.Context, policyID uuid.UUID) error
+}
+
+// FlowRunNotificationPolicy is a representation of a flow run notification policy,
+// which sends a message through a notifier block when a flow run enters one of its states.
+type FlowRunNotificationPolicy struct {
+	BaseModel
+	IsActive        bool      `json:"is_active"`
+	StateNames      []string  `json:"state_names"`
+	Tags            []string  `json:"tags"`
+	BlockDocumentID uuid.UUID `json:"block_document_id"`
+	MessageTemplate *string   `json:"message_template"`
+}
+
+// FlowRunNotificationPolicyCreate is a subset of FlowRunNotificationPolicy used when creating policies.
+type FlowRunNotificationPolicyCreate struct {
+	IsActive        bool      `json:"is_active"`
+	StateNames      []string  `json:"state_names"`
+	Tags            []string  `json:"tags"`
+	BlockDocumentID uuid.UUID `json:"block_document_id"`
+	MessageTemplate *string   `json:"message_template"`
+}
+
+// FlowRunNotificationPolicyUpdate is a subset of FlowRunNotificationPolicy used when updating policies.
+// Every field is sent, so that state names and tags removed from the policy are removed on the server.
+type FlowRunNotificationPolicyUpdate struct {
+	IsActive        bool      `json:"is_active"`
+	StateNames      []string  `json:"state_names"`
+	Tags            []string  `json:"tags"`
+	BlockDocumentID uuid.UUID `json:"block_document_id"`
+	MessageTemplate *string   `json:"message_template"`
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FlowsClient is a client for working with flows.
+type FlowsClient interface {
+	Create(ctx context.Context, data FlowCreate) (*Flow, error)
+	Get(ctx context.Context, flowID uuid.UUID) (*Flow, error)
+	GetByName(ctx context.Context, name string) (*Flow, error)
+}
+
+// Flow is a representation of a flow.
+type Flow struct {
+	BaseModel
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// FlowCreate is a subset of Flow used when creating flows.
+type FlowCreate struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// FlowFilter defines the search filter payload
+// when searching for flows by name.
+// example request payload:
+// {"flows": {"name": {"any_": ["my-flow"]}}, "limit": 2, "offset": 0}.
+type FlowFilter struct {
+	Flows struct {
+		Name struct {
+			Any []string `json:"any_"`
+		} `json:"name"`
+	} `json:"flows"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
+}
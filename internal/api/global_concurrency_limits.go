@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+)
+
+// GlobalConcurrencyLimitsClient is a client for working with global concurrency limits.
+type GlobalConcurrencyLimitsClient interface {
+	Create(ctx context.Context, data GlobalConcurrencyLimitCreate) (*GlobalConcurrencyLimit, error)
+	Get(ctx context.Context, idOrName string) (*GlobalConcurrencyLimit, error)
+	Update(ctx context.Context, idOrName string, data GlobalConcurrencyLimitUpdate) error
+	Delete(ctx context.Context, idOrName string) error
+}
+
+// GlobalConcurrencyLimit is a representation of a named global concurrency limit.
+type GlobalConcurrencyLimit struct {
+	BaseModel
+	Name               string  `json:"name"`
+	Limit              int64   `json:"limit"`
+	Active             bool    `json:"active"`
+	ActiveSlots        int64   `json:"active_slots"`
+	SlotDecayPerSecond float64 `json:"slot_decay_per_second"`
+}
+
+// GlobalConcurrencyLimitCreate is a subset of GlobalConcurrencyLimit used when creating limits.
+type GlobalConcurrencyLimitCreate struct {
+	Name               string  `json:"name"`
+	Limit              int64   `json:"limit"`
+	Active             bool    `json:"active"`
+	SlotDecayPerSecond float64 `json:"slot_decay_per_second"`
+}
+
+// GlobalConcurrencyLimitUpdate is a subset of GlobalConcurrencyLimit used when updating limits.
+type GlobalConcurrencyLimitUpdate struct {
+	Name               *string  `json:"name,omitempty"`
+	Limit              *int64   `json:"limit,omitempty"`
+	Active             *bool    `json:"active,omitempty"`
+	SlotDecayPerSecond *float64 `json:"slot_decay_per_second,omitempty"`
+}
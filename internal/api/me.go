@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// MeClient is a client for working with the identity
+// of the actor that the API key belongs to.
+type MeClient interface {
+	Accounts(ctx context.Context) ([]*AccountSummary, error)
+	Workspaces(ctx context.Context) ([]*WorkspaceSummary, error)
+}
+
+// WorkspaceSummary is a summary of one of the workspaces
+// that the authenticated actor has access to.
+type WorkspaceSummary struct {
+	AccountID            uuid.UUID `json:"account_id"`
+	AccountName          string    `json:"account_name"`
+	AccountHandle        string    `json:"account_handle"`
+	WorkspaceID          uuid.UUID `json:"workspace_id"`
+	WorkspaceName        string    `json:"workspace_name"`
+	WorkspaceDescription string    `json:"workspace_description"`
+	WorkspaceHandle      string    `json:"workspace_handle"`
+}
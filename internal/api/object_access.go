@@ -0,0 +1,33 @@
+package api
+
+import "github.com/google/uuid"
+
+// ObjectAccessActor is an actor or team that was granted access to an
+// object with its own access control, such as a work pool or a deployment.
+type ObjectAccessActor struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Type string    `json:"type"`
+}
+
+// ObjectAccessActorTypeTeam is the type of an ObjectAccessActor that is a team.
+const ObjectAccessActorTypeTeam = "team"
+
+// ObjectAccess is a representation of the access control of an object,
+// with the actors and teams granted each level of access.
+type ObjectAccess struct {
+	ManageActors []ObjectAccessActor `json:"manage_actors"`
+	RunActors    []ObjectAccessActor `json:"run_actors"`
+	ViewActors   []ObjectAccessActor `json:"view_actors"`
+}
+
+// ObjectAccessControl defines the actors and teams granted each level
+// of access to an object, replacing the existing access control.
+type ObjectAccessControl struct {
+	ManageActorIDs []uuid.UUID `json:"manage_actor_ids"`
+	RunActorIDs    []uuid.UUID `json:"run_actor_ids"`
+	ViewActorIDs   []uuid.UUID `json:"view_actor_ids"`
+	ManageTeamIDs  []uuid.UUID `json:"manage_team_ids"`
+	RunTeamIDs     []uuid.UUID `json:"run_team_ids"`
+	ViewTeamIDs    []uuid.UUID `json:"view_team_ids"`
+}
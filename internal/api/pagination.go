@@ -0,0 +1,70 @@
+package api
+
+import "context"
+
+// DefaultPageSize is the number of items requested per page
+// when paginating over a list endpoint.
+const DefaultPageSize = 200
+
+// PageFetcher retrieves a single page of items from a list endpoint,
+// starting at offset and returning at most limit items.
+type PageFetcher[T any] func(ctx context.Context, offset int, limit int) ([]T, error)
+
+// PageScheme drives the paging convention of a list endpoint. Every list
+// endpoint used so far pages with offset and limit, see OffsetPages.
+type PageScheme[T any] interface {
+	// NextPage fetches the next page of items, and reports whether
+	// there are more pages to fetch after it.
+	NextPage(ctx context.Context) ([]T, bool, error)
+}
+
+// offsetScheme pages with an increasing offset, until the server
+// returns fewer items than a full page.
+type offsetScheme[T any] struct {
+	pageSize int
+	fetch    PageFetcher[T]
+	offset   int
+}
+
+// OffsetPages returns a PageScheme for endpoints paging with offset and limit.
+//
+//nolint:ireturn // the scheme is chosen per endpoint
+func OffsetPages[T any](pageSize int, fetch PageFetcher[T]) PageScheme[T] {
+	return &offsetScheme[T]{pageSize: pageSize, fetch: fetch}
+}
+
+// NextPage fetches the page at the current offset.
+func (s *offsetScheme[T]) NextPage(ctx context.Context) ([]T, bool, error) {
+	page, err := s.fetch(ctx, s.offset, s.pageSize)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s.offset += len(page)
+
+	return page, len(page) >= s.pageSize, nil
+}
+
+// PaginateWith fetches the pages of a list endpoint following scheme,
+// collecting every item until the last page.
+func PaginateWith[T any](ctx context.Context, scheme PageScheme[T]) ([]T, error) {
+	items := []T{}
+	for {
+		page, more, err := scheme.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+
+		if !more {
+			return items, nil
+		}
+	}
+}
+
+// Paginate calls fetch with an increasing offset, collecting every
+// item until the server returns fewer items than a full page.
+func Paginate[T any](ctx context.Context, pageSize int, fetch PageFetcher[T]) ([]T, error) {
+	return PaginateWith(ctx, OffsetPages(pageSize, fetch))
+}
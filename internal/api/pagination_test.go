@@ -0,0 +1,30 @@
+package api_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	const total = 25
+	items, err := api.Paginate(context.Background(), 10, func(_ context.Context, offset int, limit int) ([]string, error) {
+		page := []string{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			page = append(page, strconv.Itoa(i))
+		}
+
+		return page, nil
+	})
+	if err != nil {
+		t.Fatalf("expected every page to be fetched, got: %s", err)
+	}
+
+	if len(items) != total {
+		t.Errorf("expected %d items, got %d", total, len(items))
+	}
+}
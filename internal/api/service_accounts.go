@@ -20,12 +20,14 @@ type ServiceAccountsClient interface {
 
 type ServiceAccountCreateRequest struct {
 	Name             string     `json:"name"`
+	Description      string     `json:"description"`
 	APIKeyExpiration string     `json:"api_key_expiration,omitempty"`
 	AccountRoleID    *uuid.UUID `json:"account_role_id,omitempty"`
 }
 
 type ServiceAccountUpdateRequest struct {
 	Name          string     `json:"name"`
+	Description   string     `json:"description"`
 	AccountRoleID *uuid.UUID `json:"account_role_id,omitempty"`
 }
 
@@ -36,13 +38,15 @@ type ServiceAccountRotateKeyRequest struct {
 // ServiceAccountFilter defines the search filter payload
 // when searching for service accounts by name.
 // example request payload:
-// {"service_accounts": {"name": {"any_": ["test"]}}}.
+// {"service_accounts": {"name": {"any_": ["test"]}}, "limit": 200, "offset": 0}.
 type ServiceAccountFilter struct {
 	ServiceAccounts struct {
 		Name struct {
 			Any []string `json:"any_"`
 		} `json:"name,omitempty"`
 	} `json:"service_accounts"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
 }
 
 /*** RESPONSE DATA STRUCTS ***/
@@ -51,7 +55,10 @@ type ServiceAccountFilter struct {
 type ServiceAccount struct {
 	BaseModel
 	AccountID       uuid.UUID            `json:"account_id"`
+	ActorID         uuid.UUID            `json:"actor_id"`
 	Name            string               `json:"name"`
+	Description     *string              `json:"description"`
+	AccountRoleID   uuid.UUID            `json:"account_role_id"`
 	AccountRoleName string               `json:"account_role_name"`
 	APIKey          ServiceAccountAPIKey `json:"api_key"`
 }
@@ -69,7 +76,10 @@ type ServiceAccountAPIKey struct {
 type ServiceAccountNoKey struct {
 	BaseModel
 	AccountID       uuid.UUID                 `json:"account_id"`
+	ActorID         uuid.UUID                 `json:"actor_id"`
 	Name            string                    `json:"name"`
+	Description     *string                   `json:"description"`
+	AccountRoleID   uuid.UUID                 `json:"account_role_id"`
 	AccountRoleName string                    `json:"account_role_name"`
 	APIKey          ServiceAccountAPIKeyNoKey `json:"api_key"`
 }
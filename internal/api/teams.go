@@ -2,11 +2,27 @@ package api
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 // TeamsClient is a client for working with teams.
 type TeamsClient interface {
+	Create(ctx context.Context, data TeamCreate) (*Team, error)
 	List(ctx context.Context, names []string) ([]*Team, error)
+	Get(ctx context.Context, teamID uuid.UUID) (*Team, error)
+	Update(ctx context.Context, teamID uuid.UUID, data TeamUpdate) error
+	Delete(ctx context.Context, teamID uuid.UUID) error
+
+	ListMembers(ctx context.Context, teamID uuid.UUID) ([]*TeamMember, error)
+	AddMembers(ctx context.Context, teamID uuid.UUID, memberIDs []uuid.UUID) error
+	RemoveMember(ctx context.Context, teamID uuid.UUID, memberID uuid.UUID) error
+}
+
+// TeamMembersBulkUpdater is implemented by TeamsClients that can add
+// and remove several team members in a single request.
+type TeamMembersBulkUpdater interface {
+	UpdateMembers(ctx context.Context, teamID uuid.UUID, data TeamMembersUpdate) error
 }
 
 // Team is a representation of an team.
@@ -16,14 +32,49 @@ type Team struct {
 	Description string `json:"description"`
 }
 
+// TeamCreate is a subset of Team used when creating teams.
+type TeamCreate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TeamUpdate is a subset of Team used when updating teams.
+type TeamUpdate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TeamMember is a representation of a team member.
+type TeamMember struct {
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberType string    `json:"member_type"`
+}
+
+// TeamMembersUpsert defines the payload when adding members to a team.
+// example request payload:
+// {"members": [{"member_id": "...", "member_type": "user"}]}.
+type TeamMembersUpsert struct {
+	Members []TeamMember `json:"members"`
+}
+
+// TeamMembersUpdate defines the payload when adding and removing team members in bulk.
+// example request payload:
+// {"add": [{"member_id": "...", "member_type": "user"}], "remove": ["..."]}.
+type TeamMembersUpdate struct {
+	Add    []TeamMember `json:"add"`
+	Remove []uuid.UUID  `json:"remove"`
+}
+
 // TeamFilter defines the search filter payload
 // when searching for team by name.
 // example request payload:
-// {"teams": {"name": {"any_": ["test"]}}}.
+// {"teams": {"name": {"any_": ["test"]}}, "limit": 200, "offset": 0}.
 type TeamFilter struct {
 	Teams struct {
 		Name struct {
 			Any []string `json:"any_"`
 		} `json:"name"`
 	} `json:"teams"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
 }
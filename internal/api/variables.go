@@ -39,40 +39,42 @@ type VariableUpdate struct {
 }
 
 // VariableFilterSettings defines settings when searching for variables.
+// example request payload:
+// {"variables": {"tags": {"all_": ["test"]}}, "limit": 200, "offset": 0}.
 type VariableFilterSettings struct {
-	Limit     *int64          `json:"limit"`
-	Offset    *int64          `json:"offset"`
-	Variables *VariableFilter `json:"variables"`
-	Sort      string          `json:"sort"`
+	Limit     int             `json:"limit,omitempty"`
+	Offset    int             `json:"offset"`
+	Variables *VariableFilter `json:"variables,omitempty"`
+	Sort      string          `json:"sort,omitempty"`
 }
 
 // VariableFilter defines filters when searching for variables.
 type VariableFilter struct {
-	ID    *VariableFilterID    `json:"id"`
-	Name  *VariableFilterName  `json:"name"`
-	Value *VariableFilterValue `json:"value"`
-	Tags  *VariableFilterTags  `json:"tags"`
+	ID    *VariableFilterID    `json:"id,omitempty"`
+	Name  *VariableFilterName  `json:"name,omitempty"`
+	Value *VariableFilterValue `json:"value,omitempty"`
+	Tags  *VariableFilterTags  `json:"tags,omitempty"`
 }
 
 // VariableFilterID defines filter criteria searching on variable IDs.
 type VariableFilterID struct {
-	Any string `json:"any_"`
+	Any []uuid.UUID `json:"any_"`
 }
 
 // VariableFilterName defines filter criteria searching on variable names.
 type VariableFilterName struct {
-	Any  string `json:"any_"`
-	Like string `json:"like_"`
+	Any  []string `json:"any_,omitempty"`
+	Like string   `json:"like_,omitempty"`
 }
 
 // VariableFilterValue defines filter criteria searching on variable values.
 type VariableFilterValue struct {
-	Any  string `json:"any_"`
-	Like string `json:"like_"`
+	Any  []string `json:"any_,omitempty"`
+	Like string   `json:"like_,omitempty"`
 }
 
-// VariableFilterTags defines filter criteria searching on variable IDs.
+// VariableFilterTags defines filter criteria searching on variable tags.
 type VariableFilterTags struct {
-	All  string `json:"all_"`
-	Null bool   `json:"is_null_"`
+	All    []string `json:"all_,omitempty"`
+	IsNull *bool    `json:"is_null_,omitempty"`
 }
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WebhooksClient is a client for working with webhooks.
+type WebhooksClient interface {
+	Create(ctx context.Context, data WebhookUpsert) (*Webhook, error)
+	Get(ctx context.Context, webhookID uuid.UUID) (*Webhook, error)
+	Update(ctx context.Context, webhookID uuid.UUID, data WebhookUpsert) error
+	Delete(ctx context.Context, webhookID uuid.UUID) error
+}
+
+// Webhook is a representation of a webhook, which turns
+// the requests it receives into events using its template.
+type Webhook struct {
+	BaseModel
+	WebhookUpsert
+	Slug string `json:"slug"`
+
+	// URL is the address external systems send requests to.
+	// It is not returned by the API, and is set by the client instead.
+	URL string `json:"-"`
+}
+
+// WebhookUpsert is the subset of Webhook used when
+// creating or replacing webhooks.
+type WebhookUpsert struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Template    string `json:"template"`
+	IsActive    bool   `json:"is_active"`
+}
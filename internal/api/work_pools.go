@@ -13,6 +13,8 @@ type WorkPoolsClient interface {
 	Get(ctx context.Context, name string) (*WorkPool, error)
 	Update(ctx context.Context, name string, data WorkPoolUpdate) error
 	Delete(ctx context.Context, name string) error
+	GetAccess(ctx context.Context, name string) (*ObjectAccess, error)
+	SetAccess(ctx context.Context, name string, data ObjectAccessControl) error
 }
 
 // WorkPool is a representation of a work pool.
@@ -38,6 +40,8 @@ type WorkPoolCreate struct {
 }
 
 // WorkPoolUpdate is a subset of WorkPool used when updating pools.
+// A nil ConcurrencyLimit is sent as null, which clears the limit,
+// so it must not be omitted when empty.
 type WorkPoolUpdate struct {
 	Description      *string                `json:"description"`
 	IsPaused         *bool                  `json:"is_paused"`
@@ -45,7 +49,19 @@ type WorkPoolUpdate struct {
 	ConcurrencyLimit *int64                 `json:"concurrency_limit"`
 }
 
-// WorkPoolFilter defines filters when searching for work pools.
+// WorkPoolFilter defines the search filter payload
+// when searching for work pools by ID or type.
+// example request payload:
+// {"work_pools": {"id": {"any_": ["<uuid>"]}, "type": {"any_": ["kubernetes"]}}, "limit": 200, "offset": 0}.
 type WorkPoolFilter struct {
-	Any []uuid.UUID `json:"any_"`
+	WorkPools struct {
+		ID struct {
+			Any []uuid.UUID `json:"any_"`
+		} `json:"id"`
+		Type struct {
+			Any []string `json:"any_"`
+		} `json:"type"`
+	} `json:"work_pools"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
 }
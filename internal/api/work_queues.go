@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WorkQueuesClient is a client for working with work queues.
+type WorkQueuesClient interface {
+	Create(ctx context.Context, data WorkQueueCreate) (*WorkQueue, error)
+	List(ctx context.Context, filter WorkQueueFilter) ([]*WorkQueue, error)
+	Get(ctx context.Context, name string) (*WorkQueue, error)
+	Update(ctx context.Context, name string, data WorkQueueUpdate) error
+	Delete(ctx context.Context, name string) error
+}
+
+// WorkQueue is a representation of a work queue.
+type WorkQueue struct {
+	BaseModel
+	Name             string    `json:"name"`
+	Description      *string   `json:"description"`
+	IsPaused         bool      `json:"is_paused"`
+	ConcurrencyLimit *int64    `json:"concurrency_limit"`
+	Priority         *int64    `json:"priority"`
+	WorkPoolID       uuid.UUID `json:"work_pool_id"`
+}
+
+// WorkQueueCreate is a subset of WorkQueue used when creating queues.
+type WorkQueueCreate struct {
+	Name             string  `json:"name"`
+	Description      *string `json:"description"`
+	IsPaused         bool    `json:"is_paused"`
+	ConcurrencyLimit *int64  `json:"concurrency_limit"`
+	Priority         *int64  `json:"priority,omitempty"`
+}
+
+// WorkQueueUpdate is a subset of WorkQueue used when updating queues.
+// A nil ConcurrencyLimit is sent as null, which clears the limit,
+// so it must not be omitted when empty.
+type WorkQueueUpdate struct {
+	Description      *string `json:"description"`
+	IsPaused         *bool   `json:"is_paused"`
+	ConcurrencyLimit *int64  `json:"concurrency_limit"`
+	Priority         *int64  `json:"priority,omitempty"`
+}
+
+// WorkQueueFilter defines the search filter payload
+// when listing the work queues of a work pool.
+// example request payload:
+// {"work_queues": {"name": {"any_": ["test"]}}, "limit": 200, "offset": 0}.
+type WorkQueueFilter struct {
+	WorkQueues struct {
+		Name struct {
+			Any []string `json:"any_"`
+		} `json:"name"`
+	} `json:"work_queues"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
+}
@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/google/uuid"
 )
@@ -10,7 +12,9 @@ import (
 type WorkspacesClient interface {
 	Create(ctx context.Context, data WorkspaceCreate) (*Workspace, error)
 	Get(ctx context.Context, workspaceID uuid.UUID) (*Workspace, error)
-	List(ctx context.Context, handleNames []string) ([]*Workspace, error)
+	GetByHandle(ctx context.Context, handle string) (*Workspace, error)
+	GetByName(ctx context.Context, name string) (*Workspace, error)
+	List(ctx context.Context, filter WorkspaceFilter) ([]*Workspace, error)
 	Update(ctx context.Context, workspaceID uuid.UUID, data WorkspaceUpdate) error
 	Delete(ctx context.Context, workspaceID uuid.UUID) error
 }
@@ -24,31 +28,74 @@ type Workspace struct {
 	Handle                 string    `json:"handle"`
 	DefaultWorkspaceRoleID uuid.UUID `json:"default_workspace_role_id"`
 	IsPublic               bool      `json:"is_public"`
+	Tags                   []string  `json:"tags"`
+
+	DefaultResultStorageBlockID *uuid.UUID `json:"default_result_storage_block_id"`
+
+	// Settings holds the feature settings of the workspace, such as
+	// ai_log_summaries. It is nil when the server omits them.
+	Settings map[string]interface{} `json:"settings"`
 }
 
 // WorkspaceCreate is a subset of Workspace used when creating workspaces.
 type WorkspaceCreate struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
-	Handle      string  `json:"handle"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Handle      string   `json:"handle"`
+	Tags        []string `json:"tags"`
 }
 
 // WorkspaceUpdate is a subset of Workspace used when updating workspaces.
+// It is sent as a PATCH, so fields left nil are not modified.
 type WorkspaceUpdate struct {
-	Name                   *string    `json:"name"`
-	Description            *string    `json:"description"`
-	Handle                 *string    `json:"handle"`
-	DefaultWorkspaceRoleID *uuid.UUID `json:"default_workspace_role_id"`
+	Name                   *string    `json:"name,omitempty"`
+	Description            *string    `json:"description,omitempty"`
+	Handle                 *string    `json:"handle,omitempty"`
+	DefaultWorkspaceRoleID *uuid.UUID `json:"default_workspace_role_id,omitempty"`
+	Tags                   *[]string  `json:"tags,omitempty"`
+
+	// ClearDescription sends the description as null, which
+	// a nil Description cannot express as it is left unmodified.
+	ClearDescription bool `json:"-"`
+}
+
+// MarshalJSON encodes the update, with an explicit null
+// description when ClearDescription is set.
+func (u WorkspaceUpdate) MarshalJSON() ([]byte, error) {
+	type workspaceUpdate WorkspaceUpdate
+	if !u.ClearDescription {
+		data, err := json.Marshal(workspaceUpdate(u))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal workspace update: %w", err)
+		}
+
+		return data, nil
+	}
+
+	data, err := json.Marshal(struct {
+		workspaceUpdate
+		Description *string `json:"description"`
+	}{workspaceUpdate: workspaceUpdate(u)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workspace update: %w", err)
+	}
+
+	return data, nil
 }
 
 // WorkspaceFilter defines the search filter payload
-// when searching for workspaces by name.
+// when searching for workspaces by handle or tags.
 // example request payload:
-// {"workspaces": {"handle": {"any_": ["test"]}}}.
+// {"workspaces": {"handle": {"any_": ["test"]}, "tags": {"all_": ["team:data"]}}, "limit": 200, "offset": 0}.
 type WorkspaceFilter struct {
 	Workspaces struct {
 		Handle struct {
 			Any []string `json:"any_"`
 		} `json:"handle"`
+		Tags struct {
+			All []string `json:"all_,omitempty"`
+		} `json:"tags"`
 	} `json:"workspaces"`
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset"`
 }
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -15,32 +16,102 @@ import (
 var _ = api.AccountMembershipsClient(&AccountMembershipsClient{})
 
 type AccountMembershipsClient struct {
-	hc          *http.Client
-	apiKey      string
-	routePrefix string
+	hc                     *http.Client
+	apiKey                 string
+	routePrefix            string
+	invitationsRoutePrefix string
 }
 
 // AccountMemberships is a factory that initializes and returns a AccountMembershipsClient.
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) AccountMemberships(accountID uuid.UUID) (api.AccountMembershipsClient, error) {
+	key := subClientKey{kind: "account_memberships", accountID: accountID}
+	if cached, ok := loadSubClient[api.AccountMembershipsClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 
-	return &AccountMembershipsClient{
-		hc:          c.hc,
-		apiKey:      c.apiKey,
-		routePrefix: getAccountScopedURL(c.endpoint, accountID, "account_memberships"),
-	}, nil
+	return storeSubClient[api.AccountMembershipsClient](c, key, &AccountMembershipsClient{
+		hc:                     c.hc,
+		apiKey:                 c.apiKey,
+		routePrefix:            getAccountScopedURL(c.endpoint, accountID, "account_memberships"),
+		invitationsRoutePrefix: getAccountScopedURL(c.endpoint, accountID, "invitations"),
+	}), nil
 }
 
 // List returns a list of account memberships, based on the provided filter.
-func (c *AccountMembershipsClient) List(ctx context.Context, emails []string) ([]*api.AccountMembership, error) {
-	var buf bytes.Buffer
+// Results are paginated until all matching memberships have been retrieved.
+//
+// When emailDomain is set, only memberships whose email is in that domain are
+// returned, compared case-insensitively. The domain is sent to the server to
+// narrow the results, and is always matched against the domain part of the
+// emails, as the server filter is a partial match and is not supported by
+// every Prefect Cloud version.
+func (c *AccountMembershipsClient) List(ctx context.Context, emails []string, emailDomain string) ([]*api.AccountMembership, error) {
+	emailDomain = strings.ToLower(strings.TrimPrefix(emailDomain, "@"))
+
 	filterQuery := api.AccountMembershipFilter{}
 	filterQuery.AccountMemberships.Email.Any = emails
+	if emailDomain != "" {
+		filterQuery.AccountMemberships.Email.Like = "@" + emailDomain
+	}
+
+	accountMemberships, err := c.listAll(ctx, filterQuery)
+
+	// Fall back to filtering every membership by domain when the
+	// server rejects the partial match on emails.
+	var responseErr *api.ResponseError
+	if filterQuery.AccountMemberships.Email.Like != "" && errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusUnprocessableEntity {
+		filterQuery.AccountMemberships.Email.Like = ""
+		accountMemberships, err = c.listAll(ctx, filterQuery)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if emailDomain == "" {
+		return accountMemberships, nil
+	}
+
+	filtered := make([]*api.AccountMembership, 0, len(accountMemberships))
+	for _, accountMembership := range accountMemberships {
+		if hasEmailDomain(accountMembership.Email, emailDomain) {
+			filtered = append(filtered, accountMembership)
+		}
+	}
+
+	return filtered, nil
+}
 
+// hasEmailDomain reports whether the domain part of email is domain,
+// ignoring case.
+func hasEmailDomain(email string, domain string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+
+	return strings.EqualFold(email[at+1:], domain)
+}
+
+// listAll returns every page of account memberships for the provided filter.
+func (c *AccountMembershipsClient) listAll(ctx context.Context, filterQuery api.AccountMembershipFilter) ([]*api.AccountMembership, error) {
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.AccountMembership, error) {
+		filterQuery.Offset = offset
+		filterQuery.Limit = limit
+
+		return c.listPage(ctx, filterQuery)
+	})
+}
+
+// listPage returns a single page of account memberships for the provided filter.
+func (c *AccountMembershipsClient) listPage(ctx context.Context, filterQuery api.AccountMembershipFilter) ([]*api.AccountMembership, error) {
+	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
 		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
 	}
@@ -59,9 +130,7 @@ func (c *AccountMembershipsClient) List(ctx context.Context, emails []string) ([
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var accountMemberships []*api.AccountMembership
@@ -71,3 +140,172 @@ func (c *AccountMembershipsClient) List(ctx context.Context, emails []string) ([
 
 	return accountMemberships, nil
 }
+
+// Get returns an account membership by ID.
+func (c *AccountMembershipsClient) Get(ctx context.Context, membershipID uuid.UUID) (*api.AccountMembership, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", c.routePrefix, membershipID.String()), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("account membership id=%s: %w", membershipID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var accountMembership api.AccountMembership
+	if err := json.NewDecoder(resp.Body).Decode(&accountMembership); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &accountMembership, nil
+}
+
+// Update modifies an existing account membership by ID.
+func (c *AccountMembershipsClient) Update(ctx context.Context, membershipID uuid.UUID, data api.AccountMembershipUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode update payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", c.routePrefix, membershipID.String()), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes an account membership by ID.
+func (c *AccountMembershipsClient) Delete(ctx context.Context, membershipID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", c.routePrefix, membershipID.String()), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Invite creates an invitation for a user to join the account.
+func (c *AccountMembershipsClient) Invite(ctx context.Context, data api.AccountInvitationCreate) (*api.AccountInvitation, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode create payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/", c.invitationsRoutePrefix), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
+	}
+
+	var invitation api.AccountInvitation
+	if err := json.NewDecoder(resp.Body).Decode(&invitation); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// GetInvitation returns an account invitation by ID.
+func (c *AccountMembershipsClient) GetInvitation(ctx context.Context, invitationID uuid.UUID) (*api.AccountInvitation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", c.invitationsRoutePrefix, invitationID.String()), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("account invitation id=%s: %w", invitationID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var invitation api.AccountInvitation
+	if err := json.NewDecoder(resp.Body).Decode(&invitation); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// RevokeInvitation revokes a pending account invitation by ID.
+func (c *AccountMembershipsClient) RevokeInvitation(ctx context.Context, invitationID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", c.invitationsRoutePrefix, invitationID.String()), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("account invitation id=%s: %w", invitationID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestAccountMembershipsClient_List_emailDomain(t *testing.T) {
+	t.Parallel()
+
+	members := []*api.AccountMembership{
+		{ID: uuid.New(), Email: "marvin@Example.com"},
+		{ID: uuid.New(), Email: "ford@contractor.io"},
+		{ID: uuid.New(), Email: "arthur@example.com.evil"},
+	}
+
+	tests := map[string]struct {
+		serverSupportsLike bool
+		expectedRequests   int
+	}{
+		"server-side filter":   {serverSupportsLike: true, expectedRequests: 1},
+		"client-side fallback": {serverSupportsLike: false, expectedRequests: 2},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+
+				var filter api.AccountMembershipFilter
+				if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+					t.Errorf("failed to decode request body: %s", err)
+				}
+
+				like := filter.AccountMemberships.Email.Like
+				if like != "" && !test.serverSupportsLike {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"detail":"extra fields not permitted"}`))
+
+					return
+				}
+
+				if like != "" && like != "@example.com" {
+					t.Errorf("expected like_ filter @example.com, got %q", like)
+				}
+
+				// The partial match of the server also returns lookalike domains.
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(members)
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			membershipsClient, err := prefectClient.AccountMemberships(uuid.New())
+			if err != nil {
+				t.Fatalf("failed to create account memberships client: %s", err)
+			}
+
+			memberships, err := membershipsClient.List(context.Background(), nil, "EXAMPLE.com")
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if len(memberships) != 1 || memberships[0].ID != members[0].ID {
+				t.Errorf("expected only %s, got %v", members[0].Email, memberships)
+			}
+
+			if requests != test.expectedRequests {
+				t.Errorf("expected %d requests, got %d", test.expectedRequests, requests)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -25,15 +24,101 @@ type AccountRolesClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) AccountRoles(accountID uuid.UUID) (api.AccountRolesClient, error) {
+	key := subClientKey{kind: "account_roles", accountID: accountID}
+	if cached, ok := loadSubClient[api.AccountRolesClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 
-	return &AccountRolesClient{
+	return storeSubClient[api.AccountRolesClient](c, key, &AccountRolesClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getAccountScopedURL(c.endpoint, accountID, "account_roles"),
-	}, nil
+	}), nil
+}
+
+// Create creates a new account role.
+func (c *AccountRolesClient) Create(ctx context.Context, data api.AccountRoleUpsert) (*api.AccountRole, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode create payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/", c.routePrefix), &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
+	}
+
+	var accountRole api.AccountRole
+	if err := json.NewDecoder(resp.Body).Decode(&accountRole); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &accountRole, nil
+}
+
+// Update modifies an existing account role by ID.
+func (c *AccountRolesClient) Update(ctx context.Context, roleID uuid.UUID, data api.AccountRoleUpsert) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode update payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", c.routePrefix, roleID.String()), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a account role by ID.
+func (c *AccountRolesClient) Delete(ctx context.Context, roleID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", c.routePrefix, roleID.String()), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
 }
 
 // List returns a list of account roles, based on the provided filter.
@@ -60,9 +145,7 @@ func (c *AccountRolesClient) List(ctx context.Context, roleNames []string) ([]*a
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var accountRoles []*api.AccountRole
@@ -87,10 +170,12 @@ func (c *AccountRolesClient) Get(ctx context.Context, roleID uuid.UUID) (*api.Ac
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("account role id=%s: %w", roleID, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var accountRole api.AccountRole
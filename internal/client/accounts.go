@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -25,6 +24,11 @@ type AccountsClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) Accounts(accountID uuid.UUID) (api.AccountsClient, error) {
+	key := subClientKey{kind: "accounts", accountID: accountID}
+	if cached, ok := loadSubClient[api.AccountsClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
@@ -33,11 +37,51 @@ func (c *Client) Accounts(accountID uuid.UUID) (api.AccountsClient, error) {
 		return nil, fmt.Errorf("accountID must be set: accountID is %q", accountID)
 	}
 
-	return &AccountsClient{
+	return storeSubClient[api.AccountsClient](c, key, &AccountsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getAccountScopedURL(c.endpoint, accountID, ""),
-	}, nil
+	}), nil
+}
+
+// AccountIDByHandle returns the ID of the account with the given handle,
+// among the accounts that the API key has access to. The handles of those
+// accounts are cached, so that they are only listed once per Client.
+func (c *Client) AccountIDByHandle(ctx context.Context, handle string) (uuid.UUID, error) {
+	if c.subClients != nil {
+		if cached, ok := c.subClients.accountIDs.Load(handle); ok {
+			if accountID, ok := cached.(uuid.UUID); ok {
+				return accountID, nil
+			}
+		}
+	}
+
+	me, err := c.Me()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	accounts, err := me.Accounts(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	accountID := uuid.Nil
+	for _, account := range accounts {
+		if c.subClients != nil {
+			c.subClients.accountIDs.Store(account.AccountHandle, account.AccountID)
+		}
+
+		if account.AccountHandle == handle {
+			accountID = account.AccountID
+		}
+	}
+
+	if accountID != uuid.Nil {
+		return accountID, nil
+	}
+
+	return uuid.Nil, fmt.Errorf("account handle=%s: %w", handle, api.ErrNotFound)
 }
 
 // Get returns details for an account by ID.
@@ -55,10 +99,12 @@ func (c *AccountsClient) Get(ctx context.Context) (*api.AccountResponse, error)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("account: %w", api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var account api.AccountResponse
@@ -90,9 +136,7 @@ func (c *AccountsClient) Update(ctx context.Context, data api.AccountUpdate) err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -114,9 +158,7 @@ func (c *AccountsClient) Delete(ctx context.Context) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
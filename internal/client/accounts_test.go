@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_AccountIDByHandle(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.Method != http.MethodGet || r.URL.Path != "/api/me/accounts" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"account_id": "` + uuid.NewString() + `", "account_name": "Other", "account_handle": "other"},
+			{"account_id": "` + accountID.String() + `", "account_name": "Data Platform", "account_handle": "data-platform"}
+		]`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	resolvedID, err := prefectClient.AccountIDByHandle(context.Background(), "data-platform")
+	if err != nil {
+		t.Fatalf("failed to resolve account handle: %s", err)
+	}
+	if resolvedID != accountID {
+		t.Errorf("expected account ID %s, got %s", accountID, resolvedID)
+	}
+
+	// The handles of every account are cached by the first lookup.
+	for _, handle := range []string{"data-platform", "other"} {
+		if _, err := prefectClient.AccountIDByHandle(context.Background(), handle); err != nil {
+			t.Fatalf("failed to resolve account handle %s: %s", handle, err)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected the accounts to be listed once, got %d requests", requests.Load())
+	}
+
+	// Unknown handles are not cached, as the account may be created later.
+	if _, err := prefectClient.AccountIDByHandle(context.Background(), "missing"); !errors.Is(err, api.ErrNotFound) {
+		t.Errorf("expected api.ErrNotFound, got: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected the accounts to be listed again for an unknown handle, got %d requests", requests.Load())
+	}
+}
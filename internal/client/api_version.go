@@ -0,0 +1,96 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIVersionHeader is the HTTP header pinning the version of the Prefect API,
+// which gates breaking changes to the API.
+const APIVersionHeader = "X-Prefect-Api-Version"
+
+// DefaultAPIVersion is the version of the Prefect API the provider
+// is built against, which is requested unless configured otherwise.
+const DefaultAPIVersion = "0.8.4"
+
+// apiVersionTransport is an http.RoundTripper that records the API version
+// reported by the server, and logs a warning once when it differs from the
+// one requested.
+type apiVersionTransport struct {
+	next    http.RoundTripper
+	version string
+
+	warnOnce sync.Once
+
+	mu     sync.Mutex
+	served string
+}
+
+// newAPIVersionTransport wraps the provided http.RoundTripper to check the API
+// version of every response. If next is nil, http.DefaultTransport is used.
+func newAPIVersionTransport(next http.RoundTripper, version string) *apiVersionTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &apiVersionTransport{
+		next:    next,
+		version: version,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction, checking the API version of the response.
+func (t *apiVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		//nolint:wrapcheck // the error is returned as-is to the http.Client
+		return resp, err
+	}
+
+	served := resp.Header.Get(APIVersionHeader)
+	if served != "" {
+		t.mu.Lock()
+		t.served = served
+		t.mu.Unlock()
+	}
+
+	if served != "" && served != t.version {
+		t.warnOnce.Do(func() {
+			tflog.Warn(req.Context(), "The Prefect API reported a different API version than the one requested, which may change the behavior of the provider", map[string]interface{}{
+				"requested_api_version": t.version,
+				"served_api_version":    served,
+			})
+		})
+	}
+
+	return resp, nil
+}
+
+// APIVersionMismatch returns the version of the Prefect API requested and the
+// one reported by the server in the latest response, and whether they differ.
+// It reports no mismatch until the server has reported a version.
+func (c *Client) APIVersionMismatch() (string, string, bool) {
+	if c.apiVersionTransport == nil {
+		return c.apiVersion, "", false
+	}
+
+	c.apiVersionTransport.mu.Lock()
+	defer c.apiVersionTransport.mu.Unlock()
+
+	served := c.apiVersionTransport.served
+
+	return c.apiVersion, served, served != "" && served != c.apiVersion
+}
+
+// WithAPIVersion configures the version of the Prefect API requested with
+// every request, in the APIVersionHeader. Defaults to DefaultAPIVersion,
+// and an empty version omits the header.
+func WithAPIVersion(version string) Option {
+	return func(client *Client) error {
+		client.apiVersion = version
+
+		return nil
+	}
+}
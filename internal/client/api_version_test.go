@@ -0,0 +1,137 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_WithAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts     []client.Option
+		expected string
+	}{
+		"default": {
+			expected: client.DefaultAPIVersion,
+		},
+		"pinned": {
+			opts:     []client.Option{client.WithAPIVersion("0.8.0")},
+			expected: "0.8.0",
+		},
+		"omitted": {
+			opts:     []client.Option{client.WithAPIVersion("")},
+			expected: "",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var apiVersion string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				apiVersion = r.Header.Get(client.APIVersionHeader)
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(api.Workspace{})
+			}))
+			t.Cleanup(server.Close)
+
+			opts := append([]client.Option{
+				client.WithEndpoint(server.URL + "/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			}, test.opts...)
+
+			prefectClient, err := client.New(opts...)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create workspaces client: %s", err)
+			}
+
+			if _, err := workspacesClient.Get(context.Background(), uuid.New()); err != nil {
+				t.Fatalf("failed to get workspace: %s", err)
+			}
+
+			if apiVersion != test.expected {
+				t.Errorf("expected %s %q, got %q", client.APIVersionHeader, test.expected, apiVersion)
+			}
+		})
+	}
+}
+
+func TestClient_WithAPIVersion_warnsOnMismatch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(client.APIVersionHeader, "0.9.0")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Workspace{})
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithAPIVersion("0.8.4"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	// The mismatch is only reported once per client.
+	for i := 0; i < 2; i++ {
+		if _, err := workspacesClient.Get(ctx, uuid.New()); err != nil {
+			t.Fatalf("failed to get workspace: %s", err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log entries: %s", err)
+	}
+
+	var warnings []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@level"] == "warn" {
+			warnings = append(warnings, entry)
+		}
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 API version warning, got %d: %v", len(warnings), warnings)
+	}
+
+	if warnings[0]["requested_api_version"] != "0.8.4" || warnings[0]["served_api_version"] != "0.9.0" {
+		t.Errorf("expected API versions 0.8.4 and 0.9.0 to be logged, got %v", warnings[0])
+	}
+
+	// The mismatch is also recorded, so that the provider can warn about it.
+	requested, served, mismatch := prefectClient.APIVersionMismatch()
+	if requested != "0.8.4" || served != "0.9.0" || !mismatch {
+		t.Errorf("expected a mismatch between API versions 0.8.4 and 0.9.0, got %q and %q (mismatch %t)", requested, served, mismatch)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.ArtifactsClient(&ArtifactsClient{})
+
+// ArtifactsClient is a client for working with artifacts.
+type ArtifactsClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// Artifacts returns an ArtifactsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Artifacts(accountID uuid.UUID, workspaceID uuid.UUID) (api.ArtifactsClient, error) {
+	key := subClientKey{kind: "artifacts", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.ArtifactsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.ArtifactsClient](c, key, &ArtifactsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "artifacts"),
+	}), nil
+}
+
+// Get returns details for an artifact by ID.
+func (c *ArtifactsClient) Get(ctx context.Context, artifactID uuid.UUID) (*api.Artifact, error) {
+	artifact, err := c.get(ctx, c.routePrefix+"/"+artifactID.String())
+	if err != nil {
+		return nil, fmt.Errorf("artifact id=%s: %w", artifactID, err)
+	}
+
+	return artifact, nil
+}
+
+// GetLatestByKey returns details for the most recent artifact with the given key.
+func (c *ArtifactsClient) GetLatestByKey(ctx context.Context, key string) (*api.Artifact, error) {
+	artifact, err := c.get(ctx, c.routePrefix+"/"+url.PathEscape(key)+"/latest")
+	if err != nil {
+		return nil, fmt.Errorf("artifact key=%s: %w", key, err)
+	}
+
+	return artifact, nil
+}
+
+// get fetches a single artifact from the given URL.
+func (c *ArtifactsClient) get(ctx context.Context, reqURL string) (*api.Artifact, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, api.ErrNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var artifact api.Artifact
+	if err := json.NewDecoder(resp.Body).Decode(&artifact); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &artifact, nil
+}
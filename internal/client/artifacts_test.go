@@ -0,0 +1,65 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestArtifactsClient_GetLatestByKey(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/artifacts/daily-report/latest" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "` + uuid.NewString() + `",
+			"key": "daily-report",
+			"type": "table",
+			"data": [{"name": "rows", "value": 42}],
+			"flow_run_id": "` + uuid.NewString() + `"
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	artifactsClient, err := prefectClient.Artifacts(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create artifacts client: %s", err)
+	}
+
+	artifact, err := artifactsClient.GetLatestByKey(context.Background(), "daily-report")
+	if err != nil {
+		t.Fatalf("failed to get artifact by key: %s", err)
+	}
+
+	if artifact.Type == nil || *artifact.Type != "table" {
+		t.Errorf("expected artifact type table, got: %v", artifact.Type)
+	}
+
+	if rows, ok := artifact.Data.([]any); !ok || len(rows) != 1 {
+		t.Errorf("expected a single table row, got: %v", artifact.Data)
+	}
+
+	if artifact.FlowRunID == nil {
+		t.Error("expected the flow run ID to be set")
+	}
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.AutomationsClient(&AutomationsClient{})
+
+// AutomationsClient is a client for working with automations.
+type AutomationsClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// Automations returns an AutomationsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Automations(accountID uuid.UUID, workspaceID uuid.UUID) (api.AutomationsClient, error) {
+	key := subClientKey{kind: "automations", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.AutomationsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.AutomationsClient](c, key, &AutomationsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "automations"),
+	}), nil
+}
+
+// Create returns details for a new automation.
+func (c *AutomationsClient) Create(ctx context.Context, data api.AutomationUpsert) (*api.Automation, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
+	}
+
+	var automation api.Automation
+	if err := json.NewDecoder(resp.Body).Decode(&automation); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &automation, nil
+}
+
+// Get returns details for an automation by ID.
+func (c *AutomationsClient) Get(ctx context.Context, automationID uuid.UUID) (*api.Automation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+automationID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("automation id=%s: %w", automationID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var automation api.Automation
+	if err := json.NewDecoder(resp.Body).Decode(&automation); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &automation, nil
+}
+
+// Update replaces an existing automation by ID.
+func (c *AutomationsClient) Update(ctx context.Context, automationID uuid.UUID, data api.AutomationUpsert) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.routePrefix+"/"+automationID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes an automation by ID.
+func (c *AutomationsClient) Delete(ctx context.Context, automationID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+automationID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
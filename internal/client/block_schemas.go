@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.BlockSchemasClient(&BlockSchemasClient{})
+
+// BlockSchemasClient is a client for working with block schemas.
+type BlockSchemasClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// BlockSchemas returns a BlockSchemasClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) BlockSchemas(accountID uuid.UUID, workspaceID uuid.UUID) (api.BlockSchemasClient, error) {
+	key := subClientKey{kind: "block_schemas", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.BlockSchemasClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.BlockSchemasClient](c, key, newBlockSchemasClient(c, accountID, workspaceID)), nil
+}
+
+// newBlockSchemasClient returns a BlockSchemasClient for an already resolved
+// account and workspace, so that it can also back the BlockTypesClient.
+func newBlockSchemasClient(c *Client, accountID uuid.UUID, workspaceID uuid.UUID) *BlockSchemasClient {
+	return &BlockSchemasClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_schemas"),
+	}
+}
+
+// GetByBlockType returns the block schema of a block type at version,
+// or the most recently registered one if version is empty.
+func (c *BlockSchemasClient) GetByBlockType(ctx context.Context, blockTypeID uuid.UUID, version string) (*api.BlockSchema, error) {
+	filter := api.BlockSchemaFilterSettings{
+		Limit: 1,
+		BlockSchemas: &api.BlockSchemaFilter{
+			BlockTypeID: &api.BlockSchemaFilterBlockTypeID{Any: []uuid.UUID{blockTypeID}},
+		},
+	}
+	if version != "" {
+		filter.BlockSchemas.Version = &api.BlockSchemaFilterVersion{Any: []string{version}}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
+		return nil, fmt.Errorf("failed to encode filter: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/filter", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	// Block schemas are returned newest first.
+	var blockSchemas []api.BlockSchema
+	if err := json.NewDecoder(resp.Body).Decode(&blockSchemas); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(blockSchemas) == 0 {
+		if version != "" {
+			return nil, fmt.Errorf("block schema for block type id=%s version=%s: %w", blockTypeID, version, api.ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("block schema for block type id=%s: %w", blockTypeID, api.ErrNotFound)
+	}
+
+	return &blockSchemas[0], nil
+}
@@ -0,0 +1,115 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestBlockSchemasClient_GetByBlockType(t *testing.T) {
+	t.Parallel()
+
+	blockTypeID := uuid.New()
+	blockSchemaID := uuid.New()
+
+	tests := map[string]struct {
+		version     string
+		body        string
+		expectedErr error
+	}{
+		"latest version": {
+			body: `[{"id":"` + blockSchemaID.String() + `","block_type_id":"` + blockTypeID.String() + `","version":"2.20.0",` +
+				`"fields":{"title":"Secret","required":["value"]},"capabilities":[]}]`,
+		},
+		"pinned version": {
+			version: "2.20.0",
+			body: `[{"id":"` + blockSchemaID.String() + `","block_type_id":"` + blockTypeID.String() + `","version":"2.20.0",` +
+				`"fields":{"title":"Secret","required":["value"]},"capabilities":[]}]`,
+		},
+		"unknown version": {
+			version:     "0.0.0",
+			body:        `[]`,
+			expectedErr: api.ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/block_schemas/filter" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+
+				var filter api.BlockSchemaFilterSettings
+				if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+					t.Errorf("failed to decode filter: %s", err)
+
+					return
+				}
+
+				if ids := filter.BlockSchemas.BlockTypeID.Any; len(ids) != 1 || ids[0] != blockTypeID {
+					t.Errorf("expected block_type_id filter on %s, got %v", blockTypeID, ids)
+				}
+
+				switch version := filter.BlockSchemas.Version; {
+				case test.version == "" && version != nil:
+					t.Errorf("expected no version filter, got %v", version.Any)
+				case test.version != "" && (version == nil || len(version.Any) != 1 || version.Any[0] != test.version):
+					t.Errorf("expected version filter on %s, got %v", test.version, version)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			blockSchemasClient, err := prefectClient.BlockSchemas(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create block schemas client: %s", err)
+			}
+
+			blockSchema, err := blockSchemasClient.GetByBlockType(context.Background(), blockTypeID, test.version)
+			if test.expectedErr != nil {
+				if !errors.Is(err, test.expectedErr) {
+					t.Errorf("expected %v, got: %v", test.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to get block schema: %s", err)
+			}
+
+			if blockSchema.ID != blockSchemaID || blockSchema.Version != "2.20.0" {
+				t.Errorf("expected block schema %s at version 2.20.0, got %s at version %s", blockSchemaID, blockSchema.ID, blockSchema.Version)
+			}
+
+			if blockSchema.Fields["title"] != "Secret" {
+				t.Errorf("expected the fields to be decoded, got %v", blockSchema.Fields)
+			}
+		})
+	}
+}
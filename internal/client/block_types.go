@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.BlockTypesClient(&BlockTypesClient{})
+
+// BlockTypesClient is a client for working with block types and their schemas.
+type BlockTypesClient struct {
+	hc           *http.Client
+	routePrefix  string
+	apiKey       string
+	blockSchemas *BlockSchemasClient
+}
+
+// BlockTypes returns a BlockTypesClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) BlockTypes(accountID uuid.UUID, workspaceID uuid.UUID) (api.BlockTypesClient, error) {
+	key := subClientKey{kind: "block_types", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.BlockTypesClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.BlockTypesClient](c, key, newBlockTypesClient(c, accountID, workspaceID)), nil
+}
+
+// newBlockTypesClient returns a BlockTypesClient for an already resolved
+// account and workspace, so that it can also back the BlocksClient.
+func newBlockTypesClient(c *Client, accountID uuid.UUID, workspaceID uuid.UUID) *BlockTypesClient {
+	return &BlockTypesClient{
+		hc:           c.hc,
+		apiKey:       c.apiKey,
+		routePrefix:  getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_types"),
+		blockSchemas: newBlockSchemasClient(c, accountID, workspaceID),
+	}
+}
+
+// GetBySlug returns details for a block type by slug.
+func (c *BlockTypesClient) GetBySlug(ctx context.Context, slug string) (*api.BlockType, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/slug/"+url.PathEscape(slug), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("block type slug=%s: %w", slug, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var blockType api.BlockType
+	if err := json.NewDecoder(resp.Body).Decode(&blockType); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &blockType, nil
+}
+
+// GetLatestBlockSchema returns the most recently registered
+// block schema of a block type.
+func (c *BlockTypesClient) GetLatestBlockSchema(ctx context.Context, blockTypeID uuid.UUID) (*api.BlockSchema, error) {
+	return c.blockSchemas.GetByBlockType(ctx, blockTypeID, "")
+}
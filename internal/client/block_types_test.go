@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestBlockTypesClient_GetLatestBlockSchema(t *testing.T) {
+	t.Parallel()
+
+	blockTypeID := uuid.New()
+	blockSchemaID := uuid.New()
+
+	tests := map[string]struct {
+		body        string
+		expectedErr error
+	}{
+		"latest schema": {
+			body: `[{"id":"` + blockSchemaID.String() + `","block_type_id":"` + blockTypeID.String() + `","version":"2.20.0"},` +
+				`{"id":"` + uuid.NewString() + `","block_type_id":"` + blockTypeID.String() + `","version":"2.19.0"}]`,
+		},
+		"no schemas": {
+			body:        `[]`,
+			expectedErr: api.ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/block_schemas/filter" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			blockTypesClient, err := prefectClient.BlockTypes(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create block types client: %s", err)
+			}
+
+			blockSchema, err := blockTypesClient.GetLatestBlockSchema(context.Background(), blockTypeID)
+			if test.expectedErr != nil {
+				if !errors.Is(err, test.expectedErr) {
+					t.Errorf("expected %v, got: %v", test.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to get latest block schema: %s", err)
+			}
+
+			if blockSchema.ID != blockSchemaID || blockSchema.Version != "2.20.0" {
+				t.Errorf("expected block schema %s at version 2.20.0, got %s at version %s", blockSchemaID, blockSchema.ID, blockSchema.Version)
+			}
+		})
+	}
+}
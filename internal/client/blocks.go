@@ -0,0 +1,233 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.BlocksClient(&BlocksClient{})
+
+// BlocksClient is a client for working with block documents.
+type BlocksClient struct {
+	hc              *http.Client
+	routePrefix     string
+	blockTypesRoute string
+	apiKey          string
+
+	// blockTypes resolves block type slugs when creating block documents.
+	blockTypes *BlockTypesClient
+}
+
+// blockDocumentCreate is the payload sent when creating a block document,
+// once the block type slug has been resolved.
+type blockDocumentCreate struct {
+	Name          string                 `json:"name"`
+	Data          map[string]interface{} `json:"data"`
+	BlockSchemaID uuid.UUID              `json:"block_schema_id"`
+	BlockTypeID   uuid.UUID              `json:"block_type_id"`
+}
+
+// Blocks returns a BlocksClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Blocks(accountID uuid.UUID, workspaceID uuid.UUID) (api.BlocksClient, error) {
+	key := subClientKey{kind: "blocks", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.BlocksClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.BlocksClient](c, key, &BlocksClient{
+		hc:              c.hc,
+		apiKey:          c.apiKey,
+		routePrefix:     getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_documents"),
+		blockTypesRoute: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "block_types"),
+		blockTypes:      newBlockTypesClient(c, accountID, workspaceID),
+	}), nil
+}
+
+// Create returns details for a new block document.
+func (c *BlocksClient) Create(ctx context.Context, data api.BlockDocumentCreate) (*api.BlockDocument, error) {
+	blockType, err := c.blockTypes.GetBySlug(ctx, data.BlockTypeSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	blockSchema, err := c.blockTypes.GetLatestBlockSchema(ctx, blockType.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(blockDocumentCreate{
+		Name:          data.Name,
+		Data:          data.Data,
+		BlockSchemaID: blockSchema.ID,
+		BlockTypeID:   blockType.ID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
+	}
+
+	var blockDocument api.BlockDocument
+	if err := json.NewDecoder(resp.Body).Decode(&blockDocument); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &blockDocument, nil
+}
+
+// Get returns details for a block document by ID.
+//
+// Secret fields are returned in plain text, so that they
+// can be compared against the configured values.
+func (c *BlocksClient) Get(ctx context.Context, blockDocumentID uuid.UUID) (*api.BlockDocument, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+blockDocumentID.String()+"?include_secrets=true", http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("block document id=%s: %w", blockDocumentID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var blockDocument api.BlockDocument
+	if err := json.NewDecoder(resp.Body).Decode(&blockDocument); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &blockDocument, nil
+}
+
+// GetByName returns details for a block document by block type slug and name.
+//
+// Secret fields are redacted by the server, as this is used
+// to reference block documents that the provider does not manage.
+func (c *BlocksClient) GetByName(ctx context.Context, blockTypeSlug string, name string) (*api.BlockDocument, error) {
+	route := c.blockTypesRoute + "/slug/" + url.PathEscape(blockTypeSlug) + "/block_documents/name/" + url.PathEscape(name) + "?include_secrets=false"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, route, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("block document block_type_slug=%s name=%s: %w", blockTypeSlug, name, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var blockDocument api.BlockDocument
+	if err := json.NewDecoder(resp.Body).Decode(&blockDocument); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &blockDocument, nil
+}
+
+// Update modifies an existing block document by ID.
+func (c *BlocksClient) Update(ctx context.Context, blockDocumentID uuid.UUID, data api.BlockDocumentUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.routePrefix+"/"+blockDocumentID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a block document by ID.
+func (c *BlocksClient) Delete(ctx context.Context, blockDocumentID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+blockDocumentID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestBlocksClient_Create_resolvesBlockType(t *testing.T) {
+	t.Parallel()
+
+	blockTypeID := uuid.New()
+	blockSchemaID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/block_types/slug/secret":
+			_ = json.NewEncoder(w).Encode(api.BlockType{BaseModel: api.BaseModel{ID: blockTypeID}, Slug: "secret"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/block_schemas/filter":
+			_ = json.NewEncoder(w).Encode([]api.BlockSchema{{BaseModel: api.BaseModel{ID: blockSchemaID}, BlockTypeID: blockTypeID}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/block_documents/":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %s", err)
+			}
+
+			if body["block_type_id"] != blockTypeID.String() || body["block_schema_id"] != blockSchemaID.String() {
+				t.Errorf("expected block type %s and schema %s, got: %v", blockTypeID, blockSchemaID, body)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(api.BlockDocument{
+				BaseModel:     api.BaseModel{ID: uuid.New()},
+				Name:          "my-secret",
+				BlockTypeID:   blockTypeID,
+				BlockSchemaID: blockSchemaID,
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	blocksClient, err := prefectClient.Blocks(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create blocks client: %s", err)
+	}
+
+	blockDocument, err := blocksClient.Create(context.Background(), api.BlockDocumentCreate{
+		Name:          "my-secret",
+		BlockTypeSlug: "secret",
+		Data:          map[string]interface{}{"value": "hunter2"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create block document: %s", err)
+	}
+
+	if blockDocument.BlockSchemaID != blockSchemaID {
+		t.Errorf("expected block schema %s, got %s", blockSchemaID, blockDocument.BlockSchemaID)
+	}
+}
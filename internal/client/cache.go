@@ -0,0 +1,60 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// subClientKey identifies a sub-client by the factory that
+// constructed it and the arguments it was constructed with.
+type subClientKey struct {
+	kind        string
+	accountID   uuid.UUID
+	workspaceID uuid.UUID
+	name        string
+}
+
+// subClientCache memoizes sub-clients, which are safe to share as they
+// hold no state beyond the configuration of the Client, along with the
+// account IDs resolved from account handles. It is safe for concurrent
+// use, as Terraform operates on resources in parallel.
+type subClientCache struct {
+	clients sync.Map
+
+	// accountIDs maps account handles to account IDs.
+	accountIDs sync.Map
+}
+
+// loadSubClient returns the cached sub-client for key, if any.
+func loadSubClient[T any](c *Client, key subClientKey) (T, bool) {
+	var zero T
+	if c.subClients == nil {
+		return zero, false
+	}
+
+	cached, ok := c.subClients.clients.Load(key)
+	if !ok {
+		return zero, false
+	}
+
+	subClient, ok := cached.(T)
+
+	return subClient, ok
+}
+
+// storeSubClient caches subClient for key and returns the cached
+// sub-client, which is a previously cached one if another goroutine
+// constructed the same sub-client concurrently.
+func storeSubClient[T any](c *Client, key subClientKey, subClient T) T {
+	if c.subClients == nil {
+		return subClient
+	}
+
+	cached, _ := c.subClients.clients.LoadOrStore(key, subClient)
+	if existing, ok := cached.(T); ok {
+		return existing
+	}
+
+	return subClient
+}
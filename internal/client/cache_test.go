@@ -0,0 +1,118 @@
+package client_test
+
+import (
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_subClientsAreCached(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	prefectClient, err := client.New(
+		client.WithEndpoint("https://api.prefect.cloud/api"),
+		client.WithDefaults(accountID, uuid.New()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	// Sub-clients are requested concurrently, as Terraform
+	// operates on resources in parallel.
+	const goroutines = 50
+	workPoolsClients := make([]api.WorkPoolsClient, goroutines)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			workPoolsClients[i], _ = prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, workPoolsClient := range workPoolsClients {
+		if workPoolsClient == nil || workPoolsClient != workPoolsClients[0] {
+			t.Fatalf("expected the same cached work pools client, got a different one at %d", i)
+		}
+	}
+
+	otherWorkPoolsClient, err := prefectClient.WorkPools(accountID, uuid.New())
+	if err != nil {
+		t.Fatalf("failed to create work pools client: %s", err)
+	}
+	if otherWorkPoolsClient == workPoolsClients[0] {
+		t.Errorf("expected a different work pools client for a different workspace")
+	}
+
+	workQueuesClient, _ := prefectClient.WorkQueues(uuid.Nil, uuid.Nil, "pool")
+	otherWorkQueuesClient, _ := prefectClient.WorkQueues(uuid.Nil, uuid.Nil, "other-pool")
+	if workQueuesClient == otherWorkQueuesClient {
+		t.Errorf("expected a different work queues client for a different work pool")
+	}
+}
+
+func TestClient_subClientErrorsAreNotCached(t *testing.T) {
+	t.Parallel()
+
+	prefectClient, err := client.New(client.WithEndpoint("https://api.prefect.cloud/api"))
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil); err == nil {
+			t.Fatalf("expected an error without a workspace scope on attempt %d", i+1)
+		}
+	}
+}
+
+// BenchmarkClient_subClients compares requesting the sub-client of 100
+// resource reads in the same workspace, which is served from the cache,
+// with requesting one for 100 different workspaces.
+func BenchmarkClient_subClients(b *testing.B) {
+	const reads = 100
+
+	accountID := uuid.New()
+	workspaceIDs := make([]uuid.UUID, reads)
+	for i := range workspaceIDs {
+		workspaceIDs[i] = uuid.New()
+	}
+
+	b.Run("same_workspace", func(b *testing.B) {
+		prefectClient := client.MustNew(client.WithEndpoint("https://api.prefect.cloud/api"))
+
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < reads; i++ {
+				_, _ = prefectClient.Workspaces(accountID)
+				_, _ = prefectClient.WorkPools(accountID, workspaceIDs[0])
+			}
+		}
+	})
+
+	b.Run("distinct_workspaces", func(b *testing.B) {
+		prefectClient := client.MustNew(client.WithEndpoint("https://api.prefect.cloud/api"))
+
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for i := 0; i < reads; i++ {
+				// The iteration is encoded in the workspace ID,
+				// so that no sub-client is cached
+				workspaceID := workspaceIDs[i]
+				binary.BigEndian.PutUint64(workspaceID[8:], uint64(n))
+
+				_, _ = prefectClient.Workspaces(accountID)
+				_, _ = prefectClient.WorkPools(accountID, workspaceID)
+			}
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,7 +18,13 @@ var _ = api.PrefectClient(&Client{})
 // New creates and returns new client instance.
 func New(opts ...Option) (*Client, error) {
 	client := &Client{
-		hc: http.DefaultClient,
+		hc:             http.DefaultClient,
+		maxRetries:     DefaultMaxRetries,
+		retryBaseDelay: DefaultRetryBaseDelay,
+		requestTimeout: DefaultRequestTimeout,
+		apiVersion:     DefaultAPIVersion,
+		logBodies:      bodyLoggingFromEnv(),
+		subClients:     &subClientCache{},
 	}
 
 	var errs []error
@@ -33,9 +40,77 @@ func New(opts ...Option) (*Client, error) {
 		return nil, errors.Join(errs...)
 	}
 
+	// The base path is prepended to every route, so we'll
+	// compose it with the endpoint once all options are applied.
+	client.endpoint += client.basePath
+
+	// Wrap the configured http.Client's transport with request logging,
+	// custom headers (including the User-Agent and API version), and retry
+	// logic, copying the http.Client so that shared clients (such as
+	// http.DefaultClient) are not modified.
+	// Logging sits below the retries, so that every attempt is logged.
+	hc := *client.hc
+	if client.proxyURL != nil || client.tlsConfig != nil {
+		transport, err := baseTransport(hc.Transport)
+		if err != nil {
+			return nil, err
+		}
+
+		if client.proxyURL != nil {
+			transport.Proxy = proxyFunc(client.proxyURL)
+		}
+
+		if client.tlsConfig != nil {
+			transport.TLSClientConfig = client.tlsConfig
+		}
+
+		hc.Transport = transport
+	}
+	// The custom headers are redacted from the logs. They are passed before the
+	// User-Agent and API version are added to them, which are logged as-is.
+	hc.Transport = newLoggingTransport(hc.Transport, client.headers, client.logBodies)
+	if client.userAgent != "" {
+		if client.headers == nil {
+			client.headers = make(http.Header, 1)
+		}
+
+		client.headers.Set("User-Agent", client.userAgent)
+	}
+	if client.apiVersion != "" {
+		if client.headers == nil {
+			client.headers = make(http.Header, 1)
+		}
+
+		client.headers.Set(APIVersionHeader, client.apiVersion)
+		client.apiVersionTransport = newAPIVersionTransport(hc.Transport, client.apiVersion)
+		hc.Transport = client.apiVersionTransport
+	}
+	if len(client.headers) > 0 {
+		hc.Transport = newHeadersTransport(hc.Transport, client.headers)
+	}
+	// The deadline sits below the rate limit, so that waiting for a token
+	// is not counted against it, and is applied on every attempt.
+	if client.requestTimeout > 0 {
+		hc.Transport = newTimeoutTransport(hc.Transport, client.requestTimeout)
+	}
+	// The rate limit sits below the retries, so that every attempt waits for a token.
+	if client.requestsPerSecond > 0 {
+		hc.Transport = newRateLimitTransport(hc.Transport, client.requestsPerSecond)
+	}
+	if client.maxRetries > 0 {
+		hc.Transport = newRetryTransport(hc.Transport, client.maxRetries, client.retryBaseDelay)
+	}
+	client.hc = &hc
+
 	return client, nil
 }
 
+// DefaultAccountID returns the account ID used by clients created without one,
+// or uuid.Nil if no default account is configured.
+func (c *Client) DefaultAccountID() uuid.UUID {
+	return c.defaultAccountID
+}
+
 // MustNew returns a new client or panics if an error occurred.
 func MustNew(opts ...Option) *Client {
 	client, err := New(opts...)
@@ -60,7 +135,7 @@ func WithClient(httpClient *http.Client) Option {
 // Prefect server or Prefect Cloud.
 func WithEndpoint(endpoint string) Option {
 	return func(client *Client) error {
-		_, err := url.Parse(endpoint)
+		endpointURL, err := url.Parse(endpoint)
 		if err != nil {
 			return fmt.Errorf("endpoint is not a valid url: %w", err)
 		}
@@ -70,6 +145,26 @@ func WithEndpoint(endpoint string) Option {
 		}
 
 		client.endpoint = endpoint
+		client.ossMode = !IsPrefectCloudHost(endpointURL.Host)
+
+		return nil
+	}
+}
+
+// WithBasePath configures a path prefix, such as /api, that is prepended
+// to every request path after the endpoint. This supports Prefect servers
+// fronted by an API gateway that serves them under a custom prefix.
+func WithBasePath(basePath string) Option {
+	return func(client *Client) error {
+		if basePath != "" && !strings.HasPrefix(basePath, "/") {
+			return fmt.Errorf("basePath %q must start with a slash", basePath)
+		}
+
+		if strings.HasSuffix(basePath, "/") {
+			return fmt.Errorf("basePath %q must not include trailing slash", basePath)
+		}
+
+		client.basePath = basePath
 
 		return nil
 	}
@@ -84,9 +179,35 @@ func WithAPIKey(apiKey string) Option {
 	}
 }
 
+// WithRetries configures how many times, and with what initial delay,
+// requests that fail with a transient error are retried.
+// Setting maxRetries to 0 disables retries.
+func WithRetries(maxRetries int, baseDelay time.Duration) Option {
+	return func(client *Client) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("maxRetries must not be negative: maxRetries is %d", maxRetries)
+		}
+
+		if baseDelay <= 0 {
+			return fmt.Errorf("baseDelay must be positive: baseDelay is %s", baseDelay)
+		}
+
+		client.maxRetries = maxRetries
+		client.retryBaseDelay = baseDelay
+
+		return nil
+	}
+}
+
 // WithDefaults configures the default account and workspace ID.
+// It must be applied after WithEndpoint, as account and workspace IDs
+// are not supported when targeting a self-hosted Prefect server.
 func WithDefaults(accountID uuid.UUID, workspaceID uuid.UUID) Option {
 	return func(client *Client) error {
+		if client.ossMode && (accountID != uuid.Nil || workspaceID != uuid.Nil) {
+			return fmt.Errorf("accountID and workspaceID cannot be set when targeting a self-hosted Prefect server: accountID is %q and workspaceID is %q", accountID, workspaceID)
+		}
+
 		if accountID == uuid.Nil && workspaceID != uuid.Nil {
 			return fmt.Errorf("an accountID must be set if a workspaceID is set: accountID is %q and workspaceID is %q", accountID, workspaceID)
 		}
@@ -0,0 +1,99 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_WithBasePath(t *testing.T) {
+	t.Parallel()
+
+	workspaceID := uuid.New()
+
+	var requestPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Workspace{BaseModel: api.BaseModel{ID: workspaceID}})
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL),
+		client.WithBasePath("/prefect/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	if _, err := workspacesClient.Get(context.Background(), workspaceID); err != nil {
+		t.Fatalf("failed to get workspace: %s", err)
+	}
+
+	// The test server is self-hosted, so the route is not account-scoped.
+	expected := "/prefect/api/workspaces/" + workspaceID.String()
+	if requestPath != expected {
+		t.Errorf("expected request path %s, got %s", expected, requestPath)
+	}
+}
+
+func TestClient_WithBasePath_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, basePath := range []string{"api", "/api/"} {
+		if _, err := client.New(client.WithBasePath(basePath)); err == nil {
+			t.Errorf("expected an error for basePath %q", basePath)
+		}
+	}
+}
+
+func TestClient_WithUserAgent(t *testing.T) {
+	t.Parallel()
+
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Workspace{})
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithUserAgent("terraform-provider-prefect/1.2.3 my-pipeline"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	if _, err := workspacesClient.Get(context.Background(), uuid.New()); err != nil {
+		t.Fatalf("failed to get workspace: %s", err)
+	}
+
+	expected := "terraform-provider-prefect/1.2.3 my-pipeline"
+	if userAgent != expected {
+		t.Errorf("expected User-Agent %q, got %q", expected, userAgent)
+	}
+}
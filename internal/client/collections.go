@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -22,11 +21,16 @@ type CollectionsClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) Collections() (api.CollectionsClient, error) {
-	return &CollectionsClient{
+	key := subClientKey{kind: "collections"}
+	if cached, ok := loadSubClient[api.CollectionsClient](c, key); ok {
+		return cached, nil
+	}
+
+	return storeSubClient[api.CollectionsClient](c, key, &CollectionsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: fmt.Sprintf("%s/collections", c.endpoint),
-	}, nil
+	}), nil
 }
 
 // GetWorkerMetadataViews returns a map of worker metadata views by prefect package name.
@@ -45,9 +49,7 @@ func (c *CollectionsClient) GetWorkerMetadataViews(ctx context.Context) (api.Wor
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workerTypeByPackage api.WorkerTypeByPackage
@@ -57,3 +59,30 @@ func (c *CollectionsClient) GetWorkerMetadataViews(ctx context.Context) (api.Wor
 
 	return workerTypeByPackage, nil
 }
+
+// GetBlockMetadataViews returns a map of block type metadata views by prefect package name.
+// This endpoint serves the block types registered by each collection, along with the collection version.
+func (c *CollectionsClient) GetBlockMetadataViews(ctx context.Context) (api.BlockTypesByPackage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/views/aggregate-block-metadata", c.routePrefix), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var blockTypesByPackage api.BlockTypesByPackage
+	if err := json.NewDecoder(resp.Body).Decode(&blockTypesByPackage); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return blockTypesByPackage, nil
+}
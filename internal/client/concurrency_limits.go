@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.ConcurrencyLimitsClient(&ConcurrencyLimitsClient{})
+
+// ConcurrencyLimitsClient is a client for working with concurrency limits.
+type ConcurrencyLimitsClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// ConcurrencyLimits returns a ConcurrencyLimitsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) ConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (api.ConcurrencyLimitsClient, error) {
+	key := subClientKey{kind: "concurrency_limits", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.ConcurrencyLimitsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.ConcurrencyLimitsClient](c, key, &ConcurrencyLimitsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "concurrency_limits"),
+	}), nil
+}
+
+// Create returns details for a new concurrency limit.
+func (c *ConcurrencyLimitsClient) Create(ctx context.Context, data api.ConcurrencyLimitCreate) (*api.ConcurrencyLimit, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The server upserts concurrency limits by tag, responding
+	// with 200 rather than 201 when the tag already has a limit.
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var concurrencyLimit api.ConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&concurrencyLimit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &concurrencyLimit, nil
+}
+
+// Get returns details for a concurrency limit by ID.
+func (c *ConcurrencyLimitsClient) Get(ctx context.Context, concurrencyLimitID uuid.UUID) (*api.ConcurrencyLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+concurrencyLimitID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("concurrency limit id=%s: %w", concurrencyLimitID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var concurrencyLimit api.ConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&concurrencyLimit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &concurrencyLimit, nil
+}
+
+// GetByTag returns details for a concurrency limit by tag.
+func (c *ConcurrencyLimitsClient) GetByTag(ctx context.Context, tag string) (*api.ConcurrencyLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/tag/"+url.PathEscape(tag), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("concurrency limit tag=%s: %w", tag, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var concurrencyLimit api.ConcurrencyLimit
+	if err := json.NewDecoder(resp.Body).Decode(&concurrencyLimit); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &concurrencyLimit, nil
+}
+
+// Delete removes a concurrency limit by ID.
+func (c *ConcurrencyLimitsClient) Delete(ctx context.Context, concurrencyLimitID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+concurrencyLimitID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,450 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.DeploymentsClient(&DeploymentsClient{})
+
+// DeploymentsClient is a client for working with deployments.
+type DeploymentsClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// Deployments returns a DeploymentsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Deployments(accountID uuid.UUID, workspaceID uuid.UUID) (api.DeploymentsClient, error) {
+	key := subClientKey{kind: "deployments", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.DeploymentsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.DeploymentsClient](c, key, &DeploymentsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "deployments"),
+	}), nil
+}
+
+// Create returns details for a new deployment.
+func (c *DeploymentsClient) Create(ctx context.Context, data api.DeploymentCreate) (*api.Deployment, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The server upserts deployments by flow and name, responding
+	// with 200 rather than 201 when the deployment already exists.
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var deployment api.Deployment
+	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &deployment, nil
+}
+
+// Get returns details for a deployment by ID.
+func (c *DeploymentsClient) Get(ctx context.Context, deploymentID uuid.UUID) (*api.Deployment, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+deploymentID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("deployment id=%s: %w", deploymentID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var deployment api.Deployment
+	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &deployment, nil
+}
+
+// GetByName returns details for a deployment by the name of its flow and its own name,
+// which together uniquely identify a deployment within a workspace.
+func (c *DeploymentsClient) GetByName(ctx context.Context, flowName string, deploymentName string) (*api.Deployment, error) {
+	reqURL := c.routePrefix + "/name/" + url.PathEscape(flowName) + "/" + url.PathEscape(deploymentName)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("deployment name=%s/%s: %w", flowName, deploymentName, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var deployment api.Deployment
+	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &deployment, nil
+}
+
+// List returns every deployment in the workspace, sorted by name.
+// Results are paginated until all deployments have been retrieved.
+func (c *DeploymentsClient) List(ctx context.Context) ([]*api.Deployment, error) {
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.Deployment, error) {
+		return c.listPage(ctx, api.DeploymentFilterSettings{
+			Limit:  limit,
+			Offset: offset,
+			Sort:   "NAME_ASC",
+		})
+	})
+}
+
+// listPage returns a single page of deployments for the provided filter.
+func (c *DeploymentsClient) listPage(ctx context.Context, filter api.DeploymentFilterSettings) ([]*api.Deployment, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
+		return nil, fmt.Errorf("failed to encode filter: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/filter", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var deployments []*api.Deployment
+	if err := json.NewDecoder(resp.Body).Decode(&deployments); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return deployments, nil
+}
+
+// Update modifies an existing deployment by ID.
+func (c *DeploymentsClient) Update(ctx context.Context, deploymentID uuid.UUID, data api.DeploymentUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.routePrefix+"/"+deploymentID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a deployment by ID.
+func (c *DeploymentsClient) Delete(ctx context.Context, deploymentID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+deploymentID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// CreateSchedule adds a schedule to a deployment.
+func (c *DeploymentsClient) CreateSchedule(ctx context.Context, deploymentID uuid.UUID, data api.DeploymentScheduleUpsert) (*api.DeploymentSchedule, error) {
+	// The server accepts a batch of schedules, so we send a batch of one.
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode([]api.DeploymentScheduleUpsert{data}); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/"+deploymentID.String()+"/schedules", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var schedules []api.DeploymentSchedule
+	if err := json.NewDecoder(resp.Body).Decode(&schedules); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(schedules) != 1 {
+		return nil, fmt.Errorf("expected 1 schedule in response, got %d", len(schedules))
+	}
+
+	return &schedules[0], nil
+}
+
+// GetSchedule returns details for a schedule of a deployment by ID.
+func (c *DeploymentsClient) GetSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID) (*api.DeploymentSchedule, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+deploymentID.String()+"/schedules", http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("deployment id=%s: %w", deploymentID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	// There is no route to read a single schedule,
+	// so we look it up among the schedules of the deployment.
+	var schedules []api.DeploymentSchedule
+	if err := json.NewDecoder(resp.Body).Decode(&schedules); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	for i := range schedules {
+		if schedules[i].ID == scheduleID {
+			return &schedules[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("deployment schedule id=%s: %w", scheduleID, api.ErrNotFound)
+}
+
+// UpdateSchedule modifies an existing schedule of a deployment by ID.
+func (c *DeploymentsClient) UpdateSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID, data api.DeploymentScheduleUpsert) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.routePrefix+"/"+deploymentID.String()+"/schedules/"+scheduleID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// DeleteSchedule removes a schedule from a deployment by ID.
+func (c *DeploymentsClient) DeleteSchedule(ctx context.Context, deploymentID uuid.UUID, scheduleID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+deploymentID.String()+"/schedules/"+scheduleID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// GetAccess returns the access control of a deployment by ID.
+func (c *DeploymentsClient) GetAccess(ctx context.Context, deploymentID uuid.UUID) (*api.ObjectAccess, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+deploymentID.String()+"/access", http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("deployment ID=%s: %w", deploymentID, api.ErrNotFound)
+	}
+
+	// Deployment-level access control is only offered on some Prefect Cloud tiers.
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, newForbiddenError(resp, "deployment access control")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var access struct {
+		// The access control is wrapped in an envelope by the API.
+		AccessControl api.ObjectAccess `json:"access_control"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&access); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &access.AccessControl, nil
+}
+
+// SetAccess replaces the access control of a deployment by ID.
+func (c *DeploymentsClient) SetAccess(ctx context.Context, deploymentID uuid.UUID, data api.ObjectAccessControl) error {
+	payload := struct {
+		AccessControl api.ObjectAccessControl `json:"access_control"`
+	}{
+		AccessControl: data,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&payload); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.routePrefix+"/"+deploymentID.String()+"/access", &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("deployment ID=%s: %w", deploymentID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		return newForbiddenError(resp, "deployment access control")
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
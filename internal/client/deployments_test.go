@@ -0,0 +1,266 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestDeploymentsClient_GetByName(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Names are path-escaped, as they may contain slashes.
+		if r.Method != http.MethodGet || r.URL.EscapedPath() != "/api/deployments/name/my-flow/team%2Fprod" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + uuid.NewString() + `","name":"team/prod","paused":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	deploymentsClient, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create deployments client: %s", err)
+	}
+
+	deployment, err := deploymentsClient.GetByName(context.Background(), "my-flow", "team/prod")
+	if err != nil {
+		t.Fatalf("failed to get deployment by name: %s", err)
+	}
+
+	if deployment.Name != "team/prod" || !deployment.Paused {
+		t.Errorf("unexpected deployment: %+v", deployment)
+	}
+}
+
+func TestDeploymentsClient_List_paginates(t *testing.T) {
+	t.Parallel()
+
+	const total = api.DefaultPageSize + 1
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/deployments/filter" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var filter api.DeploymentFilterSettings
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("failed to decode filter: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		deployments := []api.Deployment{}
+		for i := filter.Offset; i < total && i < filter.Offset+filter.Limit; i++ {
+			deployments = append(deployments, api.Deployment{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "deployment"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(deployments)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	deploymentsClient, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create deployments client: %s", err)
+	}
+
+	deployments, err := deploymentsClient.List(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list deployments: %s", err)
+	}
+
+	if len(deployments) != total {
+		t.Errorf("expected %d deployments, got %d", total, len(deployments))
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestDeploymentsClient_SetAccess(t *testing.T) {
+	t.Parallel()
+
+	deploymentID := uuid.New()
+	actorID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/deployments/"+deploymentID.String()+"/access" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload struct {
+			AccessControl api.ObjectAccessControl `json:"access_control"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode request body: %s", err)
+		}
+
+		if len(payload.AccessControl.RunActorIDs) != 1 || payload.AccessControl.RunActorIDs[0] != actorID {
+			t.Errorf("expected run_actor_ids [%s], got %v", actorID, payload.AccessControl.RunActorIDs)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	deploymentsClient, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create deployments client: %s", err)
+	}
+
+	err = deploymentsClient.SetAccess(context.Background(), deploymentID, api.ObjectAccessControl{
+		RunActorIDs: []uuid.UUID{actorID},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+}
+
+func TestDeploymentsClient_Access_forbiddenIsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"detail":"Deployment access control is not available on your current plan."}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	deploymentsClient, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create deployments client: %s", err)
+	}
+
+	_, err = deploymentsClient.GetAccess(context.Background(), uuid.New())
+	if !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("expected api.ErrUnsupported, got: %v", err)
+	}
+
+	err = deploymentsClient.SetAccess(context.Background(), uuid.New(), api.ObjectAccessControl{})
+	if !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("expected api.ErrUnsupported, got: %v", err)
+	}
+}
+
+func TestDeploymentsClient_Update_clearWorkPool(t *testing.T) {
+	t.Parallel()
+
+	workPoolName := "my-pool"
+
+	tests := map[string]struct {
+		data     api.DeploymentUpdate
+		expected map[string]interface{}
+	}{
+		"set": {
+			data:     api.DeploymentUpdate{WorkPoolName: &workPoolName},
+			expected: map[string]interface{}{"work_pool_name": workPoolName},
+		},
+		"clear": {
+			data:     api.DeploymentUpdate{ClearWorkPool: true},
+			expected: map[string]interface{}{"work_pool_name": nil, "work_queue_name": nil},
+		},
+		"unchanged": {
+			data:     api.DeploymentUpdate{},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("expected PATCH, got %s", r.Method)
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request body: %s", err)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			deploymentsClient, err := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create deployments client: %s", err)
+			}
+
+			if err := deploymentsClient.Update(context.Background(), uuid.New(), test.data); err != nil {
+				t.Fatalf("failed to update deployment: %s", err)
+			}
+
+			for _, key := range []string{"work_pool_name", "work_queue_name"} {
+				expected, shouldBeSent := test.expected[key]
+				value, sent := body[key]
+				if sent != shouldBeSent || value != expected {
+					t.Errorf("expected %s to be sent: %t with value %v, got sent: %t with value %v", key, shouldBeSent, expected, sent, value)
+				}
+			}
+		})
+	}
+}
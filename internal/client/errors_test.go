@@ -0,0 +1,383 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_Get_wrapsErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"Not Found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithAPIKey("test-key"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	ctx := context.Background()
+	accountID := uuid.New()
+	tests := map[string]func() error{
+		"AccountMemberships.Get": func() error {
+			c, _ := prefectClient.AccountMemberships(accountID)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"AccountMemberships.GetInvitation": func() error {
+			c, _ := prefectClient.AccountMemberships(accountID)
+			_, err := c.GetInvitation(ctx, uuid.New())
+
+			return err
+		},
+		"AccountRoles.Get": func() error {
+			c, _ := prefectClient.AccountRoles(accountID)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Accounts.Get": func() error {
+			c, _ := prefectClient.Accounts(accountID)
+			_, err := c.Get(ctx)
+
+			return err
+		},
+		"Artifacts.Get": func() error {
+			c, _ := prefectClient.Artifacts(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Artifacts.GetLatestByKey": func() error {
+			c, _ := prefectClient.Artifacts(uuid.Nil, uuid.Nil)
+			_, err := c.GetLatestByKey(ctx, "missing")
+
+			return err
+		},
+		"Automations.Get": func() error {
+			c, _ := prefectClient.Automations(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Blocks.Get": func() error {
+			c, _ := prefectClient.Blocks(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Blocks.GetByName": func() error {
+			c, _ := prefectClient.Blocks(uuid.Nil, uuid.Nil)
+			_, err := c.GetByName(ctx, "secret", "missing")
+
+			return err
+		},
+		"BlockTypes.GetBySlug": func() error {
+			c, _ := prefectClient.BlockTypes(uuid.Nil, uuid.Nil)
+			_, err := c.GetBySlug(ctx, "missing")
+
+			return err
+		},
+		"ConcurrencyLimits.Get": func() error {
+			c, _ := prefectClient.ConcurrencyLimits(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"ConcurrencyLimits.GetByTag": func() error {
+			c, _ := prefectClient.ConcurrencyLimits(uuid.Nil, uuid.Nil)
+			_, err := c.GetByTag(ctx, "missing")
+
+			return err
+		},
+		"Deployments.Get": func() error {
+			c, _ := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Deployments.GetByName": func() error {
+			c, _ := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+			_, err := c.GetByName(ctx, "flow", "missing")
+
+			return err
+		},
+		"Deployments.GetSchedule": func() error {
+			c, _ := prefectClient.Deployments(uuid.Nil, uuid.Nil)
+			_, err := c.GetSchedule(ctx, uuid.New(), uuid.New())
+
+			return err
+		},
+		"FlowRunNotificationPolicies.Get": func() error {
+			c, _ := prefectClient.FlowRunNotificationPolicies(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Flows.Get": func() error {
+			c, _ := prefectClient.Flows(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"GlobalConcurrencyLimits.Get": func() error {
+			c, _ := prefectClient.GlobalConcurrencyLimits(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, "missing")
+
+			return err
+		},
+		"ServiceAccounts.Get": func() error {
+			c, _ := prefectClient.ServiceAccounts(accountID)
+			_, err := c.Get(ctx, uuid.NewString())
+
+			return err
+		},
+		"Teams.Get": func() error {
+			c, _ := prefectClient.Teams(accountID)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Teams.ListMembers": func() error {
+			c, _ := prefectClient.Teams(accountID)
+			_, err := c.ListMembers(ctx, uuid.New())
+
+			return err
+		},
+		"Variables.Get": func() error {
+			c, _ := prefectClient.Variables(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Variables.GetByName": func() error {
+			c, _ := prefectClient.Variables(uuid.Nil, uuid.Nil)
+			_, err := c.GetByName(ctx, "missing")
+
+			return err
+		},
+		"Webhooks.Get": func() error {
+			c, _ := prefectClient.Webhooks(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"WorkPools.Get": func() error {
+			c, _ := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			_, err := c.Get(ctx, "missing")
+
+			return err
+		},
+		"WorkPools.GetAccess": func() error {
+			c, _ := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			_, err := c.GetAccess(ctx, "missing")
+
+			return err
+		},
+		"WorkQueues.Get": func() error {
+			c, _ := prefectClient.WorkQueues(uuid.Nil, uuid.Nil, "pool")
+			_, err := c.Get(ctx, "missing")
+
+			return err
+		},
+		"WorkspaceRoles.Get": func() error {
+			c, _ := prefectClient.WorkspaceRoles(accountID)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+		"Workspaces.Get": func() error {
+			c, _ := prefectClient.Workspaces(accountID)
+			_, err := c.Get(ctx, uuid.New())
+
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		call := call
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := call(); !errors.Is(err, api.ErrNotFound) {
+				t.Errorf("expected api.ErrNotFound, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_Create_wrapsErrAlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"detail":"Object already exists"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	ctx := context.Background()
+	tests := map[string]func() error{
+		"WorkPools.Create": func() error {
+			c, _ := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			_, err := c.Create(ctx, api.WorkPoolCreate{Name: "existing"})
+
+			return err
+		},
+		"WorkQueues.Create": func() error {
+			c, _ := prefectClient.WorkQueues(uuid.Nil, uuid.Nil, "pool")
+			_, err := c.Create(ctx, api.WorkQueueCreate{Name: "existing"})
+
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		call := call
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := call(); !errors.Is(err, api.ErrAlreadyExists) {
+				t.Errorf("expected api.ErrAlreadyExists, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_ResponseError_includesRequestID(t *testing.T) {
+	t.Parallel()
+
+	// Every attempt fails, so the error must carry the ID of the last one.
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+
+		w.Header().Set("X-Request-Id", fmt.Sprintf("request-%d", attempts))
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"detail":"Internal Server Error"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(2, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	_, err = workspacesClient.Get(context.Background(), uuid.New())
+
+	var responseErr *api.ResponseError
+	if !errors.As(err, &responseErr) {
+		t.Fatalf("expected api.ResponseError, got: %v", err)
+	}
+
+	if responseErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status code %d, got %d", http.StatusInternalServerError, responseErr.StatusCode)
+	}
+
+	if responseErr.RequestID != "request-3" {
+		t.Errorf("expected request ID request-3, got %q", responseErr.RequestID)
+	}
+
+	if !strings.Contains(err.Error(), "request-3") {
+		t.Errorf("expected error to mention the request ID, got: %s", err)
+	}
+}
+
+func TestClient_ResponseError_decodesDetail(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"detail": {
+			body:     `{"detail":"Work pool name must be unique."}`,
+			expected: "Work pool name must be unique.",
+		},
+		"detail validation errors": {
+			body:     `{"detail":[{"loc":["body","name"],"msg":"field required","type":"value_error.missing"}]}`,
+			expected: "name: field required",
+		},
+		"exception detail": {
+			body: `{"exception_message":"Invalid request received.","exception_detail":[` +
+				`{"loc":["body","concurrency_limit"],"msg":"ensure this value is greater than or equal to 0","type":"value_error"},` +
+				`{"loc":["body","tags",0],"msg":"str type expected","type":"type_error"}],"request_body":{}}`,
+			expected: "Invalid request received.; concurrency_limit: ensure this value is greater than or equal to 0; tags.0: str type expected",
+		},
+		"not json": {
+			body:     `Bad Gateway`,
+			expected: "",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workPoolsClient, _ := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			_, err = workPoolsClient.Create(context.Background(), api.WorkPoolCreate{Name: "pool"})
+
+			var responseErr *api.ResponseError
+			if !errors.As(err, &responseErr) {
+				t.Fatalf("expected api.ResponseError, got: %v", err)
+			}
+
+			if responseErr.Detail != test.expected {
+				t.Errorf("expected detail %q, got %q", test.expected, responseErr.Detail)
+			}
+
+			if test.expected != "" && !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("expected error to mention the detail, got: %s", err)
+			}
+		})
+	}
+}
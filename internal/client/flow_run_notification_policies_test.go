@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestFlowRunNotificationPoliciesClient_Update_sendsFullSets(t *testing.T) {
+	t.Parallel()
+
+	policyID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/flow_run_notification_policies/"+policyID.String() {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %s", err)
+		}
+
+		// Removed tags must be sent as an empty list rather than omitted,
+		// and a cleared message template must be sent as null.
+		if tags, ok := body["tags"].([]any); !ok || len(tags) != 0 {
+			t.Errorf("expected an empty tags list, got: %v", body["tags"])
+		}
+		if template, ok := body["message_template"]; !ok || template != nil {
+			t.Errorf("expected a null message_template, got: %v", template)
+		}
+		if stateNames, ok := body["state_names"].([]any); !ok || len(stateNames) != 1 {
+			t.Errorf("expected a single state name, got: %v", body["state_names"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	policiesClient, err := prefectClient.FlowRunNotificationPolicies(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create flow run notification policies client: %s", err)
+	}
+
+	err = policiesClient.Update(context.Background(), policyID, api.FlowRunNotificationPolicyUpdate{
+		IsActive:        true,
+		StateNames:      []string{"Failed"},
+		Tags:            []string{},
+		BlockDocumentID: uuid.New(),
+	})
+	if err != nil {
+		t.Fatalf("failed to update flow run notification policy: %s", err)
+	}
+}
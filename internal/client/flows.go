@@ -0,0 +1,165 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.FlowsClient(&FlowsClient{})
+
+// FlowsClient is a client for working with flows.
+type FlowsClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+}
+
+// Flows returns a FlowsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Flows(accountID uuid.UUID, workspaceID uuid.UUID) (api.FlowsClient, error) {
+	key := subClientKey{kind: "flows", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.FlowsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	return storeSubClient[api.FlowsClient](c, key, &FlowsClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "flows"),
+	}), nil
+}
+
+// Create returns details for a new flow.
+func (c *FlowsClient) Create(ctx context.Context, data api.FlowCreate) (*api.Flow, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The server upserts flows by name, responding
+	// with 200 rather than 201 when the flow already exists.
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var flow api.Flow
+	if err := json.NewDecoder(resp.Body).Decode(&flow); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &flow, nil
+}
+
+// Get returns details for a flow by ID.
+func (c *FlowsClient) Get(ctx context.Context, flowID uuid.UUID) (*api.Flow, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+flowID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("flow id=%s: %w", flowID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var flow api.Flow
+	if err := json.NewDecoder(resp.Body).Decode(&flow); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &flow, nil
+}
+
+// GetByName returns details for a flow by name.
+//
+// Flow names are expected to be unique within a workspace, but flows
+// are matched with a filter so that api.ErrAmbiguous can be returned
+// rather than silently picking one of several flows.
+func (c *FlowsClient) GetByName(ctx context.Context, name string) (*api.Flow, error) {
+	filter := api.FlowFilter{}
+	filter.Flows.Name.Any = []string{name}
+	// We only need to know whether there is more than one match.
+	filter.Limit = 2
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
+		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/filter", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var flows []*api.Flow
+	if err := json.NewDecoder(resp.Body).Decode(&flows); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	switch len(flows) {
+	case 0:
+		return nil, fmt.Errorf("flow name=%s: %w", name, api.ErrNotFound)
+	case 1:
+		return flows[0], nil
+	default:
+		return nil, fmt.Errorf("flow name=%s: %w", name, api.ErrAmbiguous)
+	}
+}
@@ -0,0 +1,84 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestFlowsClient_GetByName_ambiguous(t *testing.T) {
+	t.Parallel()
+
+	flows := map[string][]api.Flow{
+		"unique": {
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "unique"},
+		},
+		"shared": {
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "shared"},
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "shared"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/flows/filter" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var filter api.FlowFilter
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("failed to decode filter: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		matches := []api.Flow{}
+		if len(filter.Flows.Name.Any) == 1 {
+			matches = append(matches, flows[filter.Flows.Name.Any[0]]...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(matches)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	flowsClient, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create flows client: %s", err)
+	}
+
+	flow, err := flowsClient.GetByName(context.Background(), "unique")
+	if err != nil {
+		t.Fatalf("failed to get flow by name: %s", err)
+	}
+	if flow.ID != flows["unique"][0].ID {
+		t.Errorf("expected flow %s, got: %s", flows["unique"][0].ID, flow.ID)
+	}
+
+	if _, err := flowsClient.GetByName(context.Background(), "shared"); !errors.Is(err, api.ErrAmbiguous) {
+		t.Errorf("expected api.ErrAmbiguous, got: %v", err)
+	}
+
+	if _, err := flowsClient.GetByName(context.Background(), "missing"); !errors.Is(err, api.ErrNotFound) {
+		t.Errorf("expected api.ErrNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReservedHeaders lists the HTTP headers managed by the client itself,
+// which cannot be overridden by custom headers.
+var ReservedHeaders = []string{
+	"Authorization",
+	"Content-Type",
+	"Accept",
+	"Host",
+	"User-Agent",
+	APIVersionHeader,
+}
+
+// headersTransport is an http.RoundTripper that attaches
+// a static set of custom headers to every request.
+type headersTransport struct {
+	next    http.RoundTripper
+	headers http.Header
+}
+
+// newHeadersTransport wraps the provided http.RoundTripper so that the headers
+// are set on every request. If next is nil, http.DefaultTransport is used.
+func newHeadersTransport(next http.RoundTripper, headers http.Header) *headersTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &headersTransport{
+		next:    next,
+		headers: headers,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction with the custom headers set.
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it was given,
+	// so the headers are set on a copy instead.
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		req.Header[name] = values
+	}
+
+	//nolint:wrapcheck // the error is returned as-is to the http.Client
+	return t.next.RoundTrip(req)
+}
+
+// WithHeaders configures custom headers that are sent with every request,
+// such as those required by an authenticating proxy.
+// Headers in ReservedHeaders cannot be set.
+func WithHeaders(headers map[string]string) Option {
+	return func(client *Client) error {
+		customHeaders := make(http.Header, len(headers))
+		for name, value := range headers {
+			canonicalName := http.CanonicalHeaderKey(name)
+			for _, reserved := range ReservedHeaders {
+				if canonicalName == reserved {
+					return fmt.Errorf("header %q is reserved and cannot be set as a custom header", name)
+				}
+			}
+
+			customHeaders.Set(canonicalName, value)
+		}
+
+		client.headers = customHeaders
+
+		return nil
+	}
+}
+
+// WithUserAgent configures the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(client *Client) error {
+		client.userAgent = userAgent
+
+		return nil
+	}
+}
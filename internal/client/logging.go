@@ -0,0 +1,253 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces sensitive values in logged requests and responses.
+const redactedValue = "***"
+
+// sensitiveFields lists the JSON object keys whose values are
+// redacted from logged request and response bodies.
+var sensitiveFields = map[string]struct{}{
+	"key":      {},
+	"api_key":  {},
+	"token":    {},
+	"password": {},
+	"secret":   {},
+}
+
+// sensitiveRouteFields lists, per route segment, the JSON object keys whose
+// values are redacted whatever their type. These hold user-defined fields,
+// such as the data of a block document, whose sensitive values cannot be
+// recognized by their key.
+var sensitiveRouteFields = map[string]map[string]struct{}{
+	"block_documents": {"data": {}},
+}
+
+// sensitiveHeaders lists the canonical names of the request headers
+// whose values are redacted from logged requests.
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+}
+
+// logLevelEnvVars lists, by precedence, the environment variables setting
+// the log level of the provider, as read by Terraform and the plugin SDK.
+var logLevelEnvVars = []string{"TF_LOG_PROVIDER_PREFECT", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// bodyLoggingFromEnv reports whether the environment sets the log level
+// of the provider to debug or trace, at which bodies are logged.
+func bodyLoggingFromEnv() bool {
+	for _, name := range logLevelEnvVars {
+		level := strings.ToUpper(os.Getenv(name))
+		if level == "" {
+			continue
+		}
+
+		// JSON logs every level, as trace does.
+		return level == "DEBUG" || level == "TRACE" || level == "JSON"
+	}
+
+	return false
+}
+
+// loggingTransport is an http.RoundTripper that emits a debug log entry,
+// with sensitive values redacted, for every request sent to the Prefect API.
+// The entries are logged against the request context, so they carry
+// the fields of the resource or data source that issued the request.
+type loggingTransport struct {
+	next http.RoundTripper
+
+	// customHeaders holds the canonical names of the user-supplied headers,
+	// whose values are redacted as they typically carry credentials, such
+	// as those of an authenticating proxy.
+	customHeaders map[string]struct{}
+
+	// logBodies enables the logging of request and response bodies.
+	// Otherwise, they are not read, so that responses are not buffered
+	// in memory when their bodies would not be logged.
+	logBodies bool
+}
+
+// newLoggingTransport wraps the provided http.RoundTripper with request logging,
+// redacting the values of the given custom headers, and logging bodies if
+// logBodies is set. If next is nil, http.DefaultTransport is used.
+func newLoggingTransport(next http.RoundTripper, customHeaders http.Header, logBodies bool) *loggingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	names := make(map[string]struct{}, len(customHeaders))
+	for name := range customHeaders {
+		names[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+
+	return &loggingTransport{next: next, customHeaders: names, logBodies: logBodies}
+}
+
+// RoundTrip executes a single HTTP transaction, logging the request and its response.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": t.redactHeaders(req.Header),
+	}
+
+	routeFields := routeSensitiveFields(req.URL.Path)
+
+	if t.logBodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			fields["http_request_body"] = readRedactedBody(body, routeFields)
+		}
+	}
+
+	tflog.Debug(ctx, "Sending request to the Prefect API", fields)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Request to the Prefect API failed", fields)
+
+		//nolint:wrapcheck // the error is returned as-is to the http.Client
+		return nil, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+
+	if t.logBodies && resp.Body != nil && resp.Body != http.NoBody {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		// Replace the consumed body so the caller can still decode it.
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		fields["http_response_body"] = redactBody(body, routeFields)
+	}
+
+	tflog.Debug(ctx, "Received response from the Prefect API", fields)
+
+	return resp, nil
+}
+
+// redactHeaders returns the request headers in a loggable form,
+// with the values of sensitive and custom headers scrubbed.
+func (t *loggingTransport) redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		canonicalName := http.CanonicalHeaderKey(name)
+		_, sensitive := sensitiveHeaders[canonicalName]
+		_, custom := t.customHeaders[canonicalName]
+		if sensitive || custom {
+			headers[name] = redactedValue
+
+			continue
+		}
+
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	return headers
+}
+
+// routeSensitiveFields returns the keys of the fields that are redacted
+// whatever their type for the route, looking up each of its segments.
+func routeSensitiveFields(path string) map[string]struct{} {
+	for _, segment := range strings.Split(path, "/") {
+		if fields, ok := sensitiveRouteFields[segment]; ok {
+			return fields
+		}
+	}
+
+	return nil
+}
+
+// readRedactedBody reads and closes the body, returning its redacted contents.
+func readRedactedBody(body io.ReadCloser, routeFields map[string]struct{}) string {
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return redactBody(data, routeFields)
+}
+
+// redactBody returns the body with the values of sensitive JSON fields redacted,
+// as well as the values of routeFields, whatever their type.
+// Bodies that are not valid JSON are not logged, as they cannot be safely redacted.
+func redactBody(body []byte, routeFields map[string]struct{}) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	redacted, err := json.Marshal(redactValue(payload, routeFields))
+	if err != nil {
+		return "<body omitted>"
+	}
+
+	return string(redacted)
+}
+
+// redactValue recursively redacts the values of sensitive fields.
+func redactValue(value interface{}, routeFields map[string]struct{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if _, ok := routeFields[key]; ok && nested != nil {
+				typed[key] = redactedValue
+
+				continue
+			}
+
+			if _, ok := sensitiveFields[strings.ToLower(key)]; ok {
+				if _, isString := nested.(string); isString {
+					typed[key] = redactedValue
+
+					continue
+				}
+			}
+
+			typed[key] = redactValue(nested, routeFields)
+		}
+
+		return typed
+	case []interface{}:
+		for i, nested := range typed {
+			typed[i] = redactValue(nested, routeFields)
+		}
+
+		return typed
+	default:
+		return value
+	}
+}
+
+// WithBodyLogging configures whether request and response bodies are logged,
+// with sensitive values redacted. Defaults to whether the TF_LOG_PROVIDER_PREFECT,
+// TF_LOG_PROVIDER or TF_LOG environment variable sets the debug or trace level.
+func WithBodyLogging(enabled bool) Option {
+	return func(client *Client) error {
+		client.logBodies = enabled
+
+		return nil
+	}
+}
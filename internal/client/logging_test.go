@@ -0,0 +1,184 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestLogging_redactsBlockDocumentData(t *testing.T) {
+	t.Parallel()
+
+	const secret = "my-aws-secret-access-key"
+	blockDocumentID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(api.BlockDocument{
+				BaseModel: api.BaseModel{ID: blockDocumentID},
+				Name:      "my-credentials",
+				Data:      map[string]interface{}{"aws_secret_access_key": secret},
+			})
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithBodyLogging(true),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	blocksClient, err := prefectClient.Blocks(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create blocks client: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	blockDocument, err := blocksClient.Get(ctx, blockDocumentID)
+	if err != nil {
+		t.Fatalf("expected the block document to be returned, got: %s", err)
+	}
+
+	// Only the logged body is redacted, not the decoded response.
+	if blockDocument.Data["aws_secret_access_key"] != secret {
+		t.Errorf("expected the secret to be returned, got: %v", blockDocument.Data)
+	}
+
+	err = blocksClient.Update(ctx, blockDocumentID, api.BlockDocumentUpdate{
+		Data: map[string]interface{}{"aws_secret_access_key": secret},
+	})
+	if err != nil {
+		t.Fatalf("expected the block document to be updated, got: %s", err)
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "my-credentials") {
+		t.Errorf("expected the block document to be logged, got: %s", logs)
+	}
+
+	if strings.Contains(logs, secret) {
+		t.Errorf("expected the block document data to be redacted, got: %s", logs)
+	}
+}
+
+func TestLogging_bodiesDisabled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Variable{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "my_variable"})
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithBodyLogging(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	variablesClient, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create variables client: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	variable, err := variablesClient.Get(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("expected the variable to be returned, got: %s", err)
+	}
+
+	if variable.Name != "my_variable" {
+		t.Errorf("expected the response to be decoded, got: %v", variable)
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "Received response from the Prefect API") {
+		t.Errorf("expected the response to be logged, got: %s", logs)
+	}
+
+	if strings.Contains(logs, "my_variable") {
+		t.Errorf("expected the response body not to be logged, got: %s", logs)
+	}
+}
+
+func TestLogging_redactsHeaders(t *testing.T) {
+	t.Parallel()
+
+	const gatewayToken = "my-gateway-token"
+	const proxyCredentials = "Basic my-proxy-credentials"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Token") != gatewayToken {
+			t.Errorf("expected the custom header to be sent, got: %v", r.Header)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.Variable{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "my_variable"})
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithHeaders(map[string]string{
+			"x-gateway-token":     gatewayToken,
+			"Proxy-Authorization": proxyCredentials,
+		}),
+		client.WithUserAgent("terraform-provider-prefect/test"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	variablesClient, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create variables client: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if _, err := variablesClient.Get(ctx, uuid.New()); err != nil {
+		t.Fatalf("expected the variable to be returned, got: %s", err)
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "terraform-provider-prefect/test") {
+		t.Errorf("expected the User-Agent to be logged, got: %s", logs)
+	}
+
+	for _, secret := range []string{gatewayToken, proxyCredentials} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected the value %q to be redacted, got: %s", secret, logs)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.MeClient(&MeClient{})
+
+// MeClient is a client for working with the identity
+// of the actor that the API key belongs to.
+type MeClient struct {
+	hc          *http.Client
+	apiKey      string
+	routePrefix string
+}
+
+// Me returns a MeClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Me() (api.MeClient, error) {
+	key := subClientKey{kind: "me"}
+	if cached, ok := loadSubClient[api.MeClient](c, key); ok {
+		return cached, nil
+	}
+
+	return storeSubClient[api.MeClient](c, key, &MeClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: fmt.Sprintf("%s/me", c.endpoint),
+	}), nil
+}
+
+// Accounts returns the accounts that the API key has access to.
+func (c *MeClient) Accounts(ctx context.Context) ([]*api.AccountSummary, error) {
+	var accounts []*api.AccountSummary
+	if err := c.get(ctx, "/accounts", &accounts); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// Workspaces returns the workspaces that the API key has access to,
+// across all of its accounts.
+func (c *MeClient) Workspaces(ctx context.Context) ([]*api.WorkspaceSummary, error) {
+	var workspaces []*api.WorkspaceSummary
+	if err := c.get(ctx, "/workspaces", &workspaces); err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+func (c *MeClient) get(ctx context.Context, route string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+route, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newResponseError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
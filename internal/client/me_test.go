@@ -0,0 +1,59 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestMeClient_Workspaces(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/me/workspaces" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{
+			"account_id": "` + accountID.String() + `", "account_name": "Data Platform", "account_handle": "data-platform",
+			"workspace_id": "` + workspaceID.String() + `", "workspace_name": "Production", "workspace_description": "", "workspace_handle": "production"
+		}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	meClient, err := prefectClient.Me()
+	if err != nil {
+		t.Fatalf("failed to create me client: %s", err)
+	}
+
+	workspaces, err := meClient.Workspaces(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+
+	if len(workspaces) != 1 {
+		t.Fatalf("expected 1 workspace, got %d", len(workspaces))
+	}
+	if workspaces[0].AccountID != accountID || workspaces[0].WorkspaceID != workspaceID || workspaces[0].WorkspaceHandle != "production" {
+		t.Errorf("unexpected workspace: %+v", workspaces[0])
+	}
+}
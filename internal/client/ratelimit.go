@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimitTransport is an http.RoundTripper that delays requests
+// so that they are sent at a sustained rate, using a token bucket.
+// It is shared by every sub-client of a Client, as they share its http.Client.
+type rateLimitTransport struct {
+	next   http.RoundTripper
+	bucket *tokenBucket
+}
+
+// newRateLimitTransport wraps the provided http.RoundTripper so that at most
+// requestsPerSecond requests are sent per second on average.
+// If next is nil, http.DefaultTransport is used.
+func newRateLimitTransport(next http.RoundTripper, requestsPerSecond float64) *rateLimitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{
+		next:   next,
+		bucket: newTokenBucket(requestsPerSecond, time.Now),
+	}
+}
+
+// RoundTrip executes a single HTTP transaction once a token is available.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.bucket.wait(req.Context()); err != nil {
+		//nolint:wrapcheck // the context error is returned as-is to the http.Client
+		return nil, err
+	}
+
+	//nolint:wrapcheck // the error is returned as-is to the http.Client
+	return t.next.RoundTrip(req)
+}
+
+// tokenBucket is a concurrency-safe token bucket, refilled at rate tokens
+// per second up to its burst size. The bucket starts full, and allows
+// bursts of up to one second worth of requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, now func() time.Time) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now(),
+		now:    now,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long
+// the caller must wait before the token can be used.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	// Tokens may go negative, which queues up the callers
+	// waiting for them in the order they reserved them.
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available, or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+
+		//nolint:wrapcheck // the context error is returned as-is to the caller
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WithRateLimit configures the maximum sustained number of requests
+// sent per second, shared across every sub-client of the Client.
+// Setting requestsPerSecond to 0 disables rate limiting.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(client *Client) error {
+		if requestsPerSecond < 0 || math.IsNaN(requestsPerSecond) || math.IsInf(requestsPerSecond, 0) {
+			return fmt.Errorf("requestsPerSecond must be a non-negative number: requestsPerSecond is %v", requestsPerSecond)
+		}
+
+		client.requestsPerSecond = requestsPerSecond
+
+		return nil
+	}
+}
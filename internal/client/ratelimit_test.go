@@ -0,0 +1,111 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestClient_WithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	t.Cleanup(server.Close)
+
+	// A burst of 50 requests is allowed, so the remaining 10
+	// requests must wait for 200ms worth of tokens.
+	const requestsPerSecond = 50
+	const requests = 60
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithRateLimit(requestsPerSecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	start := time.Now()
+
+	// Requests are sent concurrently through different sub-clients,
+	// which share the rate limit of the client.
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var err error
+			if i%2 == 0 {
+				workspacesClient, _ := prefectClient.Workspaces(uuid.Nil)
+				_, err = workspacesClient.List(context.Background(), api.WorkspaceFilter{})
+			} else {
+				workPoolsClient, _ := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+				_, err = workPoolsClient.List(context.Background(), api.WorkPoolFilter{})
+			}
+			if err != nil {
+				t.Errorf("request %d failed: %s", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, but %d requests took %s", requests, elapsed)
+	}
+}
+
+func TestClient_WithRateLimit_respectsContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithRateLimit(0.1),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, _ := prefectClient.Workspaces(uuid.Nil)
+
+	// The first request takes the only token, for the next 10 seconds.
+	if _, err := workspacesClient.List(context.Background(), api.WorkspaceFilter{}); err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	_, err = workspacesClient.List(ctx, api.WorkspaceFilter{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestClient_WithRateLimit_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := client.New(client.WithRateLimit(-1)); err == nil {
+		t.Errorf("expected an error for a negative rate limit")
+	}
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request is retried.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryBaseDelay is the default delay before the first retry,
+	// which is doubled on every subsequent attempt.
+	DefaultRetryBaseDelay = 1 * time.Second
+
+	// maxRetryDelay caps the computed backoff delay between attempts.
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryTransport is an http.RoundTripper that retries requests
+// which failed with a transient error, using exponential backoff with jitter.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// newRetryTransport wraps the provided http.RoundTripper with retry logic.
+// If next is nil, http.DefaultTransport is used.
+func newRetryTransport(next http.RoundTripper, maxRetries int, baseDelay time.Duration) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction, retrying it on
+// 429 responses, on 5xx responses for idempotent requests, and on
+// transient network errors for requests that can be safely replayed.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		var written atomic.Bool
+		resp, err := t.next.RoundTrip(withWriteTrace(req, &written))
+
+		var delay time.Duration
+		fields := map[string]interface{}{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"attempt":     attempt + 1,
+			"max_retries": t.maxRetries,
+		}
+
+		if err != nil {
+			if attempt >= t.maxRetries || !shouldRetryError(req, err, written.Load()) || !canReplay(req) {
+				//nolint:wrapcheck // the error is returned as-is to the http.Client
+				return nil, err
+			}
+
+			delay = t.backoff(attempt)
+			fields["error"] = err.Error()
+		} else {
+			if attempt >= t.maxRetries || !shouldRetry(req, resp) || !canReplay(req) {
+				return resp, nil
+			}
+
+			delay = t.backoff(attempt)
+			if retryAfter, ok := parseRetryAfter(resp); ok {
+				delay = retryAfter
+			}
+
+			fields["http_status_code"] = resp.StatusCode
+
+			// Drain and close the response body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		// Retries are logged as warnings, so that a slow apply
+		// can be told apart from one that is stuck.
+		fields["retry_delay"] = delay.String()
+		tflog.Warn(req.Context(), fmt.Sprintf("Retrying request to the Prefect API (retry %d of %d)", attempt+1, t.maxRetries), fields)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			//nolint:wrapcheck // the context error is returned as-is to the http.Client
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// canReplay reports whether the request can be sent again,
+// which requires its body, if any, to be re-creatable.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// withWriteTrace returns a request that sets written once the
+// transport starts writing it to the connection.
+func withWriteTrace(req *http.Request, written *atomic.Bool) *http.Request {
+	trace := &httptrace.ClientTrace{
+		WroteHeaderField: func(string, []string) {
+			written.Store(true)
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+}
+
+// backoff returns the delay before the next attempt, doubling the
+// base delay on every attempt and applying a random jitter.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.baseDelay << attempt
+	if delay <= 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	// Use "equal jitter": half of the delay is fixed,
+	// and the other half is randomized.
+	half := delay / 2
+
+	//nolint:gosec // jitter does not require a cryptographically secure random number
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// shouldRetry reports whether a response warrants retrying the request.
+// Rate limited requests are always retried, as the server did not process them,
+// while server errors are only retried for idempotent requests.
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return isIdempotent(req)
+	}
+
+	return false
+}
+
+// shouldRetryError reports whether a failed round trip warrants retrying the request.
+// Transient network errors are retried for idempotent requests, while other
+// requests are only retried if no part of the request reached the connection,
+// as the server cannot have processed it.
+func shouldRetryError(req *http.Request, err error, written bool) bool {
+	// The caller gave up on the request, rather than the network failing it.
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if !isTransientNetworkError(err) {
+		return false
+	}
+
+	return isIdempotent(req) || !written
+}
+
+// isTransientNetworkError reports whether err is a network failure that is
+// likely to succeed when retried, such as a reset or dropped connection.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	// net.Error.Temporary is deprecated, as most errors it reports
+	// are not actually temporary, so only timeouts are considered.
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// readOnlyPostSuffixes lists the route suffixes of the endpoints that only
+// read data, but take their filter as a POST body.
+var readOnlyPostSuffixes = []string{"/filter", "/count"}
+
+// isIdempotent reports whether a request is safe to retry,
+// either because of its method or because it is a POST read.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		path := strings.TrimSuffix(req.URL.Path, "/")
+		for _, suffix := range readOnlyPostSuffixes {
+			if strings.HasSuffix(path, suffix) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses the Retry-After header on a 429 response,
+// which can be expressed either in seconds or as an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
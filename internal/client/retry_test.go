@@ -0,0 +1,344 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+// roundTripperFunc adapts a function to an http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newDroppingServer returns a server that closes the connection without
+// responding to the first request, and responds with body afterwards.
+func newDroppingServer(t *testing.T, body string, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			hijacker, ok := w.(http.Hijacker)
+			if !ok {
+				t.Errorf("response writer does not support hijacking")
+
+				return
+			}
+
+			conn, _, err := hijacker.Hijack()
+			if err != nil {
+				t.Errorf("failed to hijack connection: %s", err)
+
+				return
+			}
+
+			conn.Close()
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClient_Retry_droppedConnection(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newDroppingServer(t, `{"id":"`+uuid.NewString()+`","name":"my-flow"}`, &requests)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(2, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	flowsClient, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create flows client: %s", err)
+	}
+
+	// Reads are idempotent, so they are retried on a new connection.
+	flow, err := flowsClient.Get(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("expected the request to be retried, got: %s", err)
+	}
+
+	if flow.Name != "my-flow" {
+		t.Errorf("expected flow my-flow, got %q", flow.Name)
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestClient_Retry_droppedConnectionAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newDroppingServer(t, `{"id":"`+uuid.NewString()+`","name":"my-flow"}`, &requests)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(2, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	flowsClient, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create flows client: %s", err)
+	}
+
+	// The server received the create, so it must not be sent twice.
+	if _, err := flowsClient.Create(context.Background(), api.FlowCreate{Name: "my-flow"}); err == nil {
+		t.Fatal("expected the dropped create to fail")
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestClient_Retry_networkErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err             error
+		writeBeforeFail bool
+		expectedRetries int32
+	}{
+		"connection refused before write": {
+			err:             &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+			expectedRetries: 1,
+		},
+		"connection reset after write": {
+			err:             &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET},
+			writeBeforeFail: true,
+			expectedRetries: 0,
+		},
+		"not transient": {
+			err:             errors.New("tls: failed to verify certificate"),
+			expectedRetries: 0,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":"` + uuid.NewString() + `","name":"my-flow"}`))
+			}))
+			t.Cleanup(server.Close)
+
+			var failures atomic.Int32
+			transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if failures.Add(1) == 1 {
+					if trace := httptrace.ContextClientTrace(req.Context()); test.writeBeforeFail && trace != nil && trace.WroteHeaderField != nil {
+						trace.WroteHeaderField("Content-Type", []string{"application/json"})
+					}
+
+					return nil, test.err
+				}
+
+				return http.DefaultTransport.RoundTrip(req)
+			})
+
+			prefectClient, err := client.New(
+				client.WithClient(&http.Client{Transport: transport}),
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(2, time.Millisecond),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			flowsClient, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create flows client: %s", err)
+			}
+
+			_, err = flowsClient.Create(context.Background(), api.FlowCreate{Name: "my-flow"})
+			if test.expectedRetries > 0 && err != nil {
+				t.Errorf("expected the request to be retried, got: %s", err)
+			}
+			if test.expectedRetries == 0 && err == nil {
+				t.Error("expected the request to fail without being retried")
+			}
+
+			if retries := failures.Load() - 1; retries != test.expectedRetries {
+				t.Errorf("expected %d retries, got %d", test.expectedRetries, retries)
+			}
+		})
+	}
+}
+
+func TestClient_Retry_logsAttempts(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + uuid.NewString() + `","name":"my-flow"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(3, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	flowsClient, err := prefectClient.Flows(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create flows client: %s", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if _, err := flowsClient.Get(ctx, uuid.New()); err != nil {
+		t.Fatalf("expected the request to be retried, got: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log entries: %s", err)
+	}
+
+	var retries []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@level"] == "warn" {
+			retries = append(retries, entry)
+		}
+	}
+
+	if len(retries) != 2 {
+		t.Fatalf("expected 2 retry warnings, got %d: %v", len(retries), retries)
+	}
+
+	for i, entry := range retries {
+		if attempt, ok := entry["attempt"].(float64); !ok || int(attempt) != i+1 {
+			t.Errorf("expected attempt %d, got %v", i+1, entry["attempt"])
+		}
+		if entry["max_retries"] != float64(3) {
+			t.Errorf("expected max_retries 3, got %v", entry["max_retries"])
+		}
+		if entry["http_status_code"] != float64(http.StatusServiceUnavailable) {
+			t.Errorf("expected http_status_code 503, got %v", entry["http_status_code"])
+		}
+		if _, ok := entry["retry_delay"].(string); !ok {
+			t.Errorf("expected retry_delay to be logged, got %v", entry)
+		}
+	}
+}
+
+func TestClient_Retry_serverErrorOnPost(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		call             func(context.Context, api.VariablesClient) error
+		expectedRequests int32
+		expectErr        bool
+	}{
+		// Filter reads are sent as POST, but are still safe to replay.
+		"filter is retried": {
+			call: func(ctx context.Context, variablesClient api.VariablesClient) error {
+				_, err := variablesClient.List(ctx, api.VariableFilter{})
+
+				return err
+			},
+			expectedRequests: 2,
+		},
+		"create is not retried": {
+			call: func(ctx context.Context, variablesClient api.VariablesClient) error {
+				_, err := variablesClient.Create(ctx, api.VariableCreate{Name: "my-variable", Value: "value"})
+
+				return err
+			},
+			expectedRequests: 1,
+			expectErr:        true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if requests.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`[{"id":"` + uuid.NewString() + `","name":"my-variable","value":"value"}]`))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(2, time.Millisecond),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			variablesClient, err := prefectClient.Variables(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create variables client: %s", err)
+			}
+
+			err = test.call(context.Background(), variablesClient)
+			if test.expectErr && err == nil {
+				t.Error("expected the request to fail")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("expected the request to be retried, got: %s", err)
+			}
+
+			if requests.Load() != test.expectedRequests {
+				t.Errorf("expected %d requests, got %d", test.expectedRequests, requests.Load())
+			}
+		})
+	}
+}
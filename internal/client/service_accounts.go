@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -20,6 +19,11 @@ type ServiceAccountsClient struct {
 
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) ServiceAccounts(accountID uuid.UUID) (api.ServiceAccountsClient, error) {
+	key := subClientKey{kind: "service_accounts", accountID: accountID}
+	if cached, ok := loadSubClient[api.ServiceAccountsClient](c, key); ok {
+		return cached, nil
+	}
+
 	if c.apiKey == "" {
 		return nil, fmt.Errorf("apiKey is not set")
 	}
@@ -36,11 +40,11 @@ func (c *Client) ServiceAccounts(accountID uuid.UUID) (api.ServiceAccountsClient
 	// e.g. this will generate routePrefix ending in /accounts/bots
 	routePrefix := getAccountScopedURL(c.endpoint, accountID, "bots")
 
-	return &ServiceAccountsClient{
+	return storeSubClient[api.ServiceAccountsClient](c, key, &ServiceAccountsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: routePrefix,
-	}, nil
+	}), nil
 }
 
 func (sa *ServiceAccountsClient) Create(ctx context.Context, request api.ServiceAccountCreateRequest) (*api.ServiceAccount, error) {
@@ -63,9 +67,7 @@ func (sa *ServiceAccountsClient) Create(ctx context.Context, request api.Service
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var response api.ServiceAccount
@@ -76,10 +78,22 @@ func (sa *ServiceAccountsClient) Create(ctx context.Context, request api.Service
 	return &response, nil
 }
 
+// List returns a list of service accounts, based on the provided filter.
+// Results are paginated until all matching service accounts have been retrieved.
 func (sa *ServiceAccountsClient) List(ctx context.Context, names []string) ([]*api.ServiceAccount, error) {
 	filter := api.ServiceAccountFilter{}
 	filter.ServiceAccounts.Name.Any = names
 
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.ServiceAccount, error) {
+		filter.Offset = offset
+		filter.Limit = limit
+
+		return sa.listPage(ctx, filter)
+	})
+}
+
+// listPage returns a single page of service accounts for the provided filter.
+func (sa *ServiceAccountsClient) listPage(ctx context.Context, filter api.ServiceAccountFilter) ([]*api.ServiceAccount, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
 		return nil, fmt.Errorf("failed to encode filter: %w", err)
@@ -99,9 +113,7 @@ func (sa *ServiceAccountsClient) List(ctx context.Context, names []string) ([]*a
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var serviceAccounts []*api.ServiceAccount
@@ -129,11 +141,9 @@ func (sa *ServiceAccountsClient) Get(ctx context.Context, botID string) (*api.Se
 	switch resp.StatusCode {
 	case http.StatusOK:
 	case http.StatusNotFound:
-		return nil, fmt.Errorf("could not find Service Account")
+		return nil, fmt.Errorf("service account id=%s: %w", botID, api.ErrNotFound)
 	default:
-		bodyBytes, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newResponseError(resp)
 	}
 
 	var response api.ServiceAccount
@@ -164,9 +174,7 @@ func (sa *ServiceAccountsClient) Update(ctx context.Context, botID string, reque
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -186,9 +194,7 @@ func (sa *ServiceAccountsClient) Delete(ctx context.Context, botID string) error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -213,9 +219,7 @@ func (sa *ServiceAccountsClient) RotateKey(ctx context.Context, serviceAccountID
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var serviceAccount api.ServiceAccount
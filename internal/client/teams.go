@@ -5,14 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
-var _ = api.TeamsClient(&TeamsClient{})
+var (
+	_ = api.TeamsClient(&TeamsClient{})
+	_ = api.TeamMembersBulkUpdater(&TeamsClient{})
+)
 
 type TeamsClient struct {
 	hc          *http.Client
@@ -24,23 +26,71 @@ type TeamsClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) Teams(accountID uuid.UUID) (api.TeamsClient, error) {
+	key := subClientKey{kind: "teams", accountID: accountID}
+	if cached, ok := loadSubClient[api.TeamsClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 
-	return &TeamsClient{
+	return storeSubClient[api.TeamsClient](c, key, &TeamsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getAccountScopedURL(c.endpoint, accountID, "teams"),
-	}, nil
+	}), nil
+}
+
+// Create returns details for a new team.
+func (c *TeamsClient) Create(ctx context.Context, data api.TeamCreate) (*api.Team, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var team api.Team
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &team, nil
 }
 
 // List returns a list of teams, based on the provided filter.
+// Results are paginated until all matching teams have been retrieved.
 func (c *TeamsClient) List(ctx context.Context, names []string) ([]*api.Team, error) {
-	var buf bytes.Buffer
 	filterQuery := api.TeamFilter{}
 	filterQuery.Teams.Name.Any = names
 
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.Team, error) {
+		filterQuery.Offset = offset
+		filterQuery.Limit = limit
+
+		return c.listPage(ctx, filterQuery)
+	})
+}
+
+// listPage returns a single page of teams for the provided filter.
+func (c *TeamsClient) listPage(ctx context.Context, filterQuery api.TeamFilter) ([]*api.Team, error) {
+	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
 		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
 	}
@@ -59,9 +109,7 @@ func (c *TeamsClient) List(ctx context.Context, names []string) ([]*api.Team, er
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var teams []*api.Team
@@ -71,3 +119,210 @@ func (c *TeamsClient) List(ctx context.Context, names []string) ([]*api.Team, er
 
 	return teams, nil
 }
+
+// Get returns details for a team by ID.
+func (c *TeamsClient) Get(ctx context.Context, teamID uuid.UUID) (*api.Team, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+teamID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("team id=%s: %w", teamID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var team api.Team
+	if err := json.NewDecoder(resp.Body).Decode(&team); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &team, nil
+}
+
+// Update modifies an existing team by ID.
+func (c *TeamsClient) Update(ctx context.Context, teamID uuid.UUID, data api.TeamUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.routePrefix+"/"+teamID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a team by ID.
+func (c *TeamsClient) Delete(ctx context.Context, teamID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+teamID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// ListMembers returns the members of a team.
+func (c *TeamsClient) ListMembers(ctx context.Context, teamID uuid.UUID) ([]*api.TeamMember, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s/members", c.routePrefix, teamID), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("team id=%s: %w", teamID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var members []*api.TeamMember
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return members, nil
+}
+
+// AddMembers adds the provided users to a team.
+func (c *TeamsClient) AddMembers(ctx context.Context, teamID uuid.UUID, memberIDs []uuid.UUID) error {
+	payload := api.TeamMembersUpsert{Members: make([]api.TeamMember, 0, len(memberIDs))}
+	for _, memberID := range memberIDs {
+		payload.Members = append(payload.Members, api.TeamMember{MemberID: memberID, MemberType: "user"})
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&payload); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/%s/members", c.routePrefix, teamID), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from a team.
+func (c *TeamsClient) RemoveMember(ctx context.Context, teamID uuid.UUID, memberID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/%s/members/%s", c.routePrefix, teamID, memberID), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// UpdateMembers adds and removes team members in a single request.
+//
+// api.ErrUnsupported is returned if the server does not provide the
+// bulk membership endpoint, and api.ErrNotFound if the team does not exist.
+func (c *TeamsClient) UpdateMembers(ctx context.Context, teamID uuid.UUID, data api.TeamMembersUpdate) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s/members/bulk", c.routePrefix, teamID), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		return fmt.Errorf("bulk team membership update: %w", api.ErrUnsupported)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		if isRouteNotFound(resp) {
+			return fmt.Errorf("bulk team membership update: %w", api.ErrUnsupported)
+		}
+
+		return fmt.Errorf("team id=%s: %w", teamID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestTeamsClient_UpdateMembers_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected error
+	}{
+		{
+			name:     "route not provided",
+			status:   http.StatusNotFound,
+			body:     `{"detail":"Not Found"}`,
+			expected: api.ErrUnsupported,
+		},
+		{
+			name:     "method not allowed",
+			status:   http.StatusMethodNotAllowed,
+			body:     `{"detail":"Method Not Allowed"}`,
+			expected: api.ErrUnsupported,
+		},
+		{
+			name:     "team not found",
+			status:   http.StatusNotFound,
+			body:     `{"detail":"Team not found."}`,
+			expected: api.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			teamID := uuid.New()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/teams/"+teamID.String()+"/members/bulk" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			teamsClient, err := prefectClient.Teams(uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create teams client: %s", err)
+			}
+
+			bulkClient, ok := teamsClient.(api.TeamMembersBulkUpdater)
+			if !ok {
+				t.Fatal("expected the teams client to support bulk membership updates")
+			}
+
+			err = bulkClient.UpdateMembers(context.Background(), teamID, api.TeamMembersUpdate{})
+			if !errors.Is(err, test.expected) {
+				t.Errorf("expected %v, got: %v", test.expected, err)
+			}
+		})
+	}
+}
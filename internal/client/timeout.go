@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// DefaultRequestTimeout is the default deadline of a single HTTP request.
+const DefaultRequestTimeout = 30 * time.Second
+
+// timeoutTransport is an http.RoundTripper that aborts requests, including
+// reading their response body, once they exceed a deadline.
+// It sits below the retries, so that every attempt gets its own deadline.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// newTimeoutTransport wraps the provided http.RoundTripper with a per-request deadline.
+// If next is nil, http.DefaultTransport is used.
+func newTimeoutTransport(next http.RoundTripper, timeout time.Duration) *timeoutTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &timeoutTransport{
+		next:    next,
+		timeout: timeout,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction within the deadline.
+// The deadline is released once the response body is closed.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	parent := req.Context()
+	ctx, cancel := context.WithTimeout(parent, t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, t.timeoutError(parent, ctx, err)
+	}
+
+	resp.Body = &timeoutBody{
+		ReadCloser: resp.Body,
+		transport:  t,
+		parent:     parent,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+
+	return resp, nil
+}
+
+// timeoutError wraps err with api.ErrRequestTimeout when the request
+// was aborted by its own deadline, rather than by the caller's context.
+func (t *timeoutTransport) timeoutError(parent context.Context, ctx context.Context, err error) error {
+	if parent.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s: %w", api.ErrRequestTimeout, t.timeout, err)
+	}
+
+	return err
+}
+
+// timeoutBody releases the deadline of a request once its body is closed.
+type timeoutBody struct {
+	io.ReadCloser
+	transport *timeoutTransport
+	parent    context.Context
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, b.transport.timeoutError(b.parent, b.ctx, err)
+	}
+
+	//nolint:wrapcheck // io.EOF must be returned as-is
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	defer b.cancel()
+
+	//nolint:wrapcheck // the error is returned as-is to the caller
+	return b.ReadCloser.Close()
+}
+
+// WithRequestTimeout configures the deadline of every HTTP request sent
+// by the client, which is distinct from the deadline of the caller's context.
+// Retried requests get a new deadline on every attempt.
+// Setting timeout to 0 disables the deadline.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(client *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative: timeout is %s", timeout)
+		}
+
+		client.requestTimeout = timeout
+
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+// stall blocks until the request is aborted by the client, or a second has passed.
+func stall(r *http.Request) {
+	select {
+	case <-r.Context().Done():
+	case <-time.After(time.Second):
+	}
+}
+
+func TestClient_WithRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]http.HandlerFunc{
+		"stuck response": func(_ http.ResponseWriter, r *http.Request) {
+			stall(r)
+		},
+		"stuck body": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":`))
+			w.(http.Flusher).Flush()
+
+			stall(r)
+		},
+	}
+
+	for name, handler := range tests {
+		handler := handler
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(handler)
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+				client.WithRequestTimeout(50*time.Millisecond),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workspacesClient, _ := prefectClient.Workspaces(uuid.Nil)
+
+			start := time.Now()
+			_, err = workspacesClient.Get(context.Background(), uuid.New())
+			if !errors.Is(err, api.ErrRequestTimeout) {
+				t.Errorf("expected api.ErrRequestTimeout, got: %v", err)
+			}
+
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Errorf("expected the request to be aborted after the timeout, but it took %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestClient_WithRequestTimeout_callerContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		stall(r)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+		client.WithRequestTimeout(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, _ := prefectClient.Workspaces(uuid.Nil)
+
+	// A deadline set by the caller is not reported as a request timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	_, err = workspacesClient.Get(ctx, uuid.New())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+	if errors.Is(err, api.ErrRequestTimeout) {
+		t.Errorf("expected the caller's deadline not to be reported as a request timeout, got: %v", err)
+	}
+}
+
+func TestClient_WithRequestTimeout_negative(t *testing.T) {
+	t.Parallel()
+
+	if _, err := client.New(client.WithRequestTimeout(-time.Second)); err == nil {
+		t.Error("expected an error for a negative request timeout")
+	}
+}
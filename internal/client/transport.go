@@ -0,0 +1,143 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// baseTransport returns a copy of the provided http.RoundTripper that
+// network-level settings (such as the proxy) can be applied to.
+// If next is nil, a copy of http.DefaultTransport is returned, which
+// honors the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func baseTransport(next http.RoundTripper) (*http.Transport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	transport, ok := next.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("network settings cannot be applied to an http.Client with a custom transport of type %T", next)
+	}
+
+	return transport.Clone(), nil
+}
+
+// proxyFunc returns a function that routes requests through proxyURL,
+// unless the request's host is excluded by the NO_PROXY environment variable.
+func proxyFunc(proxyURL *url.URL) func(*http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+	config.HTTPProxy = proxyURL.String()
+	config.HTTPSProxy = proxyURL.String()
+
+	proxyForURL := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		//nolint:wrapcheck // the error is returned as-is to the http.Transport
+		return proxyForURL(req.URL)
+	}
+}
+
+// WithProxyURL configures the client to send requests through the proxy
+// at proxyURL, rather than the proxy set by the HTTP_PROXY and HTTPS_PROXY
+// environment variables. Hosts excluded by NO_PROXY are still reached directly.
+func WithProxyURL(proxyURL string) Option {
+	return func(client *Client) error {
+		parsedURL, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("proxy URL is not a valid url: %w", err)
+		}
+
+		if parsedURL.Scheme == "" || parsedURL.Host == "" {
+			return fmt.Errorf("proxy URL %q must include a scheme and host, such as http://proxy.example.com:3128", proxyURL)
+		}
+
+		client.proxyURL = parsedURL
+
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify configures the client to skip the verification
+// of the server's TLS certificate, such as a self-signed certificate
+// on a staging Prefect server. This must not be used in production.
+func WithInsecureSkipVerify(insecureSkipVerify bool) Option {
+	return func(client *Client) error {
+		if !insecureSkipVerify {
+			return nil
+		}
+
+		if client.tlsConfig == nil {
+			client.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		//nolint:gosec // explicitly requested by the user, for non-production servers
+		client.tlsConfig.InsecureSkipVerify = true
+
+		return nil
+	}
+}
+
+// ParseCACertificates parses the PEM-encoded certificates in pemData,
+// returning an error if a certificate is malformed or none are found.
+func ParseCACertificates(pemData []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	rest := pemData
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %q, expected CERTIFICATE", block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate #%d: %w", len(certificates)+1, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM-encoded certificates found")
+	}
+
+	return certificates, nil
+}
+
+// WithCACertificates configures the client to trust the provided CA
+// certificates, in addition to the system trust store, when verifying
+// the server's TLS certificate. This supports servers signed by a private CA.
+func WithCACertificates(certificates []*x509.Certificate) Option {
+	return func(client *Client) error {
+		if client.tlsConfig == nil {
+			client.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		if client.tlsConfig.RootCAs == nil {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+
+			client.tlsConfig.RootCAs = pool
+		}
+
+		for _, certificate := range certificates {
+			client.tlsConfig.RootCAs.AddCert(certificate)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+package client_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestClient_WithProxyURL(t *testing.T) {
+	// The proxy is configured explicitly, so the environment must not
+	// exclude the target host from being proxied.
+	t.Setenv("NO_PROXY", "")
+
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied request carries the absolute URL of the target.
+		proxiedHost = r.URL.Host
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]api.Workspace{})
+	}))
+	t.Cleanup(proxy.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint("http://prefect.internal.example.com/api"),
+		client.WithProxyURL(proxy.URL),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	if _, err := workspacesClient.List(context.Background(), api.WorkspaceFilter{}); err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+
+	if proxiedHost != "prefect.internal.example.com" {
+		t.Errorf("expected the request to be proxied to prefect.internal.example.com, got %q", proxiedHost)
+	}
+}
+
+func TestClient_WithCACertificates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]api.Workspace{})
+	}))
+	t.Cleanup(server.Close)
+
+	listWorkspaces := func(opts ...client.Option) error {
+		opts = append(opts,
+			client.WithEndpoint(server.URL+"/api"),
+			client.WithRetries(0, client.DefaultRetryBaseDelay),
+		)
+
+		prefectClient, err := client.New(opts...)
+		if err != nil {
+			t.Fatalf("failed to create client: %s", err)
+		}
+
+		workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+		if err != nil {
+			t.Fatalf("failed to create workspaces client: %s", err)
+		}
+
+		_, err = workspacesClient.List(context.Background(), api.WorkspaceFilter{})
+
+		return err
+	}
+
+	// The server's certificate is self-signed, so it is not trusted by default.
+	if err := listWorkspaces(); err == nil {
+		t.Errorf("expected the server's certificate to be rejected")
+	}
+
+	if err := listWorkspaces(client.WithCACertificates([]*x509.Certificate{server.Certificate()})); err != nil {
+		t.Errorf("expected the server's certificate to be trusted: %s", err)
+	}
+}
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"crypto/tls"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,9 +12,48 @@ import (
 type Client struct {
 	hc                 *http.Client
 	endpoint           string
+	basePath           string
 	apiKey             string
 	defaultAccountID   uuid.UUID
 	defaultWorkspaceID uuid.UUID
+
+	// ossMode is set when the endpoint points to a self-hosted
+	// Prefect server rather than Prefect Cloud.
+	ossMode bool
+
+	maxRetries     int
+	retryBaseDelay time.Duration
+
+	// requestTimeout is the deadline of every request, if set.
+	requestTimeout time.Duration
+
+	// requestsPerSecond limits the rate of requests, if set.
+	requestsPerSecond float64
+
+	// headers are custom headers attached to every request.
+	headers http.Header
+
+	// userAgent overrides Go's default User-Agent header, if set.
+	userAgent string
+
+	// apiVersion is the version of the Prefect API requested, if set.
+	apiVersion string
+
+	// apiVersionTransport records the version of the Prefect API
+	// served, if apiVersion is set.
+	apiVersionTransport *apiVersionTransport
+
+	// logBodies enables the logging of request and response bodies.
+	logBodies bool
+
+	// proxyURL overrides the proxy set in the environment, if set.
+	proxyURL *url.URL
+
+	// tlsConfig overrides the default TLS configuration, if set.
+	tlsConfig *tls.Config
+
+	// subClients memoizes the sub-clients returned by the Client.
+	subClients *subClientCache
 }
 
 type Option func(c *Client) error
@@ -1,20 +1,41 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
+// requestIDHeader is the response header carrying the ID
+// the server assigned to the request.
+const requestIDHeader = "X-Request-Id"
+
+// IsPrefectCloudHost returns true if the host belongs to Prefect Cloud,
+// as opposed to a self-hosted Prefect server.
+func IsPrefectCloudHost(host string) bool {
+	return host == "api.prefect.cloud" || host == "api.prefect.dev" || host == "api.stg.prefect.dev"
+}
+
 // getAccountScopedURL constructs a URL for an account-scoped route.
+// If the accountID is not set (e.g. when targeting a self-hosted
+// Prefect server), the account segment is omitted.
 func getAccountScopedURL(endpoint string, accountID uuid.UUID, route string) string {
 	var builder strings.Builder
 
 	builder.WriteString(endpoint)
 
-	builder.WriteString("/accounts/")
-	builder.WriteString(accountID.String())
+	if accountID != uuid.Nil {
+		builder.WriteString("/accounts/")
+		builder.WriteString(accountID.String())
+	}
+
 	builder.WriteRune('/')
 
 	builder.WriteString(route)
@@ -58,3 +79,120 @@ func setDefaultHeaders(request *http.Request, apiKey string) {
 	request.Header.Set("Content-Type", "application/json")
 	request.Header.Set("Accept", "application/json")
 }
+
+// newResponseError returns an api.ResponseError for a response with an
+// unexpected status code, reading the response body as the error detail.
+// When requests are retried, this is the response to the last attempt.
+func newResponseError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+
+	return &api.ResponseError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+		Detail:     parseErrorDetail(body),
+		RequestID:  resp.Header.Get(requestIDHeader),
+	}
+}
+
+// routeNotFoundDetail is the detail of a 404 response for a route the server
+// does not provide, as opposed to a missing object, whose detail names it.
+const routeNotFoundDetail = "Not Found"
+
+// isRouteNotFound reports whether a 404 response is for a route the server
+// does not provide, reading the response body.
+func isRouteNotFound(resp *http.Response) bool {
+	body, _ := io.ReadAll(resp.Body)
+
+	return parseErrorDetail(body) == routeNotFoundDetail
+}
+
+// unsupportedFeatureDetails are fragments of the detail of a 403 response
+// that denies a feature not offered on the tier of the account, rather than
+// an action the credentials are not permitted to perform.
+var unsupportedFeatureDetails = []string{"plan", "tier", "upgrade", "not available"}
+
+// newForbiddenError returns the error for a 403 response to a request using
+// the given feature. It wraps api.ErrUnsupported only if the response detail
+// says the feature is not offered on the tier of the account, so that
+// permission errors are surfaced as-is.
+func newForbiddenError(resp *http.Response, feature string) error {
+	err := newResponseError(resp)
+
+	var responseErr *api.ResponseError
+	if !errors.As(err, &responseErr) {
+		return err
+	}
+
+	detail := strings.ToLower(responseErr.Detail)
+	for _, fragment := range unsupportedFeatureDetails {
+		if strings.Contains(detail, fragment) {
+			return fmt.Errorf("%s: %w: %w", feature, api.ErrUnsupported, err)
+		}
+	}
+
+	return err
+}
+
+// validationError is a single field-level error of a 422 response.
+type validationError struct {
+	Loc []interface{} `json:"loc"`
+	Msg string        `json:"msg"`
+}
+
+// String returns the error prefixed by the location of the field,
+// omitting the leading "body" segment of request body fields.
+func (e validationError) String() string {
+	loc := make([]string, 0, len(e.Loc))
+	for i, segment := range e.Loc {
+		if i == 0 && segment == "body" {
+			continue
+		}
+
+		loc = append(loc, fmt.Sprint(segment))
+	}
+
+	if len(loc) == 0 {
+		return e.Msg
+	}
+
+	return strings.Join(loc, ".") + ": " + e.Msg
+}
+
+// parseErrorDetail decodes the message of a Prefect error response, which
+// is either a `detail` string or list of validation errors, or an
+// `exception_message` with a list of validation errors in `exception_detail`.
+// An empty string is returned if the body is not a Prefect error response.
+func parseErrorDetail(body []byte) string {
+	var response struct {
+		Detail           json.RawMessage   `json:"detail"`
+		ExceptionMessage string            `json:"exception_message"`
+		ExceptionDetail  []validationError `json:"exception_detail"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return ""
+	}
+
+	var messages []string
+	if response.ExceptionMessage != "" {
+		messages = append(messages, response.ExceptionMessage)
+	}
+
+	validationErrors := response.ExceptionDetail
+
+	var detail string
+	if err := json.Unmarshal(response.Detail, &detail); err == nil && detail != "" {
+		messages = append(messages, detail)
+	} else {
+		var detailErrors []validationError
+		if err := json.Unmarshal(response.Detail, &detailErrors); err == nil {
+			validationErrors = append(validationErrors, detailErrors...)
+		}
+	}
+
+	for _, validationErr := range validationErrors {
+		messages = append(messages, validationErr.String())
+	}
+
+	return strings.Join(messages, "; ")
+}
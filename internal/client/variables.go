@@ -5,8 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 
@@ -26,18 +26,30 @@ type VariablesClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) Variables(accountID uuid.UUID, workspaceID uuid.UUID) (api.VariablesClient, error) {
-	if accountID == uuid.Nil {
+	key := subClientKey{kind: "variables", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.VariablesClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 	if workspaceID == uuid.Nil {
 		workspaceID = c.defaultWorkspaceID
 	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
 
-	return &VariablesClient{
+	return storeSubClient[api.VariablesClient](c, key, &VariablesClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "variables"),
-	}, nil
+	}), nil
 }
 
 // Create returns details for a new variable.
@@ -61,9 +73,7 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var variable api.Variable
@@ -75,11 +85,48 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 }
 
 // List returns a list of variables matching filter criteria.
+// Results are paginated until all matching variables have been retrieved.
 func (c *VariablesClient) List(ctx context.Context, filter api.VariableFilter) ([]api.Variable, error) {
-	_ = ctx
-	_ = filter
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]api.Variable, error) {
+		return c.listPage(ctx, api.VariableFilterSettings{
+			Limit:     limit,
+			Offset:    offset,
+			Variables: &filter,
+			Sort:      "NAME_ASC",
+		})
+	})
+}
+
+// listPage returns a single page of variables for the provided filter.
+func (c *VariablesClient) listPage(ctx context.Context, filter api.VariableFilterSettings) ([]api.Variable, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
+		return nil, fmt.Errorf("failed to encode filter: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/filter", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var variables []api.Variable
+	if err := json.NewDecoder(resp.Body).Decode(&variables); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
 
-	return nil, nil
+	return variables, nil
 }
 
 // Get returns details for a variable by ID.
@@ -97,10 +144,12 @@ func (c *VariablesClient) Get(ctx context.Context, variableID uuid.UUID) (*api.V
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("variable id=%s: %w", variableID, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var variable api.Variable
@@ -113,7 +162,7 @@ func (c *VariablesClient) Get(ctx context.Context, variableID uuid.UUID) (*api.V
 
 // GetByName returns details for a variable by name.
 func (c *VariablesClient) GetByName(ctx context.Context, name string) (*api.Variable, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/name/"+name, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/name/"+url.PathEscape(name), http.NoBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -126,18 +175,26 @@ func (c *VariablesClient) GetByName(ctx context.Context, name string) (*api.Vari
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("variable name=%s: %w", name, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
-	var variable api.Variable
+	// Some Prefect server versions respond with a null body
+	// instead of a 404 when the variable does not exist.
+	var variable *api.Variable
 	if err := json.NewDecoder(resp.Body).Decode(&variable); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return &variable, nil
+	if variable == nil {
+		return nil, fmt.Errorf("variable name=%s: %w", name, api.ErrNotFound)
+	}
+
+	return variable, nil
 }
 
 // Update modifies an existing variable by ID.
@@ -161,9 +218,7 @@ func (c *VariablesClient) Update(ctx context.Context, variableID uuid.UUID, data
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -185,9 +240,7 @@ func (c *VariablesClient) Delete(ctx context.Context, variableID uuid.UUID) erro
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
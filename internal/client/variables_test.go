@@ -0,0 +1,79 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestVariablesClient_List_paginatesWithTags(t *testing.T) {
+	t.Parallel()
+
+	// Serve a full page and a final partial page.
+	const total = api.DefaultPageSize + 10
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/variables/filter" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var filter api.VariableFilterSettings
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("failed to decode filter: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if filter.Variables == nil || filter.Variables.Tags == nil || len(filter.Variables.Tags.All) != 1 || filter.Variables.Tags.All[0] != "env:ci" {
+			t.Errorf("expected tags filter [env:ci], got: %+v", filter.Variables)
+		}
+
+		variables := []api.Variable{}
+		for i := filter.Offset; i < total && i < filter.Offset+filter.Limit; i++ {
+			variables = append(variables, api.Variable{BaseModel: api.BaseModel{ID: uuid.New()}})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(variables)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	variablesClient, _ := prefectClient.Variables(uuid.Nil, uuid.Nil)
+
+	variables, err := variablesClient.List(context.Background(), api.VariableFilter{
+		Tags: &api.VariableFilterTags{All: []string{"env:ci"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to list variables: %s", err)
+	}
+
+	if len(variables) != total {
+		t.Errorf("expected %d variables, got %d", total, len(variables))
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
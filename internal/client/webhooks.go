@@ -0,0 +1,179 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.WebhooksClient(&WebhooksClient{})
+
+// WebhooksClient is a client for working with webhooks.
+type WebhooksClient struct {
+	hc          *http.Client
+	routePrefix string
+	apiKey      string
+
+	// hooksURL is the address webhooks receive requests on,
+	// which is served from the root of the API host.
+	hooksURL string
+}
+
+// Webhooks returns a WebhooksClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Webhooks(accountID uuid.UUID, workspaceID uuid.UUID) (api.WebhooksClient, error) {
+	key := subClientKey{kind: "webhooks", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.WebhooksClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
+
+	endpointURL, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint is not a valid url: %w", err)
+	}
+
+	return storeSubClient[api.WebhooksClient](c, key, &WebhooksClient{
+		hc:          c.hc,
+		apiKey:      c.apiKey,
+		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "webhooks"),
+		hooksURL:    endpointURL.Scheme + "://" + endpointURL.Host + "/hooks",
+	}), nil
+}
+
+// Create returns details for a new webhook.
+func (c *WebhooksClient) Create(ctx context.Context, data api.WebhookUpsert) (*api.Webhook, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.routePrefix+"/", &buf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
+	}
+
+	var webhook api.Webhook
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	webhook.URL = c.hooksURL + "/" + webhook.Slug
+
+	return &webhook, nil
+}
+
+// Get returns details for a webhook by ID.
+func (c *WebhooksClient) Get(ctx context.Context, webhookID uuid.UUID) (*api.Webhook, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+webhookID.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("webhook id=%s: %w", webhookID, api.ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var webhook api.Webhook
+	if err := json.NewDecoder(resp.Body).Decode(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	webhook.URL = c.hooksURL + "/" + webhook.Slug
+
+	return &webhook, nil
+}
+
+// Update replaces an existing webhook by ID.
+func (c *WebhooksClient) Update(ctx context.Context, webhookID uuid.UUID, data api.WebhookUpsert) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&data); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.routePrefix+"/"+webhookID.String(), &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete removes a webhook by ID.
+func (c *WebhooksClient) Delete(ctx context.Context, webhookID uuid.UUID) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.routePrefix+"/"+webhookID.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
+	}
+
+	return nil
+}
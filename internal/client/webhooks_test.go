@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestWebhooksClient_Get_setsURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		webhook := api.Webhook{
+			BaseModel: api.BaseModel{ID: uuid.New()},
+			Slug:      "abc123",
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(webhook)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	webhooksClient, err := prefectClient.Webhooks(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create webhooks client: %s", err)
+	}
+
+	webhook, err := webhooksClient.Get(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("failed to get webhook: %s", err)
+	}
+
+	// Webhooks are served from the root of the host, rather than under the API path.
+	if expected := server.URL + "/hooks/abc123"; webhook.URL != expected {
+		t.Errorf("expected webhook URL %s, got %s", expected, webhook.URL)
+	}
+}
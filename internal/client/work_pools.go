@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -25,18 +24,30 @@ type WorkPoolsClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (api.WorkPoolsClient, error) {
-	if accountID == uuid.Nil {
+	key := subClientKey{kind: "work_pools", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.WorkPoolsClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 	if workspaceID == uuid.Nil {
 		workspaceID = c.defaultWorkspaceID
 	}
+	if !c.ossMode && (accountID == uuid.Nil || workspaceID == uuid.Nil) {
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
+	}
 
-	return &WorkPoolsClient{
+	return storeSubClient[api.WorkPoolsClient](c, key, &WorkPoolsClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "work_pools"),
-	}, nil
+	}), nil
 }
 
 // Create returns details for a new work pool.
@@ -59,10 +70,12 @@ func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("work pool name=%s: %w", data.Name, api.ErrAlreadyExists)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newResponseError(resp)
 	}
 
 	var pool api.WorkPool
@@ -74,7 +87,18 @@ func (c *WorkPoolsClient) Create(ctx context.Context, data api.WorkPoolCreate) (
 }
 
 // List returns a list of work pools matching filter criteria.
+// Results are paginated until all matching work pools have been retrieved.
 func (c *WorkPoolsClient) List(ctx context.Context, filter api.WorkPoolFilter) ([]*api.WorkPool, error) {
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.WorkPool, error) {
+		filter.Offset = offset
+		filter.Limit = limit
+
+		return c.listPage(ctx, filter)
+	})
+}
+
+// listPage returns a single page of work pools for the provided filter.
+func (c *WorkPoolsClient) listPage(ctx context.Context, filter api.WorkPoolFilter) ([]*api.WorkPool, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
 		return nil, fmt.Errorf("failed to encode filter: %w", err)
@@ -94,9 +118,7 @@ func (c *WorkPoolsClient) List(ctx context.Context, filter api.WorkPoolFilter) (
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var pools []*api.WorkPool
@@ -122,10 +144,12 @@ func (c *WorkPoolsClient) Get(ctx context.Context, name string) (*api.WorkPool,
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("work pool name=%s: %w", name, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var pool api.WorkPool
@@ -157,9 +181,7 @@ func (c *WorkPoolsClient) Update(ctx context.Context, name string, data api.Work
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -181,9 +203,87 @@ func (c *WorkPoolsClient) Delete(ctx context.Context, name string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
+		return newResponseError(resp)
+	}
+
+	return nil
+}
+
+// GetAccess returns the access control of a work pool by name.
+func (c *WorkPoolsClient) GetAccess(ctx context.Context, name string) (*api.ObjectAccess, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.routePrefix+"/"+name+"/access", http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("work pool name=%s: %w", name, api.ErrNotFound)
+	}
+
+	// Work pool-level access control is only offered on some Prefect Cloud tiers.
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, newForbiddenError(resp, "work pool access control")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
+	}
+
+	var access struct {
+		// The access control is wrapped in an envelope by the API.
+		AccessControl api.ObjectAccess `json:"access_control"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&access); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &access.AccessControl, nil
+}
+
+// SetAccess replaces the access control of a work pool by name.
+func (c *WorkPoolsClient) SetAccess(ctx context.Context, name string, data api.ObjectAccessControl) error {
+	payload := struct {
+		AccessControl api.ObjectAccessControl `json:"access_control"`
+	}{
+		AccessControl: data,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&payload); err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.routePrefix+"/"+name+"/access", &buf)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	setDefaultHeaders(req, c.apiKey)
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("http error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("work pool name=%s: %w", name, api.ErrNotFound)
+	}
+
+	if resp.StatusCode == http.StatusForbidden {
+		return newForbiddenError(resp, "work pool access control")
+	}
 
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
 	}
 
 	return nil
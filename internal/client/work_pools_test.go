@@ -0,0 +1,145 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestWorkPoolsClient_Access_forbidden(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		body        string
+		unsupported bool
+	}{
+		{
+			name:        "not offered on the tier",
+			body:        `{"detail":"Work pool access control is not available on your current plan."}`,
+			unsupported: true,
+		},
+		{
+			name:        "not permitted",
+			body:        `{"detail":"Forbidden"}`,
+			unsupported: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/work_pools/pool/access" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workPoolsClient, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create work pools client: %s", err)
+			}
+
+			_, err = workPoolsClient.GetAccess(context.Background(), "pool")
+			if errors.Is(err, api.ErrUnsupported) != test.unsupported {
+				t.Errorf("expected api.ErrUnsupported to be wrapped: %t, got: %v", test.unsupported, err)
+			}
+
+			var responseErr *api.ResponseError
+			if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusForbidden {
+				t.Errorf("expected api.ResponseError with status code %d, got: %v", http.StatusForbidden, err)
+			}
+
+			err = workPoolsClient.SetAccess(context.Background(), "pool", api.ObjectAccessControl{})
+			if errors.Is(err, api.ErrUnsupported) != test.unsupported {
+				t.Errorf("expected api.ErrUnsupported to be wrapped: %t, got: %v", test.unsupported, err)
+			}
+		})
+	}
+}
+
+func TestWorkPoolsClient_Update_concurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	zero := int64(0)
+
+	tests := map[string]struct {
+		concurrencyLimit *int64
+		expected         string
+	}{
+		"cleared": {
+			concurrencyLimit: nil,
+			expected:         "null",
+		},
+		"zero": {
+			concurrencyLimit: &zero,
+			expected:         "0",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/api/work_pools/pool" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				var body map[string]json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode body: %s", err)
+				}
+
+				// The limit is cleared by an explicit null, so it must always be sent.
+				if limit, ok := body["concurrency_limit"]; !ok || string(limit) != test.expected {
+					t.Errorf("expected concurrency_limit %s, got %q", test.expected, limit)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workPoolsClient, err := prefectClient.WorkPools(uuid.Nil, uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create work pools client: %s", err)
+			}
+
+			err = workPoolsClient.Update(context.Background(), "pool", api.WorkPoolUpdate{ConcurrencyLimit: test.concurrencyLimit})
+			if err != nil {
+				t.Fatalf("failed to update work pool: %s", err)
+			}
+		})
+	}
+}
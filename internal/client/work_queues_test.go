@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestWorkQueuesClient_Update_concurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	zero := int64(0)
+
+	tests := map[string]struct {
+		concurrencyLimit *int64
+		expected         string
+	}{
+		"cleared": {
+			concurrencyLimit: nil,
+			expected:         "null",
+		},
+		"zero": {
+			concurrencyLimit: &zero,
+			expected:         "0",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/api/work_pools/pool/queues/queue" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				var body map[string]json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode body: %s", err)
+				}
+
+				// The limit is cleared by an explicit null, so it must always be sent.
+				if limit, ok := body["concurrency_limit"]; !ok || string(limit) != test.expected {
+					t.Errorf("expected concurrency_limit %s, got %q", test.expected, limit)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workQueuesClient, err := prefectClient.WorkQueues(uuid.Nil, uuid.Nil, "pool")
+			if err != nil {
+				t.Fatalf("failed to create work queues client: %s", err)
+			}
+
+			err = workQueuesClient.Update(context.Background(), "queue", api.WorkQueueUpdate{ConcurrencyLimit: test.concurrencyLimit})
+			if err != nil {
+				t.Fatalf("failed to update work queue: %s", err)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -26,6 +25,11 @@ type WorkspaceAccessClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (api.WorkspaceAccessClient, error) {
+	key := subClientKey{kind: "workspace_access", accountID: accountID, workspaceID: workspaceID}
+	if cached, ok := loadSubClient[api.WorkspaceAccessClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
@@ -33,14 +37,14 @@ func (c *Client) WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (ap
 		workspaceID = c.defaultWorkspaceID
 	}
 	if accountID == uuid.Nil || workspaceID == uuid.Nil {
-		return nil, fmt.Errorf("both accountID and workspaceID must be defined")
+		return nil, fmt.Errorf("%w: accountID is %q and workspaceID is %q", api.ErrWorkspaceScopeRequired, accountID, workspaceID)
 	}
 
-	return &WorkspaceAccessClient{
+	return storeSubClient[api.WorkspaceAccessClient](c, key, &WorkspaceAccessClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: fmt.Sprintf("%s/accounts/%s/workspaces/%s", c.endpoint, accountID.String(), workspaceID.String()),
-	}, nil
+	}), nil
 }
 
 // Upsert creates or updates access to a workspace for various accessor types.
@@ -80,9 +84,7 @@ func (c *WorkspaceAccessClient) Upsert(ctx context.Context, accessorType string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workspaceAccess api.WorkspaceAccess
@@ -118,10 +120,12 @@ func (c *WorkspaceAccessClient) Get(ctx context.Context, accessorType string, ac
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s access id=%s: %w", accessorType, accessID, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var workspaceAccess api.WorkspaceAccess
@@ -158,9 +162,7 @@ func (c *WorkspaceAccessClient) Delete(ctx context.Context, accessorType string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
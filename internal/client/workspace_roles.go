@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -25,15 +24,20 @@ type WorkspaceRolesClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) WorkspaceRoles(accountID uuid.UUID) (api.WorkspaceRolesClient, error) {
+	key := subClientKey{kind: "workspace_roles", accountID: accountID}
+	if cached, ok := loadSubClient[api.WorkspaceRolesClient](c, key); ok {
+		return cached, nil
+	}
+
 	if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 
-	return &WorkspaceRolesClient{
+	return storeSubClient[api.WorkspaceRolesClient](c, key, &WorkspaceRolesClient{
 		hc:          c.hc,
 		apiKey:      c.apiKey,
 		routePrefix: getAccountScopedURL(c.endpoint, accountID, "workspace_roles"),
-	}, nil
+	}), nil
 }
 
 // Create creates a new workspace role.
@@ -57,9 +61,7 @@ func (c *WorkspaceRolesClient) Create(ctx context.Context, data api.WorkspaceRol
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workspaceRole api.WorkspaceRole
@@ -91,9 +93,7 @@ func (c *WorkspaceRolesClient) Update(ctx context.Context, workspaceRoleID uuid.
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -115,9 +115,7 @@ func (c *WorkspaceRolesClient) Delete(ctx context.Context, id uuid.UUID) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -147,9 +145,7 @@ func (c *WorkspaceRolesClient) List(ctx context.Context, roleNames []string) ([]
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workspaceRoles []*api.WorkspaceRole
@@ -175,10 +171,12 @@ func (c *WorkspaceRolesClient) Get(ctx context.Context, id uuid.UUID) (*api.Work
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("workspace role id=%s: %w", id, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var workspaceRole api.WorkspaceRole
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -26,15 +25,24 @@ type WorkspacesClient struct {
 //
 //nolint:ireturn // required to support PrefectClient mocking
 func (c *Client) Workspaces(accountID uuid.UUID) (api.WorkspacesClient, error) {
-	if accountID == uuid.Nil {
+	key := subClientKey{kind: "workspaces", accountID: accountID}
+	if cached, ok := loadSubClient[api.WorkspacesClient](c, key); ok {
+		return cached, nil
+	}
+
+	// Self-hosted Prefect servers have no concept of accounts,
+	// so the account segment is always omitted from the URL.
+	if c.ossMode {
+		accountID = uuid.Nil
+	} else if accountID == uuid.Nil {
 		accountID = c.defaultAccountID
 	}
 
-	return &WorkspacesClient{
+	return storeSubClient[api.WorkspacesClient](c, key, &WorkspacesClient{
 		hc:          c.hc,
 		routePrefix: getAccountScopedURL(c.endpoint, accountID, "workspaces"),
 		apiKey:      c.apiKey,
-	}, nil
+	}), nil
 }
 
 // Create returns details for a new Workspace.
@@ -58,9 +66,7 @@ func (c *WorkspacesClient) Create(ctx context.Context, data api.WorkspaceCreate)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workspace api.Workspace
@@ -71,13 +77,21 @@ func (c *WorkspacesClient) Create(ctx context.Context, data api.WorkspaceCreate)
 	return &workspace, nil
 }
 
-// List returns a list of Workspaces, based on the provided list of handle names.
-func (c *WorkspacesClient) List(ctx context.Context, handleNames []string) ([]*api.Workspace, error) {
-	var buf bytes.Buffer
-	filterQuery := api.WorkspaceFilter{}
-	filterQuery.Workspaces.Handle.Any = handleNames
+// List returns a list of Workspaces matching the provided filter.
+// Results are paginated until all matching workspaces have been retrieved.
+func (c *WorkspacesClient) List(ctx context.Context, filter api.WorkspaceFilter) ([]*api.Workspace, error) {
+	return api.Paginate(ctx, api.DefaultPageSize, func(ctx context.Context, offset int, limit int) ([]*api.Workspace, error) {
+		filter.Offset = offset
+		filter.Limit = limit
 
-	if err := json.NewEncoder(&buf).Encode(&filterQuery); err != nil {
+		return c.listPage(ctx, filter)
+	})
+}
+
+// listPage returns a single page of Workspaces for the provided filter.
+func (c *WorkspacesClient) listPage(ctx context.Context, filter api.WorkspaceFilter) ([]*api.Workspace, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&filter); err != nil {
 		return nil, fmt.Errorf("failed to encode filter payload data: %w", err)
 	}
 
@@ -95,9 +109,7 @@ func (c *WorkspacesClient) List(ctx context.Context, handleNames []string) ([]*a
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return nil, newResponseError(resp)
 	}
 
 	var workspaces []*api.Workspace
@@ -123,10 +135,12 @@ func (c *WorkspacesClient) Get(ctx context.Context, workspaceID uuid.UUID) (*api
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("workspace id=%s: %w", workspaceID, api.ErrNotFound)
+	}
 
-		return nil, fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp)
 	}
 
 	var workspace api.Workspace
@@ -137,6 +151,51 @@ func (c *WorkspacesClient) Get(ctx context.Context, workspaceID uuid.UUID) (*api
 	return &workspace, nil
 }
 
+// GetByHandle returns details for a Workspace by handle.
+func (c *WorkspacesClient) GetByHandle(ctx context.Context, handle string) (*api.Workspace, error) {
+	filter := api.WorkspaceFilter{}
+	filter.Workspaces.Handle.Any = []string{handle}
+
+	workspaces, err := c.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workspaces) != 1 {
+		return nil, fmt.Errorf("workspace handle=%s: %w", handle, api.ErrNotFound)
+	}
+
+	return workspaces[0], nil
+}
+
+// GetByName returns the Workspace with the given name.
+// Workspace names are not unique within an account, so api.ErrAmbiguous
+// is returned when several workspaces share the name.
+func (c *WorkspacesClient) GetByName(ctx context.Context, name string) (*api.Workspace, error) {
+	// The workspaces filter does not support names,
+	// so we match against every workspace in the account.
+	workspaces, err := c.List(ctx, api.WorkspaceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*api.Workspace
+	for _, workspace := range workspaces {
+		if workspace.Name == name {
+			matches = append(matches, workspace)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("workspace name=%s: %w", name, api.ErrNotFound)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("workspace name=%s: %w (%d workspaces)", name, api.ErrAmbiguous, len(matches))
+	}
+}
+
 // Update modifies an existing Workspace by ID.
 func (c *WorkspacesClient) Update(ctx context.Context, workspaceID uuid.UUID, data api.WorkspaceUpdate) error {
 	var buf bytes.Buffer
@@ -159,9 +218,7 @@ func (c *WorkspacesClient) Update(ctx context.Context, workspaceID uuid.UUID, da
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+		return newResponseError(resp)
 	}
 
 	return nil
@@ -182,10 +239,12 @@ func (c *WorkspacesClient) Delete(ctx context.Context, workspaceID uuid.UUID) er
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		errorBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusConflict {
+		return fmt.Errorf("workspace id=%s: %w: %w", workspaceID, api.ErrHasDependents, newResponseError(resp))
+	}
 
-		return fmt.Errorf("status code %s, error=%s", resp.Status, errorBody)
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newResponseError(resp)
 	}
 
 	return nil
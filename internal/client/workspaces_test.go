@@ -0,0 +1,367 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+)
+
+func TestWorkspacesClient_List_paginates(t *testing.T) {
+	t.Parallel()
+
+	// Serve two full pages and a final partial page.
+	const total = 2*api.DefaultPageSize + 50
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || r.URL.Path != "/api/workspaces/filter" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var filter api.WorkspaceFilter
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("failed to decode filter: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if filter.Limit != api.DefaultPageSize {
+			t.Errorf("expected limit %d, got %d", api.DefaultPageSize, filter.Limit)
+		}
+
+		workspaces := []api.Workspace{}
+		for i := filter.Offset; i < total && i < filter.Offset+filter.Limit; i++ {
+			workspaces = append(workspaces, api.Workspace{
+				BaseModel: api.BaseModel{ID: uuid.New()},
+				Handle:    "workspace",
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(workspaces)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	workspaces, err := workspacesClient.List(context.Background(), api.WorkspaceFilter{})
+	if err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+
+	if len(workspaces) != total {
+		t.Errorf("expected %d workspaces, got %d", total, len(workspaces))
+	}
+
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestWorkspacesClient_Get_notFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"Workspace not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	_, err = workspacesClient.Get(context.Background(), uuid.New())
+	if !errors.Is(err, api.ErrNotFound) {
+		t.Errorf("expected api.ErrNotFound, got: %v", err)
+	}
+}
+
+func TestWorkspacesClient_GetByName_ambiguous(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		workspaces := []api.Workspace{
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "shared", Handle: "shared-a"},
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "shared", Handle: "shared-b"},
+			{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "unique", Handle: "unique"},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(workspaces)
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	workspace, err := workspacesClient.GetByName(context.Background(), "unique")
+	if err != nil {
+		t.Fatalf("failed to get workspace by name: %s", err)
+	}
+	if workspace.Handle != "unique" {
+		t.Errorf("expected workspace with handle unique, got: %s", workspace.Handle)
+	}
+
+	if _, err := workspacesClient.GetByName(context.Background(), "shared"); !errors.Is(err, api.ErrAmbiguous) {
+		t.Errorf("expected api.ErrAmbiguous, got: %v", err)
+	}
+
+	if _, err := workspacesClient.GetByName(context.Background(), "missing"); !errors.Is(err, api.ErrNotFound) {
+		t.Errorf("expected api.ErrNotFound, got: %v", err)
+	}
+}
+
+func TestWorkspacesClient_List_filtersByTags(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var filter api.WorkspaceFilter
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Errorf("failed to decode filter: %s", err)
+		}
+
+		tags := filter.Workspaces.Tags.All
+		if len(tags) != 2 || tags[0] != "team:data" || tags[1] != "env:ci" {
+			t.Errorf("expected tags filter [team:data env:ci], got: %v", tags)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	filter := api.WorkspaceFilter{}
+	filter.Workspaces.Tags.All = []string{"team:data", "env:ci"}
+
+	if _, err := workspacesClient.List(context.Background(), filter); err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+}
+
+func TestWorkspacesClient_Get_decodesSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected map[string]interface{}
+	}{
+		"settings": {
+			body:     `{"handle":"workspace","settings":{"ai_log_summaries":true}}`,
+			expected: map[string]interface{}{"ai_log_summaries": true},
+		},
+		"no settings": {
+			body:     `{"handle":"workspace"}`,
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+			if err != nil {
+				t.Fatalf("failed to create workspaces client: %s", err)
+			}
+
+			workspace, err := workspacesClient.Get(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("failed to get workspace: %s", err)
+			}
+
+			if (workspace.Settings == nil) != (test.expected == nil) {
+				t.Fatalf("expected settings %v, got %v", test.expected, workspace.Settings)
+			}
+			for key, value := range test.expected {
+				if workspace.Settings[key] != value {
+					t.Errorf("expected setting %s to be %v, got %v", key, value, workspace.Settings[key])
+				}
+			}
+		})
+	}
+}
+
+func TestWorkspacesClient_Delete_hasDependents(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"detail":"Workspace has deployments."}`))
+	}))
+	t.Cleanup(server.Close)
+
+	prefectClient, err := client.New(
+		client.WithEndpoint(server.URL+"/api"),
+		client.WithRetries(0, client.DefaultRetryBaseDelay),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	workspacesClient, err := prefectClient.Workspaces(uuid.Nil)
+	if err != nil {
+		t.Fatalf("failed to create workspaces client: %s", err)
+	}
+
+	err = workspacesClient.Delete(context.Background(), uuid.New())
+	if !errors.Is(err, api.ErrHasDependents) {
+		t.Errorf("expected api.ErrHasDependents, got: %v", err)
+	}
+
+	// The server's explanation is kept, so that it can be surfaced to the user.
+	var responseErr *api.ResponseError
+	if !errors.As(err, &responseErr) || responseErr.Detail != "Workspace has deployments." {
+		t.Errorf("expected the response detail to be preserved, got: %v", err)
+	}
+}
+
+func TestWorkspacesClient_Update_description(t *testing.T) {
+	t.Parallel()
+
+	description := "Managed by the compliance team"
+	emptyDescription := ""
+
+	tests := map[string]struct {
+		data     api.WorkspaceUpdate
+		expected map[string]interface{}
+	}{
+		"set": {
+			data:     api.WorkspaceUpdate{Description: &description},
+			expected: map[string]interface{}{"description": description},
+		},
+		"empty": {
+			data:     api.WorkspaceUpdate{Description: &emptyDescription},
+			expected: map[string]interface{}{"description": ""},
+		},
+		"clear": {
+			data:     api.WorkspaceUpdate{ClearDescription: true},
+			expected: map[string]interface{}{"description": nil},
+		},
+		"unchanged": {
+			data:     api.WorkspaceUpdate{Name: &description},
+			expected: map[string]interface{}{"name": description},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("expected PATCH, got %s", r.Method)
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request body: %s", err)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			prefectClient, err := client.New(
+				client.WithEndpoint(server.URL+"/api"),
+				client.WithRetries(0, client.DefaultRetryBaseDelay),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %s", err)
+			}
+
+			workspacesClient, err := prefectClient.Workspaces(uuid.New())
+			if err != nil {
+				t.Fatalf("failed to create workspaces client: %s", err)
+			}
+
+			if err := workspacesClient.Update(context.Background(), uuid.New(), test.data); err != nil {
+				t.Fatalf("failed to update workspace: %s", err)
+			}
+
+			if len(body) != len(test.expected) {
+				t.Errorf("expected request body %v, got %v", test.expected, body)
+			}
+
+			for key, expected := range test.expected {
+				value, ok := body[key]
+				if !ok || value != expected {
+					t.Errorf("expected %s=%v in request body, got %v", key, expected, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ = basetypes.StringTypable(&JSONStringType{})
+	_ = xattr.TypeWithValidate(&JSONStringType{})
+	_ = fmt.Stringer(&JSONStringType{})
+)
+
+// JSONStringType implements a custom Terraform type that represents
+// a JSON document stored as a string, such as a job template or
+// block data. Values are compared structurally, so formatting changes
+// made by the server do not produce a diff.
+type JSONStringType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if this type and o are equal.
+func (t JSONStringType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// String represents a string representation of JSONStringType.
+func (t JSONStringType) String() string {
+	return "JSONStringType"
+}
+
+// ValueFromString converts a string value to a JSONStringValue.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t JSONStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value := JSONStringValue{
+		StringValue: in,
+	}
+
+	return value, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a JSONStringValue.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t JSONStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error converting value from Terraform: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns an instance of the value.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t JSONStringType) ValueType(_ context.Context) attr.Value {
+	return JSONStringValue{}
+}
+
+// Validate ensures that the string is a valid JSON document.
+func (t JSONStringType) Validate(_ context.Context, value tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	var jsonStr string
+	if err := value.As(&jsonStr); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid Terraform Value",
+			fmt.Sprintf("Failed to convert %T to string: %s. Please report this issue to the provider developers.", value, err.Error()),
+		)
+
+		return diags
+	}
+
+	if !json.Valid([]byte(jsonStr)) {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid JSON String Value",
+			fmt.Sprintf("Failed to parse string %q as JSON. Use jsonencode() to build the value.", jsonStr),
+		)
+
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,94 @@
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ = basetypes.StringValuable(&JSONStringValue{})
+	_ = basetypes.StringValuableWithSemanticEquals(&JSONStringValue{})
+	_ = fmt.Stringer(&JSONStringValue{})
+)
+
+// JSONStringValue implements a custom Terraform value that represents
+// a JSON document stored as a string.
+type JSONStringValue struct {
+	basetypes.StringValue
+}
+
+// NewJSONStringNull creates a JSONString with a null value.
+func NewJSONStringNull() JSONStringValue {
+	return JSONStringValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewJSONStringUnknown creates a JSONString with an unknown value.
+func NewJSONStringUnknown() JSONStringValue {
+	return JSONStringValue{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewJSONStringValue creates a JSONString with a known value.
+func NewJSONStringValue(value string) JSONStringValue {
+	return JSONStringValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// Equal returns true if this value is equal to o.
+func (v JSONStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONStringValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns an instance of the type.
+//
+//nolint:ireturn // required to implement StringValuable
+func (v JSONStringValue) Type(_ context.Context) attr.Type {
+	return JSONStringType{}
+}
+
+func (v JSONStringValue) String() string {
+	return "JSONStringValue"
+}
+
+// StringSemanticEquals checks if two JSONStringValue objects hold
+// structurally equal JSON documents, ignoring differences in whitespace
+// and object key ordering.
+func (v JSONStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	var priorJSON, newJSON interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &priorJSON); err != nil {
+		return false, nil
+	}
+
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &newJSON); err != nil {
+		return false, nil
+	}
+
+	return reflect.DeepEqual(priorJSON, newJSON), nil
+}
@@ -0,0 +1,114 @@
+package customtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+func TestJSONStringValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prior    string
+		current  string
+		expected bool
+	}{
+		"identical": {
+			prior:    `{"a":1,"b":[1,2]}`,
+			current:  `{"a":1,"b":[1,2]}`,
+			expected: true,
+		},
+		"reordered keys": {
+			prior:    `{"a":1,"b":{"c":true,"d":null}}`,
+			current:  `{"b":{"d":null,"c":true},"a":1}`,
+			expected: true,
+		},
+		"whitespace": {
+			prior:    `{"a":1,"b":[1,2]}`,
+			current:  "{\n  \"a\": 1,\n  \"b\": [\n    1,\n    2\n  ]\n}\n",
+			expected: true,
+		},
+		"reordered array": {
+			prior:    `{"b":[1,2]}`,
+			current:  `{"b":[2,1]}`,
+			expected: false,
+		},
+		"different value": {
+			prior:    `{"a":1}`,
+			current:  `{"a":"1"}`,
+			expected: false,
+		},
+		"additional key": {
+			prior:    `{"a":1}`,
+			current:  `{"a":1,"b":2}`,
+			expected: false,
+		},
+		"invalid json": {
+			prior:    `{"a":1}`,
+			current:  `{"a":1`,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prior := customtypes.NewJSONStringValue(test.prior)
+			current := customtypes.NewJSONStringValue(test.current)
+
+			equal, diags := prior.StringSemanticEquals(context.Background(), current)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if equal != test.expected {
+				t.Errorf("expected semantic equality to be %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
+
+func TestJSONStringType_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     tftypes.Value
+		expectErr bool
+	}{
+		"object": {
+			value: tftypes.NewValue(tftypes.String, `{"a": 1}`),
+		},
+		"array": {
+			value: tftypes.NewValue(tftypes.String, `[1, 2]`),
+		},
+		"null": {
+			value: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"invalid": {
+			value:     tftypes.NewValue(tftypes.String, `{"a": 1`),
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := customtypes.JSONStringType{}.Validate(context.Background(), test.value, path.Root("test"))
+			if diags.HasError() != test.expectErr {
+				t.Errorf("expected error to be %t, got diagnostics: %v", test.expectErr, diags)
+			}
+		})
+	}
+}
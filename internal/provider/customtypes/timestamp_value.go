@@ -18,6 +18,11 @@ var (
 
 // TimestampValue implements a custom Terraform value that represents
 // a valid RFC3339 timestamp.
+//
+// Its semantic equality keeps configured timestamps and refreshed state
+// from differing by representation alone, such as `+00:00` and `Z`. It is
+// not applied to planned values of computed attributes, which is why those
+// also use the helpers.EquivalentTimestamp plan modifier.
 type TimestampValue struct {
 	basetypes.StringValue
 }
@@ -41,9 +46,11 @@ func NewTimestampUnknown() TimestampValue {
 
 // NewTimestampValue creates a Timestamp with a known value. Access
 // the value via the TimestampValue type ValueTime method.
+// The value is always formatted in UTC, so that the same instant is
+// represented the same way regardless of the zone it was returned in.
 func NewTimestampValue(value time.Time) TimestampValue {
 	return TimestampValue{
-		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339)),
+		StringValue: basetypes.NewStringValue(value.UTC().Format(time.RFC3339)),
 	}
 }
 
@@ -0,0 +1,76 @@
+package customtypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+func TestNewTimestampValue_formatsUTC(t *testing.T) {
+	t.Parallel()
+
+	value := time.Date(2024, time.March, 1, 14, 30, 0, 0, time.FixedZone("CET", 60*60))
+
+	timestamp := customtypes.NewTimestampValue(value)
+	if timestamp.ValueString() != "2024-03-01T13:30:00Z" {
+		t.Errorf("expected timestamp 2024-03-01T13:30:00Z, got %s", timestamp.ValueString())
+	}
+
+	if !timestamp.ValueTime().Equal(value) {
+		t.Errorf("expected timestamp to be the same instant as %s, got %s", value, timestamp.ValueTime())
+	}
+}
+
+func TestTimestampValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prior    string
+		current  string
+		expected bool
+	}{
+		"identical": {
+			prior:    "2024-03-01T13:30:00Z",
+			current:  "2024-03-01T13:30:00Z",
+			expected: true,
+		},
+		"+00:00 and Z": {
+			prior:    "2024-03-01T13:30:00+00:00",
+			current:  "2024-03-01T13:30:00Z",
+			expected: true,
+		},
+		"different offset": {
+			prior:    "2024-03-01T14:30:00+01:00",
+			current:  "2024-03-01T13:30:00Z",
+			expected: true,
+		},
+		"different instant": {
+			prior:    "2024-03-01T13:30:00+01:00",
+			current:  "2024-03-01T13:30:00Z",
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prior := customtypes.TimestampValue{StringValue: basetypes.NewStringValue(test.prior)}
+			current := customtypes.TimestampValue{StringValue: basetypes.NewStringValue(test.current)}
+
+			equal, diags := prior.StringSemanticEquals(context.Background(), current)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if equal != test.expected {
+				t.Errorf("expected semantic equality to be %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
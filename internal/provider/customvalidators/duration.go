@@ -0,0 +1,41 @@
+package customvalidators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ = validator.String(DurationValidator{})
+
+// DurationValidator validates that a string attribute contains
+// a positive duration, as accepted by time.ParseDuration.
+type DurationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v DurationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, such as 30s or 10m"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration String Value",
+			fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
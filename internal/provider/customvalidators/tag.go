@@ -0,0 +1,43 @@
+package customvalidators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ = validator.String(TagValidator{})
+
+// TagValidator validates that a string attribute contains a usable tag,
+// rejecting empty and whitespace-only values that the API would silently store.
+//
+// It is intended to be applied to tag sets with setvalidator.ValueStringsAre.
+type TagValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v TagValidator) Description(_ context.Context) string {
+	return "tags must not be empty or contain only whitespace"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v TagValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v TagValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.TrimSpace(value) == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Tag Value",
+			fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,41 @@
+package customvalidators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ = validator.String(UUIDValidator{})
+
+// UUIDValidator validates that a string attribute contains a valid UUID,
+// so that malformed IDs are reported at plan time rather than mid-apply.
+type UUIDValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v UUIDValidator) Description(_ context.Context) string {
+	return "value must be a valid UUID"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v UUIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v UUIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := uuid.Parse(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid UUID String Value",
+			fmt.Sprintf("Attribute %s %s, got %q: %s", req.Path, v.Description(ctx), value, err.Error()),
+		)
+	}
+}
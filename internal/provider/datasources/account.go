@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -32,6 +36,18 @@ type AccountDataSourceModel struct {
 	Link                  types.String `tfsdk:"link"`
 	AllowPublicWorkspaces types.Bool   `tfsdk:"allow_public_workspaces"`
 	BillingEmail          types.String `tfsdk:"billing_email"`
+	Settings              types.Object `tfsdk:"settings"`
+}
+
+// accountSettingsAttributeTypes defines the attribute types
+// of the account settings object.
+var accountSettingsAttributeTypes = map[string]attr.Type{
+	"plan_type":                types.StringType,
+	"self_serve":               types.BoolType,
+	"run_retention_days":       types.Int64Type,
+	"audit_log_retention_days": types.Int64Type,
+	"automations_limit":        types.Int64Type,
+	"features":                 types.ListType{ElemType: types.StringType},
 }
 
 // NewAccountDataSource returns a new AccountDataSource.
@@ -72,13 +88,16 @@ func (d *AccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		Description: `
 Get information about an existing Account.
 <br>
-Use this data source to obtain account-level attributes
+Use this data source to obtain account-level attributes, such as the account handle or billing plan.
+If no ID is provided, the account configured on the provider is used.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
-				Description: "Account ID (UUID)",
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
+				Computed:    true,
 			},
 			"created": schema.StringAttribute{
 				Computed:    true,
@@ -114,6 +133,37 @@ Use this data source to obtain account-level attributes
 				Computed:    true,
 				Description: "Billing email to apply to the account's Stripe customer",
 			},
+			"settings": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Plan and feature settings of the account",
+				Attributes: map[string]schema.Attribute{
+					"plan_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Billing plan (tier) of the account",
+					},
+					"self_serve": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether or not the account is on a self-serve plan",
+					},
+					"run_retention_days": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of days flow and task runs are retained",
+					},
+					"audit_log_retention_days": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of days audit logs are retained",
+					},
+					"automations_limit": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Maximum number of automations allowed in the account",
+					},
+					"features": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Features enabled for the account",
+					},
+				},
+			},
 		},
 	}
 }
@@ -136,7 +186,23 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	client, err := d.client.Accounts(accountID)
 	if err != nil {
+		// The client can only fail to be created if no account could be resolved,
+		// so we'll explain how to provide one rather than report a provider bug.
+		if model.ID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Account could not be resolved",
+				"No account ID was provided to the data source, and no account is configured on the provider. "+
+					"Set the `id` attribute, or configure the provider's `account_id` attribute "+
+					"(or the PREFECT_CLOUD_ACCOUNT_ID environment variable).",
+			)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
+
+		return
 	}
 
 	account, err := client.Get(ctx)
@@ -160,6 +226,27 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	model.Location = types.StringPointerValue(account.Location)
 	model.Name = types.StringValue(account.Name)
 
+	features, diags := types.ListValueFrom(ctx, types.StringType, account.Features)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := types.ObjectValue(accountSettingsAttributeTypes, map[string]attr.Value{
+		"plan_type":                types.StringValue(account.PlanType),
+		"self_serve":               types.BoolValue(account.SelfServe),
+		"run_retention_days":       types.Int64Value(account.RunRetentionDays),
+		"audit_log_retention_days": types.Int64Value(account.AuditLogRetentionDays),
+		"automations_limit":        types.Int64Value(account.AutomationsLimit),
+		"features":                 features,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Settings = settings
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -3,12 +3,17 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -31,7 +36,8 @@ type AccountMemberDataSourceModel struct {
 	AccountRoleID   customtypes.UUIDValue `tfsdk:"account_role_id"`
 	AccountRoleName types.String          `tfsdk:"account_role_name"`
 
-	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
 }
 
 // NewAccountMemberDataSource returns a new AccountMemberDataSource.
@@ -102,13 +108,19 @@ func (d *AccountMemberDataSource) Schema(_ context.Context, _ datasource.SchemaR
 	}
 	accountMemberAttributes["account_id"] = schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID) where the member resides",
 		Optional:    true,
 	}
+	accountMemberAttributes["account_handle"] = schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	}
 
 	resp.Schema = schema.Schema{
 		Description: `
-Get information about an existing Account Member (user)	by their email.
+Get information about an existing Account Member (user) by their email. The email lookup is case-insensitive.
 <br>
 Use this data source to obtain user or actor IDs to manage Workspace Access.
 `,
@@ -145,7 +157,13 @@ func (d *AccountMemberDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	client, err := d.client.AccountMemberships(config.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, config.AccountID, config.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.AccountMemberships(accountID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Memberships", err))
 
@@ -155,25 +173,52 @@ func (d *AccountMemberDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Fetch an existing Account Member by email
 	// Here, we'd expect only 1 Member (or none) to be returned
 	// as we are querying a single Member email, not a list of emails
-	// workspaceRoles, err := client.List(ctx, []string{model.Name.ValueString()})
-	accountMembers, err := client.List(ctx, []string{config.Email.ValueString()})
+	email := config.Email.ValueString()
+	accountMembers, err := client.List(ctx, []string{email}, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Account Member state",
 			fmt.Sprintf("Could not search for Account Members, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
-	if len(accountMembers) != 1 {
-		resp.Diagnostics.AddError(
+	// The API filter matches emails exactly, while emails are case-insensitive.
+	// If there is no exact match, we'll compare the email against all Account Members.
+	if len(accountMembers) == 0 {
+		accountMembers, err = client.List(ctx, nil, "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error refreshing Account Member state",
+				fmt.Sprintf("Could not search for Account Members, unexpected error: %s", err.Error()),
+			)
+
+			return
+		}
+	}
+
+	matchingAccountMembers := make([]*api.AccountMembership, 0, 1)
+	for _, accountMember := range accountMembers {
+		if strings.EqualFold(accountMember.Email, email) {
+			matchingAccountMembers = append(matchingAccountMembers, accountMember)
+		}
+	}
+
+	if len(matchingAccountMembers) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
 			"Could not find Account Member",
-			fmt.Sprintf("Could not find Account Member with email %s", config.Email.ValueString()),
+			fmt.Sprintf("Could not find Account Member with email %s", email),
 		)
 
 		return
 	}
 
-	fetchedAccountMember := accountMembers[0]
+	fetchedAccountMember := matchingAccountMembers[0]
+
+	// NOTE: the configured email is kept as-is in state,
+	// as it may differ in case from the stored email.
 
 	config.ID = customtypes.NewUUIDValue(fetchedAccountMember.ID)
 	config.ActorID = customtypes.NewUUIDValue(fetchedAccountMember.ActorID)
@@ -181,7 +226,6 @@ func (d *AccountMemberDataSource) Read(ctx context.Context, req datasource.ReadR
 	config.FirstName = types.StringValue(fetchedAccountMember.FirstName)
 	config.LastName = types.StringValue(fetchedAccountMember.LastName)
 	config.Handle = types.StringValue(fetchedAccountMember.Handle)
-	config.Email = types.StringValue(fetchedAccountMember.Email)
 	config.AccountRoleID = customtypes.NewUUIDValue(fetchedAccountMember.AccountRoleID)
 	config.AccountRoleName = types.StringValue(fetchedAccountMember.AccountRoleName)
 
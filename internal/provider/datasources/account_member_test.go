@@ -38,6 +38,15 @@ func TestAccDatasource_account_member(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
 				),
 			},
+			{
+				// Check that the email lookup is case-insensitive
+				Config: fixtureAccAccountMember("Marvin+TF-Acceptance-Tester@prefect.io"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "email", "Marvin+TF-Acceptance-Tester@prefect.io"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
+				),
+			},
 		},
 	})
 }
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -24,7 +28,10 @@ type AccountMembersDataSource struct {
 type AccountMembersDataSourceModel struct {
 	Members types.List `tfsdk:"members"`
 
-	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	AccountRoleID customtypes.UUIDValue `tfsdk:"account_role_id"`
+	EmailDomain   types.String          `tfsdk:"email_domain"`
 }
 
 // NewAccountMemberDataSource returns a new AccountMemberDataSource.
@@ -45,14 +52,33 @@ func (d *AccountMembersDataSource) Schema(_ context.Context, _ datasource.Schema
 		Description: `
 Get information about all members of account.
 <br>
-Use this data source to obtain user or actor IDs to manage Workspace Access.
+Use this data source to obtain user or actor IDs to manage Workspace Access,
+optionally filtered to the members holding a given Account Role
+or to the members with an email in a given domain.
 `,
 		Attributes: map[string]schema.Attribute{
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"account_role_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account Role ID (UUID) to filter by, for example to list only the Admins of the account",
+				Optional:    true,
+			},
+			"email_domain": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				Description: "Email domain to filter by, such as `example.com`, for example to list only the members from an external company. The domain is matched case-insensitively",
+				Optional:    true,
+			},
 			"members": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of Account members of an account",
@@ -93,21 +119,29 @@ func (d *AccountMembersDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	client, err := d.client.AccountMemberships(model.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.AccountMemberships(accountID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Memberships", err))
 
 		return
 	}
 
-	// Fetch all existing account members
+	// Fetch all existing account members, in the given email domain if set
 	var filter []string
-	accountMembers, err := client.List(ctx, filter)
+	accountMembers, err := client.List(ctx, filter, model.EmailDomain.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Account Members state",
 			fmt.Sprintf("Could not retrieve Account Members, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
 	attributeTypes := map[string]attr.Type{
@@ -125,8 +159,13 @@ func (d *AccountMembersDataSource) Read(ctx context.Context, req datasource.Read
 	memberObjects := make([]attr.Value, 0, len(accountMembers))
 
 	for _, accountMember := range accountMembers {
-		attributeValues := map[string]attr.Value{
+		// The account memberships filter does not support roles,
+		// so members are filtered by role after they are fetched.
+		if !model.AccountRoleID.IsNull() && accountMember.AccountRoleID != model.AccountRoleID.ValueUUID() {
+			continue
+		}
 
+		attributeValues := map[string]attr.Value{
 			"id":                customtypes.NewUUIDValue(accountMember.ID),
 			"actor_id":          customtypes.NewUUIDValue(accountMember.ActorID),
 			"user_id":           customtypes.NewUUIDValue(accountMember.UserID),
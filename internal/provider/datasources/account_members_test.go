@@ -0,0 +1,66 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccAccountMembers() string {
+	return `
+data "prefect_account_members" "all" {}
+`
+}
+
+func fixtureAccAccountMembersByRole(roleName string) string {
+	return fmt.Sprintf(`
+data "prefect_account_role" "role" {
+	name = "%s"
+}
+data "prefect_account_members" "by_role" {
+	account_role_id = data.prefect_account_role.role.id
+}
+`, roleName)
+}
+
+func fixtureAccAccountMembersByEmailDomain(emailDomain string) string {
+	return fmt.Sprintf(`
+data "prefect_account_members" "by_email_domain" {
+	email_domain = "%s"
+}
+`, emailDomain)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_account_members(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccAccountMembers(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prefect_account_members.all", "members.#"),
+					resource.TestCheckResourceAttrSet("data.prefect_account_members.all", "members.0.email"),
+				),
+			},
+			{
+				// Check that members can be filtered by account role
+				Config: fixtureAccAccountMembersByRole("Admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prefect_account_members.by_role", "members.#"),
+					resource.TestCheckResourceAttrPair("data.prefect_account_members.by_role", "members.0.account_role_id", "data.prefect_account_role.role", "id"),
+				),
+			},
+			{
+				// Check that members can be filtered by email domain
+				Config: fixtureAccAccountMembersByEmailDomain("no-members.invalid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_account_members.by_email_domain", "members.#", "0"),
+				),
+			},
+		},
+	})
+}
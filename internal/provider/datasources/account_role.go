@@ -3,14 +3,18 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -29,13 +33,14 @@ type AccountRoleDataSourceModel struct {
 	Created customtypes.TimestampValue `tfsdk:"created"`
 	Updated customtypes.TimestampValue `tfsdk:"updated"`
 
-	Name         types.String          `tfsdk:"name"`
-	Permissions  types.List            `tfsdk:"permissions"`
-	AccountID    customtypes.UUIDValue `tfsdk:"account_id"`
-	IsSystemRole types.Bool            `tfsdk:"is_system_role"`
+	Name          types.String          `tfsdk:"name"`
+	Permissions   types.List            `tfsdk:"permissions"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	IsSystemRole  types.Bool            `tfsdk:"is_system_role"`
 }
 
-// NewWorkspaceRoleDataSource returns a new WorkspaceRoleDataSource.
+// NewAccountRoleDataSource returns a new AccountRoleDataSource.
 //
 //nolint:ireturn // required by Terraform API
 func NewAccountRoleDataSource() datasource.DataSource {
@@ -51,9 +56,10 @@ func (d *AccountRoleDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *AccountRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
-Get information about an existing Workspace Role.
+Get information about an existing Account Role.
 <br>
-Use this data source read down the pre-defined Roles, to manage User and Service Account access.
+Use this data source to read down the pre-defined Roles (such as Admin or Member) by name, to manage User and Service Account access
+without hardcoding Account Role IDs, which differ between accounts.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,9 +79,9 @@ Use this data source read down the pre-defined Roles, to manage User and Service
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the Account Role",
+				Description: "Name of the Account Role, e.g. `Admin` or `Member`",
 				Validators: []validator.String{
-					stringvalidator.OneOf("Admin", "Member"),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"permissions": schema.ListAttribute{
@@ -85,8 +91,15 @@ Use this data source read down the pre-defined Roles, to manage User and Service
 			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
+				Computed:    true,
 				CustomType:  customtypes.UUIDType{},
-				Description: "Account ID (UUID) where the resource resides",
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID) where the resource resides, defaults to the account set in the provider",
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
 			},
 			"is_system_role": schema.BoolAttribute{
 				Computed:    true,
@@ -125,7 +138,13 @@ func (d *AccountRoleDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	client, err := d.client.AccountRoles(config.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, config.AccountID, config.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.AccountRoles(accountID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Roles", err))
 
@@ -138,15 +157,33 @@ func (d *AccountRoleDataSource) Read(ctx context.Context, req datasource.ReadReq
 	accountRoles, err := client.List(ctx, []string{config.Name.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error refreshing Workspace Role state",
-			fmt.Sprintf("Could not read Workspace Role, unexpected error: %s", err.Error()),
+			"Error refreshing Account Role state",
+			fmt.Sprintf("Could not read Account Role, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
 	if len(accountRoles) != 1 {
-		resp.Diagnostics.AddError(
-			"Could not find Workspace Role",
-			fmt.Sprintf("Could not find Workspace Role with name %s", config.Name.String()),
+		// To help practitioners fix their configuration, we'll list
+		// the names of the Account Roles that are available instead.
+		detail := fmt.Sprintf("Could not find Account Role with name %s.", config.Name.String())
+
+		allRoles, err := client.List(ctx, nil)
+		if err == nil && len(allRoles) > 0 {
+			names := make([]string, 0, len(allRoles))
+			for _, role := range allRoles {
+				names = append(names, fmt.Sprintf("%q", role.Name))
+			}
+
+			sort.Strings(names)
+			detail += fmt.Sprintf(" Available Account Roles are: %s.", strings.Join(names, ", "))
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Could not find Account Role",
+			detail,
 		)
 
 		return
@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -42,6 +43,12 @@ func TestAccDatasource_account_role_defaults(t *testing.T) {
 		})
 	}
 
+	// Unknown account role names should list the available roles
+	testSteps = append(testSteps, resource.TestStep{
+		Config:      fixtureAccAccountRoleDataSource("NotARealRole"),
+		ExpectError: regexp.MustCompile(`(?s)Available Account Roles are:.*"Admin"`),
+	})
+
 	resource.ParallelTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
 		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
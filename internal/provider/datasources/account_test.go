@@ -30,6 +30,7 @@ func TestAccDatasource_account(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "id", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID")),
 					resource.TestCheckResourceAttrSet(datasourceName, "name"),
 					resource.TestCheckResourceAttrSet(datasourceName, "handle"),
+					resource.TestCheckResourceAttrSet(datasourceName, "settings.plan_type"),
 				),
 			},
 		},
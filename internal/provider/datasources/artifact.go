@@ -0,0 +1,258 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&ArtifactDataSource{})
+
+// ArtifactDataSource contains state for the data source.
+type ArtifactDataSource struct {
+	client api.PrefectClient
+}
+
+// ArtifactDataSourceModel defines the Terraform data source model.
+type ArtifactDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Key         types.String          `tfsdk:"key"`
+	Type        types.String          `tfsdk:"type"`
+	Data        types.String          `tfsdk:"data"`
+	Description types.String          `tfsdk:"description"`
+	FlowRunID   customtypes.UUIDValue `tfsdk:"flow_run_id"`
+	TaskRunID   customtypes.UUIDValue `tfsdk:"task_run_id"`
+}
+
+// NewArtifactDataSource returns a new ArtifactDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewArtifactDataSource() datasource.DataSource {
+	return &ArtifactDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ArtifactDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_artifact"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *ArtifactDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *ArtifactDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Artifact by key or ID.
+<br>
+When looking up by key, the most recent Artifact with that key is returned.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Artifact ID (UUID)",
+				Optional:    true,
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Key of the artifact. When set, the most recent artifact with this key is returned.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the artifact (e.g. `markdown`, `table`, `link`)",
+			},
+			"data": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data of the artifact. Markdown and link artifacts are returned as-is; structured data, such as tables, is JSON-encoded.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the artifact",
+			},
+			"flow_run_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the flow run that created the artifact",
+			},
+			"task_run_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the task run that created the artifact",
+			},
+		},
+	}
+}
+
+// artifactDataString returns the artifact data as a string,
+// JSON-encoding any data that is not already a string.
+func artifactDataString(data any) (types.String, error) {
+	switch value := data.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(value), nil
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return types.StringNull(), fmt.Errorf("failed to encode artifact data: %w", err)
+		}
+
+		return types.StringValue(string(encoded)), nil
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ArtifactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model ArtifactDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.ID.IsNull() && !model.Key.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting artifact lookup keys",
+			"Artifacts can be identified by their key or ID, but not both.",
+		)
+
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Artifacts(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Artifact", err))
+
+		return
+	}
+
+	var artifact *api.Artifact
+
+	switch {
+	case !model.ID.IsNull():
+		artifact, err = client.Get(ctx, model.ID.ValueUUID())
+	case !model.Key.IsNull():
+		artifact, err = client.GetLatestByKey(ctx, model.Key.ValueString())
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Key are unset",
+			"Either an Artifact ID or Key is required to read an artifact.",
+		)
+
+		return
+	}
+
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Artifact", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing artifact state",
+			fmt.Sprintf("Could not read artifact with ID=%s and key=%s, unexpected error: %s", model.ID.ValueString(), model.Key.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	data, err := artifactDataString(artifact.Data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Failed to serialize Artifact data",
+			fmt.Sprintf("Could not serialize Artifact data as JSON string: %s", err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(artifact.ID)
+	model.Created = customtypes.NewTimestampPointerValue(artifact.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(artifact.Updated)
+
+	model.Key = types.StringPointerValue(artifact.Key)
+	model.Type = types.StringPointerValue(artifact.Type)
+	model.Data = data
+	model.Description = types.StringPointerValue(artifact.Description)
+	model.FlowRunID = customtypes.NewUUIDPointerValue(artifact.FlowRunID)
+	model.TaskRunID = customtypes.NewUUIDPointerValue(artifact.TaskRunID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccArtifactByKey(key string) string {
+	return fmt.Sprintf(`
+	data "prefect_workspace" "evergreen" {
+		handle = "github-ci-tests"
+	}
+	data "prefect_artifact" "test" {
+		key = "%s"
+		workspace_id = data.prefect_workspace.evergreen.id
+	}
+	`, key)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_artifact_missing(t *testing.T) {
+	missingKey := "missing-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      fixtureAccArtifactByKey(missingKey),
+				ExpectError: regexp.MustCompile("Artifact not found"),
+			},
+		},
+	})
+}
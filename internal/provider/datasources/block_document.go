@@ -0,0 +1,208 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlockDocumentDataSource{})
+
+// BlockDocumentDataSource contains state for the data source.
+type BlockDocumentDataSource struct {
+	client api.PrefectClient
+}
+
+// BlockDocumentDataSourceModel defines the Terraform data source model.
+type BlockDocumentDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name          types.String                `tfsdk:"name"`
+	BlockTypeSlug types.String                `tfsdk:"block_type_slug"`
+	BlockTypeID   customtypes.UUIDValue       `tfsdk:"block_type_id"`
+	BlockSchemaID customtypes.UUIDValue       `tfsdk:"block_schema_id"`
+	Data          customtypes.JSONStringValue `tfsdk:"data"`
+}
+
+// NewBlockDocumentDataSource returns a new BlockDocumentDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockDocumentDataSource() datasource.DataSource {
+	return &BlockDocumentDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlockDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_document"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlockDocumentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *BlockDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Block Document by name and block type.
+<br>
+Use this data source to reference blocks managed elsewhere, for example to wire a deployment to its storage block by ID.
+Secret fields are redacted in the returned data.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Document ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the block document",
+			},
+			"block_type_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the block type, such as `s3-bucket` or `slack-webhook`",
+			},
+			"block_type_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Type ID (UUID)",
+			},
+			"block_schema_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Schema ID (UUID)",
+			},
+			"data": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.JSONStringType{},
+				Description: "The fields of the block document, as a JSON string. Secret fields are redacted.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlockDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlockDocumentDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Blocks(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blockDocument, err := client.GetByName(ctx, model.BlockTypeSlug.ValueString(), model.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Block Document", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing block document state",
+			fmt.Sprintf("Could not read block document with block type %s and name %s, unexpected error: %s", model.BlockTypeSlug.ValueString(), model.Name.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(blockDocument.ID)
+	model.Created = customtypes.NewTimestampPointerValue(blockDocument.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(blockDocument.Updated)
+
+	model.Name = types.StringValue(blockDocument.Name)
+	model.BlockTypeID = customtypes.NewUUIDValue(blockDocument.BlockTypeID)
+	model.BlockSchemaID = customtypes.NewUUIDValue(blockDocument.BlockSchemaID)
+
+	data, err := json.Marshal(blockDocument.Data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Failed to serialize Block Document data",
+			fmt.Sprintf("Failed to serialize Block Document data as JSON string: %s", err),
+		)
+
+		return
+	}
+	model.Data = customtypes.NewJSONStringValue(string(data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,55 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccBlockDocumentByName(name string) string {
+	return fmt.Sprintf(`
+	data "prefect_workspace" "evergreen" {
+		handle = "github-ci-tests"
+	}
+	resource "prefect_block_document" "test" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		name = "%s"
+		block_type_slug = "secret"
+		data = jsonencode({
+			value = "hunter2"
+		})
+	}
+	data "prefect_block_document" "test" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		name = prefect_block_document.test.name
+		block_type_slug = prefect_block_document.test.block_type_slug
+	}
+	`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_block_document(t *testing.T) {
+	datasourceName := "data.prefect_block_document.test"
+	resourceName := "prefect_block_document.test"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlockDocumentByName(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "block_schema_id", resourceName, "block_schema_id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", randomName),
+					// The secret value is redacted by the server
+					resource.TestCheckResourceAttr(datasourceName, "data", `{"value":"********"}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,249 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlockSchemaDataSource{})
+
+// BlockSchemaDataSource contains state for the data source.
+type BlockSchemaDataSource struct {
+	client api.PrefectClient
+}
+
+// BlockSchemaDataSourceModel defines the Terraform data source model.
+type BlockSchemaDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	BlockTypeSlug types.String                `tfsdk:"block_type_slug"`
+	BlockTypeID   customtypes.UUIDValue       `tfsdk:"block_type_id"`
+	Version       types.String                `tfsdk:"version"`
+	Checksum      types.String                `tfsdk:"checksum"`
+	Fields        customtypes.JSONStringValue `tfsdk:"fields"`
+	Capabilities  types.List                  `tfsdk:"capabilities"`
+}
+
+// NewBlockSchemaDataSource returns a new BlockSchemaDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockSchemaDataSource() datasource.DataSource {
+	return &BlockSchemaDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlockSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_schema"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlockSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *BlockSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Block Schema by the slug of its Block Type, such as ` + "`s3-bucket`" + `,
+and optionally its version.
+<br>
+Use this data source to read the field definitions of a Block Type, such as to validate
+the ` + "`data`" + ` of a Block Document or to find its required fields before creating it.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block schema ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"block_type_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the block type of the block schema, such as `s3-bucket` or `secret`",
+			},
+			"block_type_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the block type of the block schema",
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the block schema, such as `2.20.0`. Defaults to the latest version of the block type's schema.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "Checksum of the block schema",
+			},
+			"fields": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.JSONStringType{},
+				Description: "The field definitions of the block schema, as a JSON Schema string. Use `jsondecode()` to read its `properties` and `required` fields.",
+			},
+			"capabilities": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Capabilities of the block schema, such as `read-path` or `write-path`",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlockSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlockSchemaDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blockTypesClient, err := d.client.BlockTypes(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return
+	}
+
+	client, err := d.client.BlockSchemas(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
+
+		return
+	}
+
+	blockType, err := blockTypesClient.GetBySlug(ctx, model.BlockTypeSlug.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Block Type", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing block schema state",
+			fmt.Sprintf("Could not read block type with slug=%s, unexpected error: %s", model.BlockTypeSlug.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	blockSchema, err := client.GetByBlockType(ctx, blockType.ID, model.Version.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Block Schema", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing block schema state",
+			fmt.Sprintf("Could not read block schema of block type with slug=%s, unexpected error: %s", model.BlockTypeSlug.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(blockSchema.ID)
+	model.Created = customtypes.NewTimestampPointerValue(blockSchema.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(blockSchema.Updated)
+
+	model.BlockTypeSlug = types.StringValue(blockType.Slug)
+	model.BlockTypeID = customtypes.NewUUIDValue(blockSchema.BlockTypeID)
+	model.Version = types.StringValue(blockSchema.Version)
+	model.Checksum = types.StringValue(blockSchema.Checksum)
+
+	fields, err := json.Marshal(blockSchema.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fields"),
+			"Failed to serialize Block Schema fields",
+			fmt.Sprintf("Failed to serialize Block Schema fields as JSON string: %s", err),
+		)
+
+		return
+	}
+	model.Fields = customtypes.NewJSONStringValue(string(fields))
+
+	capabilities, diags := types.ListValueFrom(ctx, types.StringType, blockSchema.Capabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Capabilities = capabilities
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
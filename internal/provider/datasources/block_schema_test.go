@@ -0,0 +1,65 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccBlockSchemaBySlug(slug string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+data "prefect_block_schema" "test" {
+	block_type_slug = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+`, slug)
+}
+
+func fixtureAccBlockSchemaByVersion(slug string, version string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+data "prefect_block_schema" "test" {
+	block_type_slug = "%s"
+	version = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+`, slug, version)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_block_schema(t *testing.T) {
+	dataSourceName := "data.prefect_block_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlockSchemaBySlug("secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "block_type_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checksum"),
+					resource.TestMatchResourceAttr(dataSourceName, "fields", regexp.MustCompile(`"properties"`)),
+				),
+			},
+			{
+				Config:      fixtureAccBlockSchemaByVersion("secret", "0.0.0-not-a-version"),
+				ExpectError: regexp.MustCompile("Block Schema not found"),
+			},
+			{
+				Config:      fixtureAccBlockSchemaBySlug("not-a-real-block-type"),
+				ExpectError: regexp.MustCompile("Block Type not found"),
+			},
+		},
+	})
+}
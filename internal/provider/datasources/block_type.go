@@ -0,0 +1,223 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlockTypeDataSource{})
+
+// BlockTypeDataSource contains state for the data source.
+type BlockTypeDataSource struct {
+	client api.PrefectClient
+}
+
+// BlockTypeDataSourceModel defines the Terraform data source model.
+type BlockTypeDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Slug               types.String          `tfsdk:"slug"`
+	Name               types.String          `tfsdk:"name"`
+	LogoURL            types.String          `tfsdk:"logo_url"`
+	DocumentationURL   types.String          `tfsdk:"documentation_url"`
+	Description        types.String          `tfsdk:"description"`
+	IsProtected        types.Bool            `tfsdk:"is_protected"`
+	BlockSchemaID      customtypes.UUIDValue `tfsdk:"block_schema_id"`
+	BlockSchemaVersion types.String          `tfsdk:"block_schema_version"`
+}
+
+// NewBlockTypeDataSource returns a new BlockTypeDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockTypeDataSource() datasource.DataSource {
+	return &BlockTypeDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlockTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_type"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlockTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *BlockTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Block Type by slug, such as ` + "`s3-bucket`" + `.
+<br>
+Use this data source to reference the latest Block Schema of a Block Type,
+instead of hardcoding Block Schema IDs, which change between Prefect versions.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block type ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Slug of the block type, such as `s3-bucket` or `secret`",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the block type",
+			},
+			"logo_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the block type's logo",
+			},
+			"documentation_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the block type's documentation",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the block type",
+			},
+			"is_protected": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the block type is protected, meaning it is managed by Prefect and cannot be modified",
+			},
+			"block_schema_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the latest block schema of the block type",
+			},
+			"block_schema_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the latest block schema of the block type",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlockTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlockTypeDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.BlockTypes(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return
+	}
+
+	blockType, err := client.GetBySlug(ctx, model.Slug.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Block Type", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing block type state",
+			fmt.Sprintf("Could not read block type with slug=%s, unexpected error: %s", model.Slug.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	blockSchema, err := client.GetLatestBlockSchema(ctx, blockType.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing block type state",
+			fmt.Sprintf("Could not read the latest block schema of block type with slug=%s, unexpected error: %s", model.Slug.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(blockType.ID)
+	model.Created = customtypes.NewTimestampPointerValue(blockType.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(blockType.Updated)
+
+	model.Slug = types.StringValue(blockType.Slug)
+	model.Name = types.StringValue(blockType.Name)
+	model.LogoURL = types.StringPointerValue(blockType.LogoURL)
+	model.DocumentationURL = types.StringPointerValue(blockType.DocumentationURL)
+	model.Description = types.StringPointerValue(blockType.Description)
+	model.IsProtected = types.BoolValue(blockType.IsProtected)
+	model.BlockSchemaID = customtypes.NewUUIDValue(blockSchema.ID)
+	model.BlockSchemaVersion = types.StringValue(blockSchema.Version)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccBlockTypeBySlug(slug string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+data "prefect_block_type" "test" {
+	slug = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+`, slug)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_block_type(t *testing.T) {
+	dataSourceName := "data.prefect_block_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlockTypeBySlug("secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "slug", "secret"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Secret"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "logo_url"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "block_schema_id"),
+				),
+			},
+			{
+				Config:      fixtureAccBlockTypeBySlug("not-a-real-block-type"),
+				ExpectError: regexp.MustCompile("Block Type not found"),
+			},
+		},
+	})
+}
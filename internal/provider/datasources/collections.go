@@ -0,0 +1,228 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&CollectionsDataSource{})
+
+// CollectionsDataSource contains state for the data source.
+type CollectionsDataSource struct {
+	client api.PrefectClient
+}
+
+// CollectionsDataSourceModel defines the Terraform data source model.
+type CollectionsDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Collections types.List   `tfsdk:"collections"`
+}
+
+// NewCollectionsDataSource returns a new CollectionsDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewCollectionsDataSource() datasource.DataSource {
+	return &CollectionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *CollectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *CollectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *CollectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about the Prefect integration Collections, such as prefect-aws or prefect-gcp.
+<br>
+Use this data source to discover the latest versions of the Collections recommended by Prefect,
+for example to keep worker images aligned with them.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a single Collection to fetch, e.g. `prefect-aws`",
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Collections returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Collection package",
+						},
+						"latest_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Latest version of the Collection known to Prefect",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the Collection, taken from the worker it provides (if any)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model CollectionsDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Collections()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Collections", err))
+
+		return
+	}
+
+	blockTypesByPackage, err := client.GetBlockMetadataViews(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing Collections state",
+			fmt.Sprintf("Could not read Collections, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	workerTypeByPackage, err := client.GetWorkerMetadataViews(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing Collections state",
+			fmt.Sprintf("Could not read Collections, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	names := make([]string, 0, len(blockTypesByPackage))
+	for name := range blockTypesByPackage {
+		if !model.Name.IsNull() && model.Name.ValueString() != name {
+			continue
+		}
+
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !model.Name.IsNull() && len(names) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Could not find Collection",
+			fmt.Sprintf("Could not find a Collection with the name %s", model.Name.ValueString()),
+		)
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"name":           types.StringType,
+		"latest_version": types.StringType,
+		"description":    types.StringType,
+	}
+
+	collectionObjects := make([]attr.Value, 0, len(names))
+	for _, name := range names {
+		attributeValues := map[string]attr.Value{
+			"name":           types.StringValue(name),
+			"latest_version": collectionVersion(blockTypesByPackage[name]),
+			"description":    collectionDescription(workerTypeByPackage[name]),
+		}
+
+		collectionObject, diag := types.ObjectValue(attributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		collectionObjects = append(collectionObjects, collectionObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, collectionObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Collections = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// collectionVersion returns the Collection version, as reported
+// by the block schemas registered by the Collection.
+func collectionVersion(collection api.CollectionBlockTypes) types.String {
+	slugs := make([]string, 0, len(collection.BlockTypes))
+	for slug := range collection.BlockTypes {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	for _, slug := range slugs {
+		if version := collection.BlockTypes[slug].BlockSchema.Version; version != "" {
+			return types.StringValue(version)
+		}
+	}
+
+	return types.StringNull()
+}
+
+// collectionDescription returns the description of the
+// first worker type provided by the Collection, if any.
+func collectionDescription(workers api.MetadataByWorkerType) types.String {
+	workerTypes := make([]string, 0, len(workers))
+	for workerType := range workers {
+		workerTypes = append(workerTypes, workerType)
+	}
+	sort.Strings(workerTypes)
+
+	for _, workerType := range workerTypes {
+		if description := workers[workerType].Description; description != "" {
+			return types.StringValue(description)
+		}
+	}
+
+	return types.StringNull()
+}
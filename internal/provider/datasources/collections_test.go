@@ -0,0 +1,56 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccCollections() string {
+	return `
+data "prefect_collections" "all" {}
+`
+}
+
+func fixtureAccCollectionsByName(name string) string {
+	return fmt.Sprintf(`
+data "prefect_collections" "single" {
+	name = "%s"
+}
+`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_collections(t *testing.T) {
+	allDatasourceName := "data.prefect_collections.all"
+	singleDatasourceName := "data.prefect_collections.single"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccCollections(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(allDatasourceName, "collections.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(allDatasourceName, "collections.*", map[string]string{"name": "prefect-aws"}),
+				),
+			},
+			{
+				Config: fixtureAccCollectionsByName("prefect-aws"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singleDatasourceName, "collections.#", "1"),
+					resource.TestCheckResourceAttr(singleDatasourceName, "collections.0.name", "prefect-aws"),
+					resource.TestCheckResourceAttrSet(singleDatasourceName, "collections.0.latest_version"),
+				),
+			},
+			{
+				Config:      fixtureAccCollectionsByName("prefect-does-not-exist"),
+				ExpectError: regexp.MustCompile("Could not find Collection"),
+			},
+		},
+	})
+}
@@ -0,0 +1,309 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&CurrentAccountDataSource{})
+
+// CurrentAccountDataSource contains state for the data source.
+type CurrentAccountDataSource struct {
+	client api.PrefectClient
+}
+
+// CurrentAccountDataSourceModel defines the Terraform data source model.
+type CurrentAccountDataSourceModel struct {
+	ID     customtypes.UUIDValue `tfsdk:"id"`
+	Name   types.String          `tfsdk:"name"`
+	Handle types.String          `tfsdk:"handle"`
+
+	WorkspaceID     customtypes.UUIDValue `tfsdk:"workspace_id"`
+	WorkspaceHandle types.String          `tfsdk:"workspace_handle"`
+
+	Accounts   types.List `tfsdk:"accounts"`
+	Workspaces types.List `tfsdk:"workspaces"`
+}
+
+// currentAccountAccountAttributeTypes defines the attribute types
+// of the objects in the accounts list.
+var currentAccountAccountAttributeTypes = map[string]attr.Type{
+	"id":     customtypes.UUIDType{},
+	"name":   types.StringType,
+	"handle": types.StringType,
+}
+
+// currentAccountWorkspaceAttributeTypes defines the attribute types
+// of the objects in the workspaces list.
+var currentAccountWorkspaceAttributeTypes = map[string]attr.Type{
+	"id":          customtypes.UUIDType{},
+	"name":        types.StringType,
+	"handle":      types.StringType,
+	"description": types.StringType,
+	"account_id":  customtypes.UUIDType{},
+}
+
+// NewCurrentAccountDataSource returns a new CurrentAccountDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewCurrentAccountDataSource() datasource.DataSource {
+	return &CurrentAccountDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *CurrentAccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_account"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *CurrentAccountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *CurrentAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about the Account(s) and Workspace(s) that the configured API key has access to.
+<br>
+Use this data source to discover the Account ID from the API key, instead of passing it as an input.
+When the API key has access to a single Account, such as the key of a Service Account, it is returned in ` + "`id`" + `.
+When that Account has a single Workspace, it is returned in ` + "`workspace_id`" + `.
+Otherwise, those attributes are null and the ` + "`accounts`" + ` and ` + "`workspaces`" + ` lists can be searched instead.
+<br>
+This data source is only available with Prefect Cloud.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), if the API key has access to a single account",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the account, if the API key has access to a single account",
+			},
+			"handle": schema.StringAttribute{
+				Computed:    true,
+				Description: "Handle of the account, if the API key has access to a single account",
+			},
+			"workspace_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), if the account has a single workspace that the API key has access to",
+			},
+			"workspace_handle": schema.StringAttribute{
+				Computed:    true,
+				Description: "Handle of the workspace, if the account has a single workspace that the API key has access to",
+			},
+			"accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Accounts that the API key has access to",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Account ID (UUID)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the account",
+						},
+						"handle": schema.StringAttribute{
+							Computed:    true,
+							Description: "Handle of the account",
+						},
+					},
+				},
+			},
+			"workspaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workspaces that the API key has access to, across all of its accounts",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Workspace ID (UUID)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workspace",
+						},
+						"handle": schema.StringAttribute{
+							Computed:    true,
+							Description: "Handle of the workspace",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the workspace",
+						},
+						"account_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Account ID (UUID) of the workspace",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *CurrentAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model CurrentAccountDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Me()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Me", err))
+
+		return
+	}
+
+	accounts, err := client.Accounts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing current account state",
+			fmt.Sprintf("Could not read the accounts of the API key, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	workspaces, err := client.Workspaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing current account state",
+			fmt.Sprintf("Could not read the workspaces of the API key, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDNull()
+	model.Name = types.StringNull()
+	model.Handle = types.StringNull()
+	model.WorkspaceID = customtypes.NewUUIDNull()
+	model.WorkspaceHandle = types.StringNull()
+
+	if len(accounts) == 1 {
+		account := accounts[0]
+		model.ID = customtypes.NewUUIDValue(account.AccountID)
+		model.Name = types.StringValue(account.AccountName)
+		model.Handle = types.StringValue(account.AccountHandle)
+
+		var accountWorkspaces []*api.WorkspaceSummary
+		for _, workspace := range workspaces {
+			if workspace.AccountID == account.AccountID {
+				accountWorkspaces = append(accountWorkspaces, workspace)
+			}
+		}
+
+		if len(accountWorkspaces) == 1 {
+			model.WorkspaceID = customtypes.NewUUIDValue(accountWorkspaces[0].WorkspaceID)
+			model.WorkspaceHandle = types.StringValue(accountWorkspaces[0].WorkspaceHandle)
+		}
+	}
+
+	var diags diag.Diagnostics
+	model.Accounts, diags = currentAccountAccountsList(accounts)
+	resp.Diagnostics.Append(diags...)
+
+	model.Workspaces, diags = currentAccountWorkspacesList(workspaces)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// currentAccountAccountsList converts account summaries to a list of objects.
+func currentAccountAccountsList(accounts []*api.AccountSummary) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accountObjects := make([]attr.Value, 0, len(accounts))
+	for _, account := range accounts {
+		accountObject, diag := types.ObjectValue(currentAccountAccountAttributeTypes, map[string]attr.Value{
+			"id":     customtypes.NewUUIDValue(account.AccountID),
+			"name":   types.StringValue(account.AccountName),
+			"handle": types.StringValue(account.AccountHandle),
+		})
+		diags.Append(diag...)
+
+		accountObjects = append(accountObjects, accountObject)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: currentAccountAccountAttributeTypes}), diags
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: currentAccountAccountAttributeTypes}, accountObjects)
+	diags.Append(diag...)
+
+	return list, diags
+}
+
+// currentAccountWorkspacesList converts workspace summaries to a list of objects.
+func currentAccountWorkspacesList(workspaces []*api.WorkspaceSummary) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	workspaceObjects := make([]attr.Value, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		workspaceObject, diag := types.ObjectValue(currentAccountWorkspaceAttributeTypes, map[string]attr.Value{
+			"id":          customtypes.NewUUIDValue(workspace.WorkspaceID),
+			"name":        types.StringValue(workspace.WorkspaceName),
+			"handle":      types.StringValue(workspace.WorkspaceHandle),
+			"description": types.StringValue(workspace.WorkspaceDescription),
+			"account_id":  customtypes.NewUUIDValue(workspace.AccountID),
+		})
+		diags.Append(diag...)
+
+		workspaceObjects = append(workspaceObjects, workspaceObject)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: currentAccountWorkspaceAttributeTypes}), diags
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: currentAccountWorkspaceAttributeTypes}, workspaceObjects)
+	diags.Append(diag...)
+
+	return list, diags
+}
@@ -0,0 +1,34 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccCurrentAccount() string {
+	return `
+data "prefect_current_account" "test" {}
+	`
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_current_account(t *testing.T) {
+	datasourceName := "data.prefect_current_account.test"
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccCurrentAccount(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "accounts.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "accounts.0.handle"),
+					resource.TestCheckResourceAttrSet(datasourceName, "workspaces.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "workspaces.0.account_id"),
+				),
+			},
+		},
+	})
+}
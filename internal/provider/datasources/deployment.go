@@ -0,0 +1,297 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&DeploymentDataSource{})
+
+// DeploymentDataSource contains state for the data source.
+type DeploymentDataSource struct {
+	client api.PrefectClient
+}
+
+// DeploymentDataSourceModel defines the Terraform data source model.
+type DeploymentDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name          types.String                `tfsdk:"name"`
+	FlowName      types.String                `tfsdk:"flow_name"`
+	FlowID        customtypes.UUIDValue       `tfsdk:"flow_id"`
+	WorkPoolName  types.String                `tfsdk:"work_pool_name"`
+	WorkQueueName types.String                `tfsdk:"work_queue_name"`
+	Parameters    customtypes.JSONStringValue `tfsdk:"parameters"`
+	Tags          types.Set                   `tfsdk:"tags"`
+	Paused        types.Bool                  `tfsdk:"paused"`
+}
+
+// NewDeploymentDataSource returns a new DeploymentDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *DeploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing Deployment by ID, or by the name of the Deployment and its Flow.
+<br>
+Use this data source to reference Deployments created outside of Terraform, such as with ` + "`prefect deploy`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Deployment ID (UUID)",
+				Optional:    true,
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Name of the deployment. Must be set together with `flow_name`.",
+			},
+			"flow_name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Name of the flow the deployment belongs to. Must be set together with `name`.",
+			},
+			"flow_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the flow the deployment belongs to",
+			},
+			"work_pool_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the work pool the deployment's flow runs are submitted to",
+			},
+			"work_queue_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the work queue the deployment's flow runs are submitted to",
+			},
+			"parameters": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.JSONStringType{},
+				Description: "Parameters passed to the flow runs of the deployment, as a JSON string. Use `jsondecode()` to read individual parameters.",
+			},
+			"tags": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags associated with the deployment",
+			},
+			"paused": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the deployment is paused",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model DeploymentDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	byName := !model.Name.IsNull() || !model.FlowName.IsNull()
+	if !model.ID.IsNull() && byName {
+		resp.Diagnostics.AddError(
+			"Conflicting deployment lookup keys",
+			"Deployments can be identified by their ID, or by their name and flow_name, but not both.",
+		)
+
+		return
+	}
+
+	if byName && (model.Name.IsNull() || model.FlowName.IsNull()) {
+		resp.Diagnostics.AddError(
+			"Incomplete deployment lookup keys",
+			"Deployment names are only unique within a flow, so name and flow_name must be set together.",
+		)
+
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Deployments(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	var deployment *api.Deployment
+
+	switch {
+	case !model.ID.IsNull():
+		deployment, err = client.Get(ctx, model.ID.ValueUUID())
+	case byName:
+		deployment, err = client.GetByName(ctx, model.FlowName.ValueString(), model.Name.ValueString())
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Name are unset",
+			"Either a Deployment ID, or a Deployment name and flow_name, are required to read a deployment.",
+		)
+
+		return
+	}
+
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Deployment", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing deployment state",
+			fmt.Sprintf("Could not read deployment, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// Deployments only reference their flow by ID,
+	// so the flow name is resolved when looking up by ID.
+	if model.FlowName.IsNull() {
+		flowsClient, err := d.client.Flows(accountID, model.WorkspaceID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow", err))
+
+			return
+		}
+
+		flow, err := flowsClient.Get(ctx, deployment.FlowID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error refreshing deployment state",
+				fmt.Sprintf("Could not read the flow of deployment %s, unexpected error: %s", deployment.ID, err.Error()),
+			)
+
+			return
+		}
+
+		model.FlowName = types.StringValue(flow.Name)
+	}
+
+	model.ID = customtypes.NewUUIDValue(deployment.ID)
+	model.Created = customtypes.NewTimestampPointerValue(deployment.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(deployment.Updated)
+
+	model.Name = types.StringValue(deployment.Name)
+	model.FlowID = customtypes.NewUUIDValue(deployment.FlowID)
+	model.WorkPoolName = types.StringPointerValue(deployment.WorkPoolName)
+	model.WorkQueueName = types.StringPointerValue(deployment.WorkQueueName)
+	model.Paused = types.BoolValue(deployment.Paused)
+
+	parameters := deployment.Parameters
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parameters"),
+			"Failed to serialize Deployment parameters",
+			fmt.Sprintf("Failed to serialize Deployment parameters as JSON string: %s", err),
+		)
+
+		return
+	}
+	model.Parameters = customtypes.NewJSONStringValue(string(parametersJSON))
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, deployment.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Tags = tags
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
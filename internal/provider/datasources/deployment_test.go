@@ -0,0 +1,84 @@
+package datasources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeployment(flowName string, flowID uuid.UUID, name string) string {
+	return fmt.Sprintf(`
+	data "prefect_workspace" "evergreen" {
+		handle = "github-ci-tests"
+	}
+	resource "prefect_deployment" "test" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		name = "%[3]s"
+		flow_id = "%[2]s"
+		parameters = jsonencode({ some = "value" })
+		tags = ["terraform"]
+	}
+	data "prefect_deployment" "by_id" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		id = prefect_deployment.test.id
+	}
+	data "prefect_deployment" "by_name" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		flow_name = "%[1]s"
+		name = prefect_deployment.test.name
+	}
+	`, flowName, flowID, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_deployment(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	flowName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	deploymentName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// Flows are registered by running them rather than through Terraform,
+	// so we register one directly with the API.
+	c, _ := testutils.NewTestClient()
+	workspacesClient, _ := c.Workspaces(uuid.Nil)
+	workspace, err := workspacesClient.GetByHandle(context.Background(), "github-ci-tests")
+	if err != nil {
+		t.Fatalf("Error fetching workspace: %s", err)
+	}
+
+	flowsClient, _ := c.Flows(uuid.Nil, workspace.ID)
+	flow, err := flowsClient.Create(context.Background(), api.FlowCreate{
+		Name: flowName,
+	})
+	if err != nil {
+		t.Fatalf("Error creating flow: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployment(flowName, flow.ID, deploymentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.prefect_deployment.by_id", "id", "prefect_deployment.test", "id"),
+					resource.TestCheckResourceAttr("data.prefect_deployment.by_id", "flow_name", flowName),
+					resource.TestCheckResourceAttr("data.prefect_deployment.by_id", "flow_id", flow.ID.String()),
+					resource.TestCheckResourceAttr("data.prefect_deployment.by_id", "paused", "false"),
+					resource.TestCheckResourceAttr("data.prefect_deployment.by_id", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.prefect_deployment.by_name", "id", "prefect_deployment.test", "id"),
+					resource.TestCheckResourceAttr("data.prefect_deployment.by_name", "parameters", `{"some":"value"}`),
+				),
+			},
+		},
+	})
+}
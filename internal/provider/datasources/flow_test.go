@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccFlowByName(name string) string {
+	return fmt.Sprintf(`
+	data "prefect_workspace" "evergreen" {
+		handle = "github-ci-tests"
+	}
+	data "prefect_flow" "test" {
+		workspace_id = data.prefect_workspace.evergreen.id
+		name = "%s"
+	}
+	`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_flow(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	datasourceName := "data.prefect_flow.test"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// Flows are registered by running them rather than through Terraform,
+	// so we register one directly with the API.
+	c, _ := testutils.NewTestClient()
+	workspacesClient, _ := c.Workspaces(uuid.Nil)
+	workspace, err := workspacesClient.GetByHandle(context.Background(), "github-ci-tests")
+	if err != nil {
+		t.Fatalf("Error fetching workspace: %s", err)
+	}
+
+	flowsClient, _ := c.Flows(uuid.Nil, workspace.ID)
+	flow, err := flowsClient.Create(context.Background(), api.FlowCreate{
+		Name: randomName,
+		Tags: []string{"terraform"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating flow: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccFlowByName(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", flow.ID.String()),
+					resource.TestCheckResourceAttr(datasourceName, "name", randomName),
+					resource.TestCheckResourceAttr(datasourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "tags.*", "terraform"),
+					resource.TestCheckResourceAttrSet(datasourceName, "created"),
+					resource.TestCheckResourceAttrSet(datasourceName, "updated"),
+				),
+			},
+		},
+	})
+}
@@ -2,14 +2,20 @@ package datasources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&ServiceAccountDataSource{})
@@ -26,7 +32,11 @@ type ServiceAccountDataSourceModel struct {
 	Updated customtypes.TimestampValue `tfsdk:"updated"`
 
 	Name            types.String          `tfsdk:"name"`
+	Description     types.String          `tfsdk:"description"`
+	ActorID         customtypes.UUIDValue `tfsdk:"actor_id"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle   types.String          `tfsdk:"account_handle"`
+	AccountRoleID   customtypes.UUIDValue `tfsdk:"account_role_id"`
 	AccountRoleName types.String          `tfsdk:"account_role_name"`
 
 	// SA fields
@@ -34,7 +44,6 @@ type ServiceAccountDataSourceModel struct {
 	APIKeyName    types.String               `tfsdk:"api_key_name"`
 	APIKeyCreated customtypes.TimestampValue `tfsdk:"api_key_created"`
 	APIKeyExpires customtypes.TimestampValue `tfsdk:"api_key_expiration"`
-	APIKey        types.String               `tfsdk:"api_key"`
 }
 
 // NewServiceAccountDataSource returns a new ServiceAccountDataSource.
@@ -73,6 +82,7 @@ var serviceAccountAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Optional:    true,
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Service Account ID (UUID)",
 	},
 	"created": schema.StringAttribute{
@@ -87,14 +97,34 @@ var serviceAccountAttributes = map[string]schema.Attribute{
 	},
 	"account_id": schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID), defaults to the account set in the provider",
 		Optional:    true,
 	},
+	"account_handle": schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	},
 	"name": schema.StringAttribute{
 		Computed:    true,
 		Optional:    true,
 		Description: "Name of the service account",
 	},
+	"description": schema.StringAttribute{
+		Computed:    true,
+		Description: "Description of the service account",
+	},
+	"actor_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Actor ID (UUID) of the service account, used by some access grants to refer to it",
+	},
+	"account_role_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Account Role ID (UUID) of the service account",
+	},
 	"account_role_name": schema.StringAttribute{
 		Computed:    true,
 		Description: "Account Role name of the service account",
@@ -117,10 +147,6 @@ var serviceAccountAttributes = map[string]schema.Attribute{
 		CustomType:  customtypes.TimestampType{},
 		Description: "Date and time that the API Key expires in RFC 3339 format",
 	},
-	"api_key": schema.StringAttribute{
-		Computed:    true,
-		Description: "API Key associated with the service account",
-	},
 }
 
 // Schema defines the schema for the data source.
@@ -130,6 +156,7 @@ func (d *ServiceAccountDataSource) Schema(_ context.Context, _ datasource.Schema
 Get information about an existing Service Account, by name or ID.
 <br>
 Use this data source to obtain service account-level attributes, such as ID.
+The API Key itself is never returned; it is only available from the ` + "`prefect_service_account`" + ` resource that created it.
 `,
 		Attributes: serviceAccountAttributes,
 	}
@@ -154,12 +181,15 @@ func (d *ServiceAccountDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	client, err := d.client.ServiceAccounts(model.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.ServiceAccounts(accountID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
 
 		return
 	}
@@ -169,34 +199,34 @@ func (d *ServiceAccountDataSource) Read(ctx context.Context, req datasource.Read
 	var serviceAccount *api.ServiceAccount
 	if !model.ID.IsNull() {
 		serviceAccount, err = client.Get(ctx, model.ID.ValueString())
-	} else if !model.Name.IsNull() {
+	} else {
 		var serviceAccounts []*api.ServiceAccount
 		serviceAccounts, err = client.List(ctx, []string{model.Name.ValueString()})
-
-		// The error from the API call should take precedence
-		// followed by this custom error if a specific service account is not returned
-		if err == nil && len(serviceAccounts) != 1 {
-			err = fmt.Errorf("a Service Account with the name=%s could not be found", model.Name.ValueString())
-		}
-
-		if len(serviceAccounts) == 1 {
+		if err == nil && len(serviceAccounts) == 1 {
 			serviceAccount = serviceAccounts[0]
 		}
 	}
 
-	if serviceAccount == nil {
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Service Account", err))
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing Service Account state",
-			fmt.Sprintf("Could not find Service Account with ID=%s and Name=%s", model.ID.ValueString(), model.Name.ValueString()),
+			fmt.Sprintf("Could not read Service Account, unexpected error: %s", err.Error()),
 		)
 
 		return
 	}
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error refreshing Service Account state",
-			fmt.Sprintf("Could not read Service Account, unexpected error: %s", err.Error()),
+	if serviceAccount == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Service Account not found",
+			fmt.Sprintf("Could not find a Service Account with the name=%s", model.Name.ValueString()),
 		)
 
 		return
@@ -207,14 +237,16 @@ func (d *ServiceAccountDataSource) Read(ctx context.Context, req datasource.Read
 	model.Updated = customtypes.NewTimestampPointerValue(serviceAccount.Updated)
 
 	model.Name = types.StringValue(serviceAccount.Name)
+	model.Description = types.StringPointerValue(serviceAccount.Description)
+	model.ActorID = customtypes.NewUUIDValue(serviceAccount.ActorID)
 	model.AccountID = customtypes.NewUUIDValue(serviceAccount.AccountID)
 
+	model.AccountRoleID = customtypes.NewUUIDValue(serviceAccount.AccountRoleID)
 	model.AccountRoleName = types.StringValue(serviceAccount.AccountRoleName)
 	model.APIKeyID = types.StringValue(serviceAccount.APIKey.ID)
 	model.APIKeyName = types.StringValue(serviceAccount.APIKey.Name)
 	model.APIKeyCreated = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Created)
 	model.APIKeyExpires = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Expiration)
-	model.APIKey = types.StringValue(serviceAccount.APIKey.Key)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -34,8 +34,15 @@ func TestAccDatasource_service_account(t *testing.T) {
 					resource.TestMatchResourceAttr(dataSourceNameByName, "api_key_name", regexp.MustCompile((fmt.Sprintf(`^%s`, randomName)))),
 					resource.TestCheckResourceAttrSet(dataSourceNameByName, "created"),
 					resource.TestCheckResourceAttrSet(dataSourceNameByName, "updated"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByName, "account_role_id", "prefect_service_account.bot", "account_role_id"),
+					resource.TestCheckNoResourceAttr(dataSourceNameByName, "api_key"),
 				),
 			},
+			{
+				// Check that an unknown name emits a not-found diagnostic
+				Config:      fixtureAccServiceAccountDataSourceNotFound(randomName),
+				ExpectError: regexp.MustCompile("Service Account not found"),
+			},
 		},
 	})
 }
@@ -53,3 +60,11 @@ data "prefect_service_account" "bot_by_name" {
 }
 	`, name)
 }
+
+func fixtureAccServiceAccountDataSourceNotFound(name string) string {
+	return fmt.Sprintf(`
+data "prefect_service_account" "missing" {
+	name = "%s-missing"
+}
+	`, name)
+}
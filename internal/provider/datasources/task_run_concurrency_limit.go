@@ -0,0 +1,192 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&TaskRunConcurrencyLimitDataSource{})
+
+// TaskRunConcurrencyLimitDataSource contains state for the data source.
+type TaskRunConcurrencyLimitDataSource struct {
+	client api.PrefectClient
+}
+
+// TaskRunConcurrencyLimitDataSourceModel defines the Terraform data source model.
+type TaskRunConcurrencyLimitDataSourceModel struct {
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Tag              types.String `tfsdk:"tag"`
+	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+	ActiveSlots      types.List   `tfsdk:"active_slots"`
+}
+
+// NewTaskRunConcurrencyLimitDataSource returns a new TaskRunConcurrencyLimitDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewTaskRunConcurrencyLimitDataSource() datasource.DataSource {
+	return &TaskRunConcurrencyLimitDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *TaskRunConcurrencyLimitDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_run_concurrency_limit"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *TaskRunConcurrencyLimitDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *TaskRunConcurrencyLimitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about an existing tag-based Task Run Concurrency Limit, by tag.
+<br>
+Use this data source to read limits that are not managed by Terraform, such as ones configured manually.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Concurrency limit ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Required:    true,
+				Description: "Tag of the task runs that the concurrency limit applies to",
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum number of concurrent task runs with the tag",
+			},
+			"active_slots": schema.ListAttribute{
+				Computed:    true,
+				ElementType: customtypes.UUIDType{},
+				Description: "IDs (UUID) of the task runs currently holding a slot of the concurrency limit",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *TaskRunConcurrencyLimitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model TaskRunConcurrencyLimitDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.ConcurrencyLimits(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	concurrencyLimit, err := client.GetByTag(ctx, model.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Concurrency Limit", err))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error refreshing concurrency limit state",
+			fmt.Sprintf("Could not read concurrency limit with tag=%s, unexpected error: %s", model.Tag.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(concurrencyLimit.ID)
+	model.Created = customtypes.NewTimestampPointerValue(concurrencyLimit.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(concurrencyLimit.Updated)
+
+	model.Tag = types.StringValue(concurrencyLimit.Tag)
+	model.ConcurrencyLimit = types.Int64Value(concurrencyLimit.ConcurrencyLimit)
+
+	activeSlots := make([]customtypes.UUIDValue, 0, len(concurrencyLimit.ActiveSlots))
+	for _, taskRunID := range concurrencyLimit.ActiveSlots {
+		activeSlots = append(activeSlots, customtypes.NewUUIDValue(taskRunID))
+	}
+
+	model.ActiveSlots, diags = types.ListValueFrom(ctx, customtypes.UUIDType{}, activeSlots)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
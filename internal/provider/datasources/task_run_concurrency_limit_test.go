@@ -0,0 +1,49 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccTaskRunConcurrencyLimit(tag string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_concurrency_limit" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	tag = "%s"
+	concurrency_limit = 3
+}
+data "prefect_task_run_concurrency_limit" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	tag = prefect_concurrency_limit.test.tag
+}
+	`, tag)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_task_run_concurrency_limit(t *testing.T) {
+	datasourceName := "data.prefect_task_run_concurrency_limit.test"
+	randomTag := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccTaskRunConcurrencyLimit(randomTag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "prefect_concurrency_limit.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "tag", randomTag),
+					resource.TestCheckResourceAttr(datasourceName, "concurrency_limit", "3"),
+					resource.TestCheckResourceAttr(datasourceName, "active_slots.#", "0"),
+				),
+			},
+		},
+	})
+}
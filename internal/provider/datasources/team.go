@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -26,8 +30,10 @@ type TeamDataSourceModel struct {
 	Updated     customtypes.TimestampValue `tfsdk:"updated"`
 	Name        types.String               `tfsdk:"name"`
 	Description types.String               `tfsdk:"description"`
+	MemberIDs   types.List                 `tfsdk:"member_ids"`
 
-	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
 }
 
 // NewTeamDataSource returns a new TeamDataSource.
@@ -75,7 +81,7 @@ var teamAttributesBase = map[string]schema.Attribute{
 // Schema defines the schema for the data source.
 func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	// Create a copy of the base attributes
-	// and add the account ID overrides here
+	// and add the account ID and member overrides here
 	// as they are not needed in the teams (plural) list
 	teamAttributes := make(map[string]schema.Attribute)
 	for k, v := range teamAttributesBase {
@@ -83,9 +89,20 @@ func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	}
 	teamAttributes["account_id"] = schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID), defaults to the account set in the provider",
 		Optional:    true,
 	}
+	teamAttributes["account_handle"] = schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	}
+	teamAttributes["member_ids"] = schema.ListAttribute{
+		Computed:    true,
+		ElementType: customtypes.UUIDType{},
+		Description: "IDs (UUID) of the users that are members of the team",
+	}
 
 	resp.Schema = schema.Schema{
 		Description: `
@@ -126,7 +143,13 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	client, err := d.client.Teams(config.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, config.AccountID, config.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Teams(accountID)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Teams", err))
 
@@ -136,16 +159,29 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Fetch an existing Team by name
 	// Here, we'd expect only 1 Team (or none) to be returned
 	// as we are querying a single Team name, not a list of names
-	// teams, err := client.List(ctx, []string{model.Name.ValueString()})
 	teams, err := client.List(ctx, []string{config.Name.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Team state",
 			fmt.Sprintf("Could not search for Team, unexpected error: %s", err.Error()),
 		)
+
+		return
+	}
+
+	// Team names are not guaranteed to be unique, so rather than
+	// silently picking one of several matches, we ask for a more specific lookup.
+	if len(teams) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Team name",
+			fmt.Sprintf("Found %d Teams with name %s; rename the Teams so that their names are unique", len(teams), config.Name.ValueString()),
+		)
+
+		return
 	}
 
-	if len(teams) != 1 {
+	if len(teams) == 0 {
 		resp.Diagnostics.AddError(
 			"Could not find Team",
 			fmt.Sprintf("Could not find Team with name %s", config.Name.ValueString()),
@@ -156,11 +192,33 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	fetchedTeam := teams[0]
 
+	members, err := client.ListMembers(ctx, fetchedTeam.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing Team state",
+			fmt.Sprintf("Could not read Team members, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	memberIDs := make([]customtypes.UUIDValue, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, customtypes.NewUUIDValue(member.MemberID))
+	}
+
+	list, diags := types.ListValueFrom(ctx, customtypes.UUIDType{}, memberIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	config.ID = customtypes.NewUUIDValue(fetchedTeam.ID)
 	config.Created = customtypes.NewTimestampPointerValue(fetchedTeam.Created)
 	config.Updated = customtypes.NewTimestampPointerValue(fetchedTeam.Updated)
 	config.Name = types.StringValue(fetchedTeam.Name)
 	config.Description = types.StringValue(fetchedTeam.Description)
+	config.MemberIDs = list
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
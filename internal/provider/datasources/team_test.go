@@ -30,6 +30,7 @@ func TestAccDatasource_team(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "name", "my-team"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member_ids.#"),
 				),
 			},
 		},
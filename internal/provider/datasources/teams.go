@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&TeamsDataSource{})
@@ -22,7 +27,8 @@ type TeamsDataSource struct {
 
 // TeamsDataSourceModel defines the Terraform data source model.
 type TeamsDataSourceModel struct {
-	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
 
 	Teams types.List `tfsdk:"teams"`
 }
@@ -69,9 +75,15 @@ Use this data source to search for multiple Teams. Defaults to fetching all Team
 		Attributes: map[string]schema.Attribute{
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
 			"teams": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "Teams returned by the server",
@@ -93,7 +105,13 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	client, err := d.client.Teams(model.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Teams(accountID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating variable client",
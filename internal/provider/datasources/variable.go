@@ -2,14 +2,20 @@ package datasources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&VariableDataSource{})
@@ -21,15 +27,16 @@ type VariableDataSource struct {
 
 // VariableDataSourceModel defines the Terraform data source model.
 type VariableDataSourceModel struct {
-	ID          customtypes.UUIDValue      `tfsdk:"id"`
-	Created     customtypes.TimestampValue `tfsdk:"created"`
-	Updated     customtypes.TimestampValue `tfsdk:"updated"`
-	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
-	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
-	Tags  types.List   `tfsdk:"tags"`
+	Tags  types.Set    `tfsdk:"tags"`
 }
 
 // NewVariableDataSource returns a new VariableDataSource.
@@ -67,6 +74,7 @@ var variableAttributes = map[string]schema.Attribute{
 	"id": schema.StringAttribute{
 		Computed:    true,
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Variable ID (UUID)",
 		Optional:    true,
 	},
@@ -82,12 +90,19 @@ var variableAttributes = map[string]schema.Attribute{
 	},
 	"account_id": schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID), defaults to the account set in the provider",
 		Optional:    true,
 	},
+	"account_handle": schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	},
 	"workspace_id": schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
-		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
+		Description: "Workspace ID (UUID) the variable belongs to, defaults to the workspace set in the provider. A workspace must be set either here or in the provider.",
 		Optional:    true,
 	},
 	"name": schema.StringAttribute{
@@ -99,7 +114,7 @@ var variableAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Description: "Value of the variable",
 	},
-	"tags": schema.ListAttribute{
+	"tags": schema.SetAttribute{
 		Computed:    true,
 		Description: "Tags associated with the variable",
 		ElementType: types.StringType,
@@ -137,12 +152,15 @@ func (d *VariableDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	client, err := d.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Variables(accountID, model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -157,16 +175,22 @@ func (d *VariableDataSource) Read(ctx context.Context, req datasource.ReadReques
 	default:
 		resp.Diagnostics.AddError(
 			"Both ID and Name are unset",
-			"This is a bug in the Terraform provider. Please report it to the maintainers.",
+			"Either a Variable ID or Name is required to read a variable.",
 		)
 
 		return
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Variable", err))
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing variable state",
-			fmt.Sprintf("Could not read variable, unexpected error: %s", err.Error()),
+			fmt.Sprintf("Could not read variable with ID=%s and name=%s, unexpected error: %s", model.ID.ValueString(), model.Name.ValueString(), err.Error()),
 		)
 
 		return
@@ -179,12 +203,12 @@ func (d *VariableDataSource) Read(ctx context.Context, req datasource.ReadReques
 	model.Name = types.StringValue(variable.Name)
 	model.Value = types.StringValue(variable.Value)
 
-	list, diags := types.ListValueFrom(ctx, types.StringType, variable.Tags)
+	tags, diags := types.SetValueFrom(ctx, types.StringType, variable.Tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	model.Tags = list
+	model.Tags = tags
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,233 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&VariablesDataSource{})
+
+// VariablesDataSource contains state for the data source.
+type VariablesDataSource struct {
+	client api.PrefectClient
+}
+
+// VariablesDataSourceModel defines the Terraform data source model.
+type VariablesDataSourceModel struct {
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Tags      types.Set  `tfsdk:"tags"`
+	Variables types.List `tfsdk:"variables"`
+}
+
+// NewVariablesDataSource returns a new VariablesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesDataSource() datasource.DataSource {
+	return &VariablesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *VariablesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *VariablesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *VariablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about multiple Variables.
+<br>
+Use this data source to enumerate the Variables of a Workspace, optionally narrowed down by tags. Defaults to fetching all Variables in the Workspace.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return variables that have all of these tags. This filter is applied by the server.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+				},
+			},
+			"variables": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Variables returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Variable ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the variable",
+						},
+						"value": schema.StringAttribute{
+							Computed:    true,
+							Description: "Value of the variable",
+						},
+						"tags": schema.SetAttribute{
+							Computed:    true,
+							Description: "Tags associated with the variable",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model VariablesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Variables(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	filter := api.VariableFilter{}
+	if !model.Tags.IsNull() {
+		filter.Tags = &api.VariableFilterTags{}
+		resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &filter.Tags.All, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	variables, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing variable state",
+			fmt.Sprintf("Could not read variables, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":      customtypes.UUIDType{},
+		"created": customtypes.TimestampType{},
+		"updated": customtypes.TimestampType{},
+		"name":    types.StringType,
+		"value":   types.StringType,
+		"tags":    types.SetType{ElemType: types.StringType},
+	}
+
+	variableObjects := make([]attr.Value, 0, len(variables))
+	for _, variable := range variables {
+		attributeValues := map[string]attr.Value{
+			"id":      customtypes.NewUUIDValue(variable.ID),
+			"created": customtypes.NewTimestampPointerValue(variable.Created),
+			"updated": customtypes.NewTimestampPointerValue(variable.Updated),
+			"name":    types.StringValue(variable.Name),
+			"value":   types.StringValue(variable.Value),
+		}
+
+		tags, diag := types.SetValueFrom(ctx, types.StringType, variable.Tags)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		attributeValues["tags"] = tags
+
+		variableObject, diag := types.ObjectValue(attributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variableObjects = append(variableObjects, variableObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, variableObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Variables = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,53 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccVariablesByTags(name string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_variable" "test" {
+	name = "%s"
+	value = "variable value goes here"
+	tags = ["%s"]
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+data "prefect_variables" "test" {
+	tags = ["%s"]
+	workspace_id = data.prefect_workspace.evergreen.id
+	depends_on = [prefect_variable.test]
+}
+`, name, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_variables(t *testing.T) {
+	datasourceName := "data.prefect_variables.test"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the tags filter only returns the tagged variable
+				Config: fixtureAccVariablesByTags(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "variables.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "variables.0.id", "prefect_variable.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "variables.0.name", randomName),
+					resource.TestCheckResourceAttr(datasourceName, "variables.0.value", "variable value goes here"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "variables.0.tags.*", randomName),
+				),
+			},
+		},
+	})
+}
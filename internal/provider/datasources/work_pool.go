@@ -3,16 +3,21 @@ package datasources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&WorkPoolDataSource{})
@@ -24,11 +29,12 @@ type WorkPoolDataSource struct {
 
 // WorkPoolDataSourceModel defines the Terraform data source model.
 type WorkPoolDataSourceModel struct {
-	ID          customtypes.UUIDValue      `tfsdk:"id"`
-	Created     customtypes.TimestampValue `tfsdk:"created"`
-	Updated     customtypes.TimestampValue `tfsdk:"updated"`
-	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
-	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
 	Name             types.String          `tfsdk:"name"`
 	Description      types.String          `tfsdk:"description"`
@@ -77,6 +83,7 @@ var workPoolAttributesBase = map[string]schema.Attribute{
 	"id": schema.StringAttribute{
 		Computed:    true,
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Work pool ID (UUID)",
 		Optional:    true,
 	},
@@ -116,6 +123,7 @@ var workPoolAttributesBase = map[string]schema.Attribute{
 	"default_queue_id": schema.StringAttribute{
 		Computed:    true,
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "The ID (UUID) of the default queue associated with this work pool",
 		Optional:    true,
 	},
@@ -136,11 +144,18 @@ func (d *WorkPoolDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 	workPoolAttributes["account_id"] = schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID), defaults to the account set in the provider",
 		Optional:    true,
 	}
+	workPoolAttributes["account_handle"] = schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	}
 	workPoolAttributes["workspace_id"] = schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 		Optional:    true,
 	}
@@ -165,21 +180,42 @@ func (d *WorkPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	client, err := d.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
+	// Work pools are identified by their name on the API side,
+	// so a name is required to look up a work pool.
+	if model.Name.IsNull() || model.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing Work Pool name",
+			"A Work Pool name is required to read a work pool.",
 		)
 
 		return
 	}
 
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkPools(accountID, model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return
+	}
+
 	pool, err := client.Get(ctx, model.Name.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Work Pool", err))
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing work pool state",
-			fmt.Sprintf("Could not read work pool, unexpected error: %s", err.Error()),
+			fmt.Sprintf("Could not read work pool with name=%s, unexpected error: %s", model.Name.ValueString(), err.Error()),
 		)
 
 		return
@@ -209,7 +245,7 @@ func (d *WorkPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 			return
 		}
 
-		model.BaseJobTemplate = types.StringValue(builder.String())
+		model.BaseJobTemplate = types.StringValue(strings.TrimSuffix(builder.String(), "\n"))
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
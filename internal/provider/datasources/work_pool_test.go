@@ -29,6 +29,22 @@ data "prefect_work_pools" "evergreen" {
 `
 }
 
+func fixtureAccWorkPoolsByType() string {
+	return `
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+data "prefect_work_pool" "evergreen" {
+	name = "evergreen-pool"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+data "prefect_work_pools" "evergreen" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	type = data.prefect_work_pool.evergreen.type
+}
+`
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_work_pool(t *testing.T) {
 	singleWorkPoolDatasourceName := "data.prefect_work_pool.evergreen"
@@ -66,6 +82,16 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					resource.TestCheckResourceAttrSet(multipleWorkPoolDatasourceName, "work_pools.0.base_job_template"),
 				),
 			},
+			{
+				// Check that we can query work pools by type
+				Config: fixtureAccWorkPoolsByType(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(multipleWorkPoolDatasourceName, "work_pools.*", map[string]string{
+						"name": "evergreen-pool",
+					}),
+					resource.TestCheckResourceAttrPair(multipleWorkPoolDatasourceName, "work_pools.0.type", singleWorkPoolDatasourceName, "type"),
+				),
+			},
 		},
 	})
 }
@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&WorkPoolsDataSource{})
@@ -25,11 +29,13 @@ type WorkPoolsDataSource struct {
 
 // WorkPoolsSourceModel defines the Terraform data source model.
 type WorkPoolsSourceModel struct {
-	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
-	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	FilterAny types.List `tfsdk:"filter_any"`
-	WorkPools types.List `tfsdk:"work_pools"`
+	FilterAny types.List   `tfsdk:"filter_any"`
+	Type      types.String `tfsdk:"type"`
+	WorkPools types.List   `tfsdk:"work_pools"`
 }
 
 // NewWorkPoolsDataSource returns a new WorkPoolsDataSource.
@@ -69,24 +75,36 @@ func (d *WorkPoolsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 		Description: `
 Get information about an multiple Work Pools.
 <br>
-Use this data source to search for multiple Work Pools. Defaults to fetching all Work Pools in the Workspace.
+Use this data source to search for multiple Work Pools. Defaults to fetching all Work Pools in the Workspace,
+optionally narrowed down to the Work Pools of a given type.
 `,
 		Attributes: map[string]schema.Attribute{
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
 			"workspace_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 				Optional:    true,
 			},
 			"filter_any": schema.ListAttribute{
-				ElementType: types.StringType,
+				ElementType: customtypes.UUIDType{},
 				Optional:    true,
 				Description: "Work pool IDs (UUID) to search for (work pools with any matching UUID are returned)",
 			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of the work pools to search for, eg. kubernetes, ecs, process, etc.",
+			},
 			"work_pools": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "Work pools returned by the server",
@@ -108,18 +126,37 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	client, err := d.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkPools(accountID, model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
 
 		return
 	}
 
 	filter := api.WorkPoolFilter{}
 
+	if !model.FilterAny.IsNull() {
+		var ids []customtypes.UUIDValue
+		resp.Diagnostics.Append(model.FilterAny.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, id := range ids {
+			filter.WorkPools.ID.Any = append(filter.WorkPools.ID.Any, id.ValueUUID())
+		}
+	}
+
+	if !model.Type.IsNull() {
+		filter.WorkPools.Type.Any = []string{model.Type.ValueString()}
+	}
+
 	pools, err := client.List(ctx, filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
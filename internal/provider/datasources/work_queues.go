@@ -0,0 +1,210 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkQueuesDataSource{})
+
+// WorkQueuesDataSource contains state for the data source.
+type WorkQueuesDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkQueuesDataSourceModel defines the Terraform data source model.
+type WorkQueuesDataSourceModel struct {
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	WorkPoolName types.String `tfsdk:"work_pool_name"`
+	WorkQueues   types.List   `tfsdk:"work_queues"`
+}
+
+// NewWorkQueuesDataSource returns a new WorkQueuesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkQueuesDataSource() datasource.DataSource {
+	return &WorkQueuesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkQueuesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_queues"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkQueuesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkQueuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about the Work Queues of a Work Pool.
+<br>
+Use this data source to enumerate every Work Queue in a Work Pool, for example with ` + "`for_each`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"work_pool_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the work pool to list the work queues of",
+			},
+			"work_queues": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Work queues returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Work queue ID (UUID)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the work queue",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the work queue",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The priority of this work queue within its work pool, where 1 is the highest priority",
+						},
+						"concurrency_limit": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The concurrency limit applied to this work queue",
+						},
+						"is_paused": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether this work queue is paused",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkQueuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkQueuesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkQueues(accountID, model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	queues, err := client.List(ctx, api.WorkQueueFilter{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing work queue state",
+			fmt.Sprintf("Could not read work queues, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":                customtypes.UUIDType{},
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"priority":          types.Int64Type,
+		"concurrency_limit": types.Int64Type,
+		"is_paused":         types.BoolType,
+	}
+
+	queueObjects := make([]attr.Value, 0, len(queues))
+	for _, queue := range queues {
+		attributeValues := map[string]attr.Value{
+			"id":                customtypes.NewUUIDValue(queue.ID),
+			"name":              types.StringValue(queue.Name),
+			"description":       types.StringPointerValue(queue.Description),
+			"priority":          types.Int64PointerValue(queue.Priority),
+			"concurrency_limit": types.Int64PointerValue(queue.ConcurrencyLimit),
+			"is_paused":         types.BoolValue(queue.IsPaused),
+		}
+
+		queueObject, diag := types.ObjectValue(attributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		queueObjects = append(queueObjects, queueObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, queueObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.WorkQueues = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
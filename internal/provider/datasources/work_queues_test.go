@@ -0,0 +1,45 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkQueues() string {
+	return `
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+data "prefect_work_queues" "evergreen" {
+	work_pool_name = "evergreen-pool"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+`
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_work_queues(t *testing.T) {
+	datasourceName := "data.prefect_work_queues.evergreen"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that we can list the work queues of a work pool,
+				// which always include the pool's default queue
+				Config: fixtureAccWorkQueues(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "work_queues.*", map[string]string{
+						"name": "default",
+					}),
+					resource.TestCheckResourceAttrSet(datasourceName, "work_queues.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "work_queues.0.priority"),
+					resource.TestCheckResourceAttrSet(datasourceName, "work_queues.0.is_paused"),
+				),
+			},
+		},
+	})
+}
@@ -5,12 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -69,57 +69,57 @@ Use this data source to get the default base job configurations for those common
 					"kubernetes": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Kubernetes workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"ecs": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for ECS workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"azure_container_instances": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Azure Container Instances workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"docker": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Docker workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"cloud_run": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Cloud Run workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"vertex_ai": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Vertex AI workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"prefect_agent": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Prefect Agent workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"process": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Process workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"azure_container_instances_push": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Azure Container Instances Push workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"cloud_run_push": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for Cloud Run Push workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 					"ecs_push": schema.StringAttribute{
 						Computed:    true,
 						Description: "Default base job configuration for ECS Push workers",
-						CustomType:  jsontypes.NormalizedType{},
+						CustomType:  customtypes.JSONStringType{},
 					},
 				},
 			},
@@ -165,30 +165,30 @@ func (d *WorkerMetadataDataSource) Read(ctx context.Context, req datasource.Read
 
 	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/types/object#setting-values
 	attributeTypes := map[string]attr.Type{
-		"kubernetes":                     jsontypes.NormalizedType{},
-		"ecs":                            jsontypes.NormalizedType{},
-		"azure_container_instances":      jsontypes.NormalizedType{},
-		"docker":                         jsontypes.NormalizedType{},
-		"cloud_run":                      jsontypes.NormalizedType{},
-		"vertex_ai":                      jsontypes.NormalizedType{},
-		"prefect_agent":                  jsontypes.NormalizedType{},
-		"process":                        jsontypes.NormalizedType{},
-		"azure_container_instances_push": jsontypes.NormalizedType{},
-		"cloud_run_push":                 jsontypes.NormalizedType{},
-		"ecs_push":                       jsontypes.NormalizedType{},
+		"kubernetes":                     customtypes.JSONStringType{},
+		"ecs":                            customtypes.JSONStringType{},
+		"azure_container_instances":      customtypes.JSONStringType{},
+		"docker":                         customtypes.JSONStringType{},
+		"cloud_run":                      customtypes.JSONStringType{},
+		"vertex_ai":                      customtypes.JSONStringType{},
+		"prefect_agent":                  customtypes.JSONStringType{},
+		"process":                        customtypes.JSONStringType{},
+		"azure_container_instances_push": customtypes.JSONStringType{},
+		"cloud_run_push":                 customtypes.JSONStringType{},
+		"ecs_push":                       customtypes.JSONStringType{},
 	}
 	attributeValues := map[string]attr.Value{
-		"kubernetes":                     jsontypes.NewNormalizedValue(string(remap["kubernetes"])),
-		"ecs":                            jsontypes.NewNormalizedValue(string(remap["ecs"])),
-		"azure_container_instances":      jsontypes.NewNormalizedValue(string(remap["azure-container-instance"])),
-		"docker":                         jsontypes.NewNormalizedValue(string(remap["docker"])),
-		"cloud_run":                      jsontypes.NewNormalizedValue(string(remap["cloud-run"])),
-		"vertex_ai":                      jsontypes.NewNormalizedValue(string(remap["vertex-ai"])),
-		"prefect_agent":                  jsontypes.NewNormalizedValue(string(remap["prefect-agent"])),
-		"process":                        jsontypes.NewNormalizedValue(string(remap["process"])),
-		"azure_container_instances_push": jsontypes.NewNormalizedValue(string(remap["azure-container-instance:push"])),
-		"cloud_run_push":                 jsontypes.NewNormalizedValue(string(remap["cloud-run:push"])),
-		"ecs_push":                       jsontypes.NewNormalizedValue(string(remap["ecs:push"])),
+		"kubernetes":                     customtypes.NewJSONStringValue(string(remap["kubernetes"])),
+		"ecs":                            customtypes.NewJSONStringValue(string(remap["ecs"])),
+		"azure_container_instances":      customtypes.NewJSONStringValue(string(remap["azure-container-instance"])),
+		"docker":                         customtypes.NewJSONStringValue(string(remap["docker"])),
+		"cloud_run":                      customtypes.NewJSONStringValue(string(remap["cloud-run"])),
+		"vertex_ai":                      customtypes.NewJSONStringValue(string(remap["vertex-ai"])),
+		"prefect_agent":                  customtypes.NewJSONStringValue(string(remap["prefect-agent"])),
+		"process":                        customtypes.NewJSONStringValue(string(remap["process"])),
+		"azure_container_instances_push": customtypes.NewJSONStringValue(string(remap["azure-container-instance:push"])),
+		"cloud_run_push":                 customtypes.NewJSONStringValue(string(remap["cloud-run:push"])),
+		"ecs_push":                       customtypes.NewJSONStringValue(string(remap["ecs:push"])),
 	}
 
 	obj, diag := types.ObjectValue(attributeTypes, attributeValues)
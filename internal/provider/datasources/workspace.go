@@ -2,16 +2,21 @@ package datasources
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
-	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var _ = datasource.DataSourceWithConfigure(&WorkspaceDataSource{})
@@ -23,14 +28,19 @@ type WorkspaceDataSource struct {
 
 // WorkspaceDataSourceModel defines the Terraform data source model.
 type WorkspaceDataSourceModel struct {
-	ID        customtypes.UUIDValue      `tfsdk:"id"`
-	Created   customtypes.TimestampValue `tfsdk:"created"`
-	Updated   customtypes.TimestampValue `tfsdk:"updated"`
-	AccountID customtypes.UUIDValue      `tfsdk:"account_id"`
+	ID            customtypes.UUIDValue      `tfsdk:"id"`
+	Created       customtypes.TimestampValue `tfsdk:"created"`
+	Updated       customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID     customtypes.UUIDValue      `tfsdk:"account_id"`
+	AccountHandle types.String               `tfsdk:"account_handle"`
 
 	Name        types.String `tfsdk:"name"`
 	Handle      types.String `tfsdk:"handle"`
 	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+
+	DefaultResultStorageBlockID customtypes.UUIDValue       `tfsdk:"default_result_storage_block_id"`
+	Settings                    customtypes.JSONStringValue `tfsdk:"settings"`
 }
 
 // NewWorkspaceDataSource returns a new WorkspaceDataSource.
@@ -64,9 +74,13 @@ func (d *WorkspaceDataSource) Configure(_ context.Context, req datasource.Config
 	d.client = client
 }
 
-var workspaceAttributes = map[string]schema.Attribute{
+// Shared set of schema attributes between workspace (singular)
+// and workspaces (plural) datasources. Any workspace (singular)
+// specific attributes will be added to a deep copy in the Schema method.
+var workspaceAttributesBase = map[string]schema.Attribute{
 	"id": schema.StringAttribute{
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Workspace ID (UUID)",
 		Computed:    true,
 		Optional:    true,
@@ -81,13 +95,9 @@ var workspaceAttributes = map[string]schema.Attribute{
 		CustomType:  customtypes.TimestampType{},
 		Description: "Timestamp of when the resource was updated (RFC3339)",
 	},
-	"account_id": schema.StringAttribute{
-		CustomType:  customtypes.UUIDType{},
-		Description: "Account ID (UUID), defaults to the account set in the provider",
-		Optional:    true,
-	},
 	"name": schema.StringAttribute{
 		Computed:    true,
+		Optional:    true,
 		Description: "Name of the workspace",
 	},
 	"handle": schema.StringAttribute{
@@ -99,13 +109,47 @@ var workspaceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Description: "Description for the workspace",
 	},
+	"tags": schema.SetAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Tags associated with the workspace",
+	},
 }
 
 // Schema defines the schema for the data source.
 func (d *WorkspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Create a copy of the base attributes
+	// and add the account ID, result storage, and settings overrides here
+	// as they are not needed in the workspaces (plural) list
+	workspaceAttributes := make(map[string]schema.Attribute)
+	for k, v := range workspaceAttributesBase {
+		workspaceAttributes[k] = v
+	}
+	workspaceAttributes["account_id"] = schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
+		Description: "Account ID (UUID), defaults to the account set in the provider",
+		Optional:    true,
+	}
+	workspaceAttributes["account_handle"] = schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	}
+	workspaceAttributes["default_result_storage_block_id"] = schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID (UUID) of the block used as the default result storage of the workspace; null when unset",
+	}
+	workspaceAttributes["settings"] = schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.JSONStringType{},
+		Description: "Settings of the workspace, such as `ai_log_summaries`, as a JSON object. Use `jsondecode()` to read individual settings; null when the server does not return settings",
+	}
+
 	resp.Schema = schema.Schema{
 		Description: `
-Get information about an existing Workspace by handle.
+Get information about an existing Workspace by ID, name, or handle.
 <br>
 Use this data source to obtain Workspace IDs
 `,
@@ -123,16 +167,30 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	if model.ID.IsNull() && model.Handle.IsNull() {
+	// Exactly one lookup key must be set to identify the workspace.
+	lookupKeys := 0
+	for _, isSet := range []bool{!model.ID.IsNull(), !model.Name.IsNull(), !model.Handle.IsNull()} {
+		if isSet {
+			lookupKeys++
+		}
+	}
+
+	if lookupKeys != 1 {
 		resp.Diagnostics.AddError(
-			"Both ID and Handle are unset",
-			"Either a Workspace ID or Handle are required to read a workspace.",
+			"Invalid workspace lookup",
+			"Exactly one of id, name, or handle must be set to read a workspace.",
 		)
 
 		return
 	}
 
-	client, err := d.client.Workspaces(model.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Workspaces(accountID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workspace client",
@@ -142,49 +200,33 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// A workspace can be read by either ID or Handle
-	// If both are set, we prefer the ID
-	// If neither are set, we will fail early.
 	var workspace *api.Workspace
-	if !model.ID.IsNull() {
-		var workspaceID uuid.UUID
-		workspaceID, err = uuid.Parse(model.ID.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("id"),
-				"Error parsing Workspace ID",
-				fmt.Sprintf("Could not parse workspace ID to UUID, unexpected error: %s", err.Error()),
-			)
+	switch {
+	case !model.ID.IsNull():
+		workspace, err = client.Get(ctx, model.ID.ValueUUID())
+	case !model.Handle.IsNull():
+		workspace, err = client.GetByHandle(ctx, model.Handle.ValueString())
+	case !model.Name.IsNull():
+		workspace, err = client.GetByName(ctx, model.Name.ValueString())
+	}
+
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Workspace", err))
 
 			return
 		}
 
-		workspace, err = client.Get(ctx, workspaceID)
-	} else if !model.Handle.IsNull() {
-		var workspaces []*api.Workspace
-		workspaces, err = client.List(ctx, []string{model.Handle.ValueString()})
-
-		// The error from the API call should take precedence
-		// followed by this custom error if a specific workspace is not returned
-		if err == nil && len(workspaces) != 1 {
-			err = fmt.Errorf("a workspace with the handle=%s could not be found", model.Handle.ValueString())
-		}
+		if errors.Is(err, api.ErrAmbiguous) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Ambiguous Workspace name",
+				fmt.Sprintf("More than one Workspace in the account is named %s. Look up the Workspace by id or handle instead.", model.Name.ValueString()),
+			)
 
-		if len(workspaces) == 1 {
-			workspace = workspaces[0]
+			return
 		}
-	}
-
-	if workspace == nil {
-		resp.Diagnostics.AddError(
-			"Error refreshing workspace state",
-			fmt.Sprintf("Could not find workspace with ID=%s and Handle=%s", model.ID.ValueString(), model.Handle.ValueString()),
-		)
-
-		return
-	}
 
-	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing workspace state",
 			fmt.Sprintf("Could not read workspace, unexpected error: %s", err.Error()),
@@ -201,6 +243,30 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	model.Handle = types.StringValue(workspace.Handle)
 	model.Description = types.StringPointerValue(workspace.Description)
 
+	tags, diags := types.SetValueFrom(ctx, types.StringType, workspace.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Tags = tags
+
+	model.DefaultResultStorageBlockID = customtypes.NewUUIDPointerValue(workspace.DefaultResultStorageBlockID)
+
+	model.Settings = customtypes.NewJSONStringNull()
+	if workspace.Settings != nil {
+		settings, err := json.Marshal(workspace.Settings)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("settings"),
+				"Failed to serialize Workspace settings",
+				fmt.Sprintf("Failed to serialize Workspace settings as JSON string: %s", err),
+			)
+
+			return
+		}
+		model.Settings = customtypes.NewJSONStringValue(string(settings))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
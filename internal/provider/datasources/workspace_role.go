@@ -3,12 +3,19 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -30,7 +37,9 @@ type WorkspaceRoleDataSourceModel struct {
 	Description     types.String          `tfsdk:"description"`
 	Scopes          types.List            `tfsdk:"scopes"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle   types.String          `tfsdk:"account_handle"`
 	InheritedRoleID customtypes.UUIDValue `tfsdk:"inherited_role_id"`
+	IsSystem        types.Bool            `tfsdk:"is_system"`
 }
 
 // NewWorkspaceRoleDataSource returns a new WorkspaceRoleDataSource.
@@ -63,7 +72,10 @@ var workspaceRoleAttributes = map[string]schema.Attribute{
 	},
 	"name": schema.StringAttribute{
 		Required:    true,
-		Description: "Name of the Workspace Role",
+		Description: "Name of the Workspace Role, e.g. `Developer` or `Runner`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
 	},
 	"description": schema.StringAttribute{
 		Computed:    true,
@@ -77,13 +89,23 @@ var workspaceRoleAttributes = map[string]schema.Attribute{
 	"account_id": schema.StringAttribute{
 		Optional:    true,
 		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
 		Description: "Account ID (UUID) where Workspace Role resides",
 	},
+	"account_handle": schema.StringAttribute{
+		Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+		Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+		Optional:    true,
+	},
 	"inherited_role_id": schema.StringAttribute{
 		Computed:    true,
 		CustomType:  customtypes.UUIDType{},
 		Description: "Workspace Role ID (UUID), whose permissions are inherited by this Workspace Role",
 	},
+	"is_system": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the Workspace Role is a pre-defined system role, rather than a custom role of the account",
+	},
 }
 
 // Schema defines the schema for the data source.
@@ -127,12 +149,15 @@ func (d *WorkspaceRoleDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	client, err := d.client.WorkspaceRoles(model.AccountID.ValueUUID())
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkspaceRoles(accountID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating the Workspace Roles client",
-			fmt.Sprintf("Could not create Workspace Roles client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Roles", err))
 
 		return
 	}
@@ -146,12 +171,46 @@ func (d *WorkspaceRoleDataSource) Read(ctx context.Context, req datasource.ReadR
 			"Error refreshing Workspace Role state",
 			fmt.Sprintf("Could not read Workspace Role, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
-	if len(workspaceRoles) != 1 {
-		resp.Diagnostics.AddError(
+	switch {
+	case len(workspaceRoles) == 0:
+		// To help practitioners fix their configuration, we'll list
+		// the names of the Workspace Roles that are available instead.
+		detail := fmt.Sprintf("Could not find Workspace Role with name %s.", model.Name.String())
+
+		allRoles, err := client.List(ctx, nil)
+		if err == nil && len(allRoles) > 0 {
+			names := make([]string, 0, len(allRoles))
+			for _, role := range allRoles {
+				names = append(names, fmt.Sprintf("%q", role.Name))
+			}
+
+			sort.Strings(names)
+			detail += fmt.Sprintf(" Available Workspace Roles are: %s.", strings.Join(names, ", "))
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
 			"Could not find Workspace Role",
-			fmt.Sprintf("Could not find Workspace Role with name %s", model.Name.String()),
+			detail,
+		)
+
+		return
+	case len(workspaceRoles) > 1:
+		ids := make([]string, 0, len(workspaceRoles))
+		for _, role := range workspaceRoles {
+			ids = append(ids, role.ID.String())
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Workspace Role name",
+			fmt.Sprintf("Found %d Workspace Roles with name %s (IDs: %s). "+
+				"Rename the custom Workspace Role so that its name is unique, or refer to it by ID instead.",
+				len(workspaceRoles), model.Name.String(), strings.Join(ids, ", ")),
 		)
 
 		return
@@ -167,6 +226,8 @@ func (d *WorkspaceRoleDataSource) Read(ctx context.Context, req datasource.ReadR
 	model.Description = types.StringPointerValue(fetchedRole.Description)
 	model.AccountID = customtypes.NewUUIDPointerValue(fetchedRole.AccountID)
 	model.InheritedRoleID = customtypes.NewUUIDPointerValue(fetchedRole.InheritedRoleID)
+	// System roles are shared by every account, so they aren't linked to one
+	model.IsSystem = types.BoolValue(fetchedRole.AccountID == nil)
 
 	list, diags := types.ListValueFrom(ctx, types.StringType, fetchedRole.Scopes)
 	resp.Diagnostics.Append(diags...)
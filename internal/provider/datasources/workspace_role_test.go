@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,6 +35,7 @@ func TestAccDatasource_workspace_role_defaults(t *testing.T) {
 				resource.TestCheckResourceAttrSet(dataSourceName, "updated"),
 				// Default roles should not be associated with an account
 				resource.TestCheckNoResourceAttr(dataSourceName, "account_id"),
+				resource.TestCheckResourceAttr(dataSourceName, "is_system", "true"),
 			),
 		})
 	}
@@ -44,3 +46,18 @@ func TestAccDatasource_workspace_role_defaults(t *testing.T) {
 		Steps:                    testSteps,
 	})
 }
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workspace_role_missing(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the available Workspace Roles are listed when the name is not found
+				Config:      fixtureAccWorkspaceRoleDataSource("missing-workspace-role"),
+				ExpectError: regexp.MustCompile(`Available Workspace Roles are: .*"Developer"`),
+			},
+		},
+	})
+}
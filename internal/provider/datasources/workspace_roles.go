@@ -0,0 +1,232 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkspaceRolesDataSource{})
+
+// WorkspaceRolesDataSource contains state for the data source.
+type WorkspaceRolesDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkspaceRolesDataSourceModel defines the Terraform data source model.
+type WorkspaceRolesDataSourceModel struct {
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+
+	Name           types.String `tfsdk:"name"`
+	WorkspaceRoles types.List   `tfsdk:"workspace_roles"`
+}
+
+// NewWorkspaceRolesDataSource returns a new WorkspaceRolesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkspaceRolesDataSource() datasource.DataSource {
+	return &WorkspaceRolesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkspaceRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_roles"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkspaceRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkspaceRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about multiple Workspace Roles.
+<br>
+Use this data source to enumerate the pre-defined and custom Workspace Roles of the Account, for example to reference a Role by name when granting workspace access. Defaults to fetching all Workspace Roles.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Workspace Role to filter by",
+			},
+			"workspace_roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workspace Roles returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Workspace Role ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Workspace Role",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the Workspace Role",
+						},
+						"scopes": schema.ListAttribute{
+							Computed:    true,
+							Description: "List of scopes linked to the Workspace Role",
+							ElementType: types.StringType,
+						},
+						"inherited_role_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Workspace Role ID (UUID), whose permissions are inherited by this Workspace Role",
+						},
+						"is_system": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Workspace Role is pre-defined by Prefect, rather than a custom role of the Account",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkspaceRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkspaceRolesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkspaceRoles(accountID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role", err))
+
+		return
+	}
+
+	// Fetch all existing workspace roles, unless filtering by name
+	var filter []string
+	if !model.Name.IsNull() {
+		filter = []string{model.Name.ValueString()}
+	}
+
+	roles, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing Workspace Role state",
+			fmt.Sprintf("Could not read Workspace Roles, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":                customtypes.UUIDType{},
+		"created":           customtypes.TimestampType{},
+		"updated":           customtypes.TimestampType{},
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"scopes":            types.ListType{ElemType: types.StringType},
+		"inherited_role_id": customtypes.UUIDType{},
+		"is_system":         types.BoolType,
+	}
+
+	roleObjects := make([]attr.Value, 0, len(roles))
+	for _, role := range roles {
+		scopes, diag := types.ListValueFrom(ctx, types.StringType, role.Scopes)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		attributeValues := map[string]attr.Value{
+			"id":                customtypes.NewUUIDValue(role.ID),
+			"created":           customtypes.NewTimestampPointerValue(role.Created),
+			"updated":           customtypes.NewTimestampPointerValue(role.Updated),
+			"name":              types.StringValue(role.Name),
+			"description":       types.StringPointerValue(role.Description),
+			"scopes":            scopes,
+			"inherited_role_id": customtypes.NewUUIDPointerValue(role.InheritedRoleID),
+			// The pre-defined roles are not associated with an account.
+			"is_system": types.BoolValue(role.AccountID == nil),
+		}
+
+		roleObject, diag := types.ObjectValue(attributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		roleObjects = append(roleObjects, roleObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, roleObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.WorkspaceRoles = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
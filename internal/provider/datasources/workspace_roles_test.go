@@ -0,0 +1,45 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkspaceRolesDataSource() string {
+	return `
+data "prefect_workspace_roles" "all" {}
+
+data "prefect_workspace_roles" "developer" {
+	name = "Developer"
+}
+`
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workspace_roles(t *testing.T) {
+	allDataSourceName := "data.prefect_workspace_roles.all"
+	developerDataSourceName := "data.prefect_workspace_roles.developer"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkspaceRolesDataSource(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(allDataSourceName, "workspace_roles.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(allDataSourceName, "workspace_roles.*", map[string]string{
+						"name":      "Owner",
+						"is_system": "true",
+					}),
+					resource.TestCheckResourceAttr(developerDataSourceName, "workspace_roles.#", "1"),
+					resource.TestCheckResourceAttr(developerDataSourceName, "workspace_roles.0.name", "Developer"),
+					resource.TestCheckResourceAttr(developerDataSourceName, "workspace_roles.0.is_system", "true"),
+					resource.TestCheckResourceAttrSet(developerDataSourceName, "workspace_roles.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package datasources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -22,6 +23,33 @@ data "prefect_workspace" "evergreen" {
 }
 `, id)
 }
+func fixtureAccWorkspaceByName(handle string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "%s"
+}
+data "prefect_workspace" "by_name" {
+	name = data.prefect_workspace.evergreen.name
+}
+`, handle)
+}
+func fixtureAccWorkspaceByAccountHandle(handle string, accountHandle string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "%s"
+	account_handle = "%s"
+}
+`, handle, accountHandle)
+}
+func fixtureAccWorkspaceConflictingAccount(handle string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "%s"
+	account_id = "00000000-0000-0000-0000-000000000000"
+	account_handle = "some-account"
+}
+`, handle)
+}
 
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_workspace(t *testing.T) {
@@ -41,6 +69,7 @@ func TestAccDatasource_workspace(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "created"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "updated"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tags.#"),
 				),
 			},
 			{
@@ -53,6 +82,24 @@ func TestAccDatasource_workspace(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
 				),
 			},
+			{
+				// Check the lookup by name, resolving the name from the handle
+				Config: fixtureAccWorkspaceByName(workspaceHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prefect_workspace.by_name", "id", workspaceID),
+					resource.TestCheckResourceAttr("data.prefect_workspace.by_name", "handle", workspaceHandle),
+				),
+			},
+			{
+				// Check that account_id and account_handle cannot both be set
+				Config:      fixtureAccWorkspaceConflictingAccount(workspaceHandle),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				// Check that an account handle the API key cannot access is rejected
+				Config:      fixtureAccWorkspaceByAccountHandle(workspaceHandle, "not-a-real-account-handle"),
+				ExpectError: regexp.MustCompile("Unable to resolve Prefect Account Handle"),
+			},
 		},
 	})
 }
@@ -0,0 +1,249 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkspacesDataSource{})
+
+// WorkspacesDataSource contains state for the data source.
+type WorkspacesDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkspacesDataSourceModel defines the Terraform data source model.
+type WorkspacesDataSourceModel struct {
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+
+	Filter     *WorkspacesFilterModel `tfsdk:"filter"`
+	Workspaces types.List             `tfsdk:"workspaces"`
+}
+
+// WorkspacesFilterModel defines the optional filter block
+// used to narrow down the returned workspaces.
+type WorkspacesFilterModel struct {
+	Handle types.String `tfsdk:"handle"`
+	Name   types.String `tfsdk:"name"`
+	Tags   types.Set    `tfsdk:"tags"`
+}
+
+// NewWorkspacesDataSource returns a new WorkspacesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &WorkspacesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkspacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkspacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Get information about multiple Workspaces.
+<br>
+Use this data source to search for multiple Workspaces. Defaults to fetching all Workspaces in the Account.
+`,
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"account_handle": schema.StringAttribute{
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("account_id"))},
+				Description: "Handle of the account, as an alternative to account_id. Only data sources accept an account handle, resources accept account_id only",
+				Optional:    true,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workspaces returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workspaceAttributesBase,
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description: "Optional filter to narrow down the returned Workspaces",
+				Attributes: map[string]schema.Attribute{
+					"handle": schema.StringAttribute{
+						Optional:    true,
+						Description: "Only return workspaces whose handle starts with this prefix",
+					},
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Only return workspaces whose name contains this substring",
+					},
+					"tags": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return workspaces that have all of these tags. This filter is applied by the server.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkspacesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID, diags := helpers.ResolveAccountID(ctx, d.client, model.AccountID, model.AccountHandle)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Workspaces(accountID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workspace client",
+			fmt.Sprintf("Could not create workspace client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
+		)
+
+		return
+	}
+
+	// Tags are filtered server-side to avoid fetching every workspace,
+	// while the handle prefix and name substring are matched below.
+	filter := api.WorkspaceFilter{}
+	if model.Filter != nil && !model.Filter.Tags.IsNull() {
+		resp.Diagnostics.Append(model.Filter.Tags.ElementsAs(ctx, &filter.Workspaces.Tags.All, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	workspaces, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing workspace state",
+			fmt.Sprintf("Could not read workspaces, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"id":          customtypes.UUIDType{},
+		"created":     customtypes.TimestampType{},
+		"updated":     customtypes.TimestampType{},
+		"name":        types.StringType,
+		"handle":      types.StringType,
+		"description": types.StringType,
+		"tags":        types.SetType{ElemType: types.StringType},
+	}
+
+	workspaceObjects := make([]attr.Value, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		if !model.Filter.matches(workspace) {
+			continue
+		}
+
+		attributeValues := map[string]attr.Value{
+			"id":          customtypes.NewUUIDValue(workspace.ID),
+			"created":     customtypes.NewTimestampPointerValue(workspace.Created),
+			"updated":     customtypes.NewTimestampPointerValue(workspace.Updated),
+			"name":        types.StringValue(workspace.Name),
+			"handle":      types.StringValue(workspace.Handle),
+			"description": types.StringPointerValue(workspace.Description),
+		}
+
+		tags, diag := types.SetValueFrom(ctx, types.StringType, workspace.Tags)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		attributeValues["tags"] = tags
+
+		workspaceObject, diag := types.ObjectValue(attributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		workspaceObjects = append(workspaceObjects, workspaceObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, workspaceObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Workspaces = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// matches reports whether a workspace satisfies the configured filter.
+// A nil filter, or a filter with no attributes set, matches every workspace.
+func (f *WorkspacesFilterModel) matches(workspace *api.Workspace) bool {
+	if f == nil {
+		return true
+	}
+
+	if !f.Handle.IsNull() && !strings.HasPrefix(workspace.Handle, f.Handle.ValueString()) {
+		return false
+	}
+
+	if !f.Name.IsNull() && !strings.Contains(workspace.Name, f.Name.ValueString()) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkspacesByHandlePrefix(handle string) string {
+	return fmt.Sprintf(`
+data "prefect_workspaces" "evergreen" {
+	filter {
+		handle = "%s"
+	}
+}
+`, handle)
+}
+
+func fixtureAccWorkspacesByTags(handle string) string {
+	return fmt.Sprintf(`
+data "prefect_workspaces" "evergreen" {
+	filter {
+		handle = "%s"
+		tags   = ["tf-acceptance-tests-no-such-tag"]
+	}
+}
+`, handle)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workspaces(t *testing.T) {
+	dataSourceName := "data.prefect_workspaces.evergreen"
+	workspaceHandle := "github-ci-tests"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkspacesByHandlePrefix(workspaceHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "workspaces.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "workspaces.0.handle", workspaceHandle),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workspaces.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workspaces.0.name"),
+				),
+			},
+			{
+				// Check that the tags filter excludes workspaces without the tags
+				Config: fixtureAccWorkspacesByTags(workspaceHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "workspaces.#", "0"),
+				),
+			},
+		},
+	})
+}
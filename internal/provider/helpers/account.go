@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+// ResolveAccountID returns the account ID that a data source is scoped to.
+// An account_handle is resolved against the accounts the API key has access to,
+// the same way as the provider's account_handle. When neither attribute is set,
+// uuid.Nil is returned so that the provider's default account is used.
+//
+// Resources accept account_id only, so that the account of an object in
+// their state does not depend on a handle that may be reassigned.
+func ResolveAccountID(ctx context.Context, client api.PrefectClient, accountID customtypes.UUIDValue, accountHandle types.String) (uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if accountHandle.IsNull() || accountHandle.IsUnknown() {
+		return accountID.ValueUUID(), diags
+	}
+
+	resolvedID, err := client.AccountIDByHandle(ctx, accountHandle.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("account_handle"),
+			"Unable to resolve Prefect Account Handle",
+			fmt.Sprintf("The Prefect Account Handle %q could not be resolved to an account that the Prefect API Key has access to: %s", accountHandle.ValueString(), err),
+		)
+
+		return uuid.Nil, diags
+	}
+
+	return resolvedID, diags
+}
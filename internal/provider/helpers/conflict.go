@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+)
+
+const (
+	// conflictGetAttempts is the number of times the object that caused
+	// a create conflict is fetched before giving up on adopting it.
+	conflictGetAttempts = 3
+
+	// conflictGetBaseDelay is the delay before fetching that object again,
+	// doubled on every subsequent attempt.
+	conflictGetBaseDelay = 250 * time.Millisecond
+)
+
+// AdoptExistingAttribute returns the schema of the `adopt_existing`
+// attribute, which opts a resource into adopting an existing object
+// when its creation fails with a conflict.
+func AdoptExistingAttribute(objectName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether to adopt an existing %s of the same name when creating it fails because it already exists, such as when several pipelines create it concurrently. ", objectName) +
+			fmt.Sprintf("The existing %s is only adopted if its configuration matches this resource. Defaults to `false`.", objectName),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// GetAfterConflict fetches the object that caused a create to fail with
+// api.ErrAlreadyExists. The concurrent create may not be visible yet, so
+// get is retried with exponential backoff while it returns api.ErrNotFound.
+func GetAfterConflict[T any](ctx context.Context, get func(ctx context.Context) (T, error)) (T, error) {
+	return pollWhileNotFound(ctx, pollOptions{
+		baseDelay:   conflictGetBaseDelay,
+		maxAttempts: conflictGetAttempts,
+		waitingFor:  "conflicting object",
+	}, get)
+}
+
+// AlreadyExistsDiagnostic returns an error diagnostic for when an object
+// could not be created because another one of the same name exists, along
+// with the attributes that prevented it from being adopted, if any.
+//
+//nolint:ireturn // required by Terraform API
+func AlreadyExistsDiagnostic(objectName string, name string, mismatches []string) diag.Diagnostic {
+	return AlreadyExistsAttributeDiagnostic(path.Root("name"), objectName, name, mismatches)
+}
+
+// AlreadyExistsAttributeDiagnostic is like AlreadyExistsDiagnostic, for
+// objects whose name is set by another attribute than `name`.
+//
+//nolint:ireturn // required by Terraform API
+func AlreadyExistsAttributeDiagnostic(attributePath path.Path, objectName string, name string, mismatches []string) diag.Diagnostic {
+	detail := fmt.Sprintf("A %s named %q already exists. Import it with `terraform import`, or set `adopt_existing = true` to adopt it on create.", objectName, name)
+	if len(mismatches) > 0 {
+		detail = fmt.Sprintf("A %s named %q already exists, but could not be adopted because these attributes differ from the configuration: %s. ", objectName, name, strings.Join(mismatches, ", ")) +
+			"Update the configuration to match the existing object, or import it with `terraform import`."
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		fmt.Sprintf("%s already exists", objectName),
+		detail,
+	)
+}
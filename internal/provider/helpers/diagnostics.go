@@ -1,9 +1,13 @@
 package helpers
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
 // https://developer.hashicorp.com/terraform/plugin/framework/diagnostics#custom-diagnostics-types
@@ -13,6 +17,17 @@ import (
 //
 //nolint:ireturn // required by Terraform API
 func CreateClientErrorDiagnostic(clientName string, err error) diag.Diagnostic {
+	// A missing workspace is a configuration issue rather than a bug,
+	// so we'll explain how to provide one instead.
+	if errors.Is(err, api.ErrWorkspaceScopeRequired) {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("workspace_id"),
+			"Workspace could not be resolved",
+			fmt.Sprintf("The %s is scoped to a Workspace, but no workspace_id was set on it and no default workspace_id (and account_id) is configured on the provider. ", clientName)+
+				"Set the workspace_id attribute, or configure the provider's workspace_id and account_id attributes.",
+		)
+	}
+
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("Error creating %s client", clientName),
 		fmt.Sprintf("Could not create %s client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", clientName, err.Error()),
@@ -24,8 +39,40 @@ func CreateClientErrorDiagnostic(clientName string, err error) diag.Diagnostic {
 //
 //nolint:ireturn // required by Terraform API
 func ResourceClientErrorDiagnostic(resourceName string, operation string, err error) diag.Diagnostic {
+	// A timed out request usually means the Prefect API is degraded,
+	// so we'll point to the provider setting rather than report a bug.
+	if errors.Is(err, api.ErrRequestTimeout) {
+		return diag.NewErrorDiagnostic(
+			fmt.Sprintf("Timeout during %s %s", operation, resourceName),
+			fmt.Sprintf("Could not %s %s, as a request to the Prefect API timed out: %s. ", operation, resourceName, err)+
+				"Retry the operation, or increase the provider's request_timeout attribute if the Prefect API is slow to respond.",
+		)
+	}
+
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("Error during %s %s", operation, resourceName),
-		fmt.Sprintf("Could not %s %s, unexpected error: %s", operation, resourceName, err),
+		fmt.Sprintf("Could not %s %s, unexpected error: %s", operation, resourceName, err)+requestIDDetail(err),
+	)
+}
+
+// requestIDDetail returns a sentence pointing to the ID of the failed
+// request, if the server returned one, so it can be quoted to Prefect support.
+func requestIDDetail(err error) string {
+	var responseErr *api.ResponseError
+	if errors.As(err, &responseErr) && responseErr.RequestID != "" {
+		return fmt.Sprintf("\n\nIf the problem persists, contact Prefect support and include the request ID %s.", responseErr.RequestID)
+	}
+
+	return ""
+}
+
+// NotFoundDiagnostic returns an error diagnostic for when a lookup
+// finds no such object, as reported by api.ErrNotFound.
+//
+//nolint:ireturn // required by Terraform API
+func NotFoundDiagnostic(objectName string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("%s not found", objectName),
+		fmt.Sprintf("Could not find %s (%s). Check that it exists and that the provider's credentials can access it.", objectName, err),
 	)
 }
@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// ImportWorkspaceScopedState imports a workspace-scoped resource from a
+// composite import ID in the form of `account_id/workspace_id/<attributes...>`,
+// such as `account_id/workspace_id/work_pool_name/name` for a work queue.
+// Each part is set in the state to its respective attribute, and parts of
+// ID attributes (`id`, or suffixed with `_id`) must be UUIDs. The last part
+// may contain slashes, such as a tag.
+//
+// The account ID is left unset when it is the default account of the
+// client, so that configurations relying on the account set in the
+// provider do not plan to remove it after the import.
+//
+// It reports whether the ID is in the composite form, which is recognized
+// by a leading account UUID followed by a slash, so that resources can
+// fall back to parsing their other import ID formats when it is not.
+func ImportWorkspaceScopedState(ctx context.Context, client api.PrefectClient, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) bool {
+	accountID, _, found := strings.Cut(req.ID, "/")
+	if !found {
+		return false
+	}
+
+	parsedAccountID, err := uuid.Parse(accountID)
+	if err != nil {
+		return false
+	}
+
+	names := append([]string{"account_id", "workspace_id"}, attributes...)
+	format := "`" + strings.Join(names, "/") + "`"
+
+	parts := strings.SplitN(req.ID, "/", len(names))
+	if len(parts) != len(names) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected %d import identifiers, in the form of %s. Got %q", len(names), format, req.ID),
+		)
+
+		return true
+	}
+
+	for i, name := range names {
+		if parts[i] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of %s, but %s is empty. Got %q", format, name, req.ID),
+			)
+
+			return true
+		}
+
+		if name == "id" || strings.HasSuffix(name, "_id") {
+			if _, err := uuid.Parse(parts[i]); err != nil {
+				resp.Diagnostics.AddError(
+					"Unexpected Import Identifier",
+					fmt.Sprintf("Expected %s to be a UUID, in the form of %s. Got %q", name, format, parts[i]),
+				)
+
+				return true
+			}
+		}
+	}
+
+	for i, name := range names {
+		if name == "account_id" && client != nil && parsedAccountID == client.DefaultAccountID() {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
+
+	return true
+}
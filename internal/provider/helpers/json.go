@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONSemanticallyEqual reports whether two strings are both valid JSON
+// documents that decode to the same value, ignoring differences in
+// whitespace and object key ordering.
+func JSONSemanticallyEqual(a string, b string) bool {
+	var aValue, bValue interface{}
+
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// JSONSubset reports whether two strings are both valid JSON documents,
+// where every value in subset is also present in superset. Objects in
+// superset may hold additional keys, such as defaults filled in by the
+// server, while arrays must be of equal length.
+func JSONSubset(subset string, superset string) bool {
+	var subsetValue, supersetValue interface{}
+
+	if err := json.Unmarshal([]byte(subset), &subsetValue); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(superset), &supersetValue); err != nil {
+		return false
+	}
+
+	return isJSONSubset(subsetValue, supersetValue)
+}
+
+func isJSONSubset(subset interface{}, superset interface{}) bool {
+	switch subsetValue := subset.(type) {
+	case map[string]interface{}:
+		supersetValue, ok := superset.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for key, value := range subsetValue {
+			if !isJSONSubset(value, supersetValue[key]) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		supersetValue, ok := superset.([]interface{})
+		if !ok || len(subsetValue) != len(supersetValue) {
+			return false
+		}
+
+		for i := range subsetValue {
+			if !isJSONSubset(subsetValue[i], supersetValue[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(subset, superset)
+	}
+}
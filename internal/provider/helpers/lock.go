@@ -0,0 +1,52 @@
+package helpers
+
+import "sync"
+
+// KeyedMutex serializes the operations sharing a key, such as the
+// read-modify-write cycles of several resources replacing the access
+// control of the same object, which Terraform otherwise applies in parallel.
+// Its zero value is ready to use, and it must not be copied after first use.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is the lock of a single key, along with the
+// number of operations holding or waiting for it.
+type keyedLock struct {
+	mu    sync.Mutex
+	users int
+}
+
+// Lock blocks until the lock of key is acquired, and returns
+// the function releasing it.
+func (m *KeyedMutex) Lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*keyedLock{}
+	}
+
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyedLock{}
+		m.locks[key] = lock
+	}
+	lock.users++
+	m.mu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		// Forget the lock once nothing uses it, so that
+		// the map does not grow with every key ever locked.
+		lock.users--
+		if lock.users == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
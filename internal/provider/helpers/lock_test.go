@@ -0,0 +1,60 @@
+package helpers_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestKeyedMutex_serializesSameKey(t *testing.T) {
+	t.Parallel()
+
+	var locks helpers.KeyedMutex
+
+	// Simulate concurrent read-modify-write cycles on a shared list,
+	// which lose updates unless they are serialized.
+	var grants []int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := locks.Lock("pool")
+			defer unlock()
+
+			read := append([]int{}, grants...)
+			time.Sleep(time.Millisecond)
+			grants = append(read, i)
+		}()
+	}
+	wg.Wait()
+
+	if len(grants) != 10 {
+		t.Errorf("expected 10 grants, got %v", grants)
+	}
+}
+
+func TestKeyedMutex_distinctKeysDoNotBlock(t *testing.T) {
+	t.Parallel()
+
+	var locks helpers.KeyedMutex
+
+	unlock := locks.Lock("pool-a")
+	defer unlock()
+
+	done := make(chan struct{})
+	go func() {
+		locks.Lock("pool-b")()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("expected the lock of another key to be acquired")
+	}
+}
@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// pollOptions configures pollWhileNotFound.
+type pollOptions struct {
+	// baseDelay is the delay before the second attempt,
+	// doubled on every subsequent attempt.
+	baseDelay time.Duration
+
+	// maxDelay caps the delay between two attempts, if set.
+	maxDelay time.Duration
+
+	// maxAttempts is the number of attempts before giving up, if set.
+	// Otherwise, attempts continue until the context is done.
+	maxAttempts int
+
+	// waitingFor describes the object waited for in the error
+	// returned when the context is done.
+	waitingFor string
+}
+
+// pollWhileNotFound calls get with exponential backoff while it returns
+// api.ErrNotFound, returning the first other result, the last result once
+// the attempts are exhausted, or an error once ctx is done.
+func pollWhileNotFound[T any](ctx context.Context, opts pollOptions, get func(ctx context.Context) (T, error)) (T, error) {
+	delay := opts.baseDelay
+
+	for attempt := 1; ; attempt++ {
+		object, err := get(ctx)
+		if !errors.Is(err, api.ErrNotFound) || attempt == opts.maxAttempts {
+			return object, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return object, fmt.Errorf("waiting for %s: %w", opts.waitingFor, ctx.Err())
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if opts.maxDelay > 0 && delay > opts.maxDelay {
+			delay = opts.maxDelay
+		}
+	}
+}
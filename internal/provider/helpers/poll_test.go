@@ -0,0 +1,74 @@
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	object, err := helpers.WaitForReady(context.Background(), func(_ context.Context) (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "", api.ErrNotFound
+		}
+
+		return "my-pool", nil
+	})
+	if err != nil {
+		t.Fatalf("expected the object to be returned, got: %s", err)
+	}
+
+	if object != "my-pool" || attempts != 3 {
+		t.Errorf("expected my-pool after 3 attempts, got %q after %d", object, attempts)
+	}
+}
+
+func TestWaitForReady_boundedByContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := helpers.WaitForReady(ctx, func(_ context.Context) (string, error) {
+		return "", api.ErrNotFound
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait to stop at the deadline, got: %v", err)
+	}
+}
+
+func TestWaitForReady_otherErrorsAreNotRetried(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	_, err := helpers.WaitForReady(context.Background(), func(_ context.Context) (string, error) {
+		attempts++
+
+		return "", errors.New("status code 500")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestGetAfterConflict_boundedByAttempts(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	_, err := helpers.GetAfterConflict(context.Background(), func(_ context.Context) (string, error) {
+		attempts++
+
+		return "", api.ErrNotFound
+	})
+	if !errors.Is(err, api.ErrNotFound) || attempts != 3 {
+		t.Errorf("expected a not found error after 3 attempts, got %d attempts and error %v", attempts, err)
+	}
+}
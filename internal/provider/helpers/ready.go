@@ -0,0 +1,27 @@
+package helpers
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// readyGetBaseDelay is the delay before fetching a created object
+	// again, doubled on every subsequent attempt.
+	readyGetBaseDelay = 250 * time.Millisecond
+
+	// readyGetMaxDelay caps the delay between two fetches.
+	readyGetMaxDelay = 5 * time.Second
+)
+
+// WaitForReady fetches a created object until it is returned, as it may not
+// be queryable right after its creation. get is retried with exponential
+// backoff while it returns api.ErrNotFound, until ctx is done, so the wait
+// is bounded by the deadline of the operation.
+func WaitForReady[T any](ctx context.Context, get func(ctx context.Context) (T, error)) (T, error) {
+	return pollWhileNotFound(ctx, pollOptions{
+		baseDelay:  readyGetBaseDelay,
+		maxDelay:   readyGetMaxDelay,
+		waitingFor: "created object",
+	}, get)
+}
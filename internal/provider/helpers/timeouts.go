@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+)
+
+// DefaultTimeout is the deadline applied to a resource operation
+// when no timeout is configured for it.
+const DefaultTimeout = 5 * time.Minute
+
+// TimeoutsModel maps the `timeouts` block of a resource to a Go type.
+// A nil *TimeoutsModel represents an unset block.
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// TimeoutsBlock returns the schema of the `timeouts` block,
+// which configures a deadline for each resource operation.
+func TimeoutsBlock() schema.Block {
+	attribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: fmt.Sprintf("Deadline for the %s operation, expressed as a duration string (e.g. `30s`, `10m`). Defaults to `%s`.", operation, DefaultTimeout),
+			Optional:    true,
+			Validators:  []validator.String{customvalidators.DurationValidator{}},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Deadlines applied to each resource operation. An operation that exceeds its deadline fails.",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("create"),
+			"read":   attribute("read"),
+			"update": attribute("update"),
+			"delete": attribute("delete"),
+		},
+	}
+}
+
+// timeout returns the configured deadline for an operation,
+// falling back to DefaultTimeout.
+func (t *TimeoutsModel) timeout(operation string) time.Duration {
+	if t == nil {
+		return DefaultTimeout
+	}
+
+	var value types.String
+	switch operation {
+	case "create":
+		value = t.Create
+	case "read":
+		value = t.Read
+	case "update":
+		value = t.Update
+	case "delete":
+		value = t.Delete
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		return DefaultTimeout
+	}
+
+	// The value is validated at plan time, so parsing does not fail here.
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration <= 0 {
+		return DefaultTimeout
+	}
+
+	return duration
+}
+
+// WithOperationTimeout derives a context bounded by the deadline configured
+// for the resource operation. The returned function must be deferred:
+// it releases the context and, if the deadline was exceeded, appends
+// a diagnostic attributing the timeout to the operation.
+func WithOperationTimeout(ctx context.Context, timeouts *TimeoutsModel, resourceName string, operation string, diags *diag.Diagnostics) (context.Context, func()) {
+	timeout := timeouts.timeout(operation)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, func() {
+		defer cancel()
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			diags.AddError(
+				fmt.Sprintf("Timeout during %s %s", operation, resourceName),
+				fmt.Sprintf("The %s operation on the %s did not complete within %s. "+
+					"If the Prefect API is slow to respond, consider increasing `timeouts.%s`.", operation, resourceName, timeout, operation),
+			)
+		}
+	}
+}
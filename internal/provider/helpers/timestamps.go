@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// EquivalentTimestamp returns a plan modifier for computed timestamp
+// attributes, which keeps the prior state value when the planned value is
+// the same instant in a different representation, such as `+00:00` and `Z`,
+// so that it is not planned as a change.
+//
+// Configured values are left as-is, as Terraform requires them to be planned
+// verbatim. Their equivalence is handled by the semantic equality of the
+// timestamp type instead.
+//
+//nolint:ireturn // required by Terraform API
+func EquivalentTimestamp() planmodifier.String {
+	return equivalentTimestampPlanModifier{}
+}
+
+type equivalentTimestampPlanModifier struct{}
+
+// Description returns a plain text description of the plan modifier.
+func (m equivalentTimestampPlanModifier) Description(_ context.Context) string {
+	return "Keeps the prior timestamp when the planned timestamp is the same instant."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m equivalentTimestampPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString keeps the state value when it is equivalent to the planned value.
+func (m equivalentTimestampPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	prior, err := time.Parse(time.RFC3339, req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	planned, err := time.Parse(time.RFC3339, req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if prior.Equal(planned) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,73 @@
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestEquivalentTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   types.String
+		state    types.String
+		plan     types.String
+		expected types.String
+	}{
+		"+00:00 and Z": {
+			config:   types.StringNull(),
+			state:    types.StringValue("2024-03-01T13:30:00+00:00"),
+			plan:     types.StringValue("2024-03-01T13:30:00Z"),
+			expected: types.StringValue("2024-03-01T13:30:00+00:00"),
+		},
+		"different instant": {
+			config:   types.StringNull(),
+			state:    types.StringValue("2024-03-01T13:30:00+00:00"),
+			plan:     types.StringValue("2024-03-01T14:30:00Z"),
+			expected: types.StringValue("2024-03-01T14:30:00Z"),
+		},
+		"unknown plan": {
+			config:   types.StringNull(),
+			state:    types.StringValue("2024-03-01T13:30:00+00:00"),
+			plan:     types.StringUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"configured": {
+			config:   types.StringValue("2024-03-01T13:30:00Z"),
+			state:    types.StringValue("2024-03-01T13:30:00+00:00"),
+			plan:     types.StringValue("2024-03-01T13:30:00Z"),
+			expected: types.StringValue("2024-03-01T13:30:00Z"),
+		},
+		"created": {
+			config:   types.StringNull(),
+			state:    types.StringNull(),
+			plan:     types.StringUnknown(),
+			expected: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				ConfigValue: test.config,
+				StateValue:  test.state,
+				PlanValue:   test.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			helpers.EquivalentTimestamp().PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(test.expected) {
+				t.Errorf("expected planned value %s, got %s", test.expected, resp.PlanValue)
+			}
+		})
+	}
+}
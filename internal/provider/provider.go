@@ -2,36 +2,61 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/datasources"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
 )
 
 var _ = provider.Provider(&PrefectProvider{})
 
-// New returns a new Prefect Provider instance.
+// defaultBasePath is the path prefix of the Prefect API routes
+// when base_path is not configured.
+const defaultBasePath = "/api"
+
+// New returns a new Prefect Provider instance
+// for the given provider version.
 //
 //nolint:ireturn // required by Terraform API
-func New() provider.Provider {
-	return &PrefectProvider{}
+func New(version string) provider.Provider {
+	return &PrefectProvider{
+		version: version,
+	}
 }
 
 // Metadata returns the provider type name.
 func (p *PrefectProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "prefect"
+	resp.Version = p.version
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -39,23 +64,115 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				Description: "Prefect API URL. Can also be set via the `PREFECT_API_URL` environment variable. Defaults to `https://api.prefect.cloud`",
+				Description: "Prefect API URL. Can also be set via the `PREFECT_API_URL` environment variable, in which case a workspace-scoped URL (as used by the Prefect CLI) also provides the default `account_id` and `workspace_id`. Defaults to `https://api.prefect.cloud`. Set this to the URL of a self-hosted Prefect server (e.g. `http://localhost:4200/api`) to use the provider without Prefect Cloud.",
+				Optional:    true,
+			},
+			"base_path": schema.StringAttribute{
+				Description: "Path prefix of the Prefect API routes, appended to `endpoint`. Set this when the Prefect API is served under a custom prefix, such as behind an API gateway (e.g. `/prefect/api`). Defaults to `/api`.",
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "Prefect Cloud API Key. Can also be set via the `PREFECT_CLOUD_API_KEY` environment variable.",
+				Description: "Prefect Cloud API Key. Can also be set via the `PREFECT_API_KEY` environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the Prefect Cloud API Key, such as a mounted Kubernetes secret. A leading `~` is expanded to the home directory, and trailing whitespace is trimmed from the file contents. Conflicts with `api_key`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "Short-lived bearer token to authenticate to Prefect Cloud with, such as one issued by a token-vending service, instead of an API Key. " +
+					"It is sent in the `Authorization: Bearer` header, the same way as an API Key. Conflicts with `api_key` and `api_key_file`, and takes precedence over the `PREFECT_API_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key"), path.MatchRoot("api_key_file")),
+				},
+			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Default Prefect Cloud Account ID. Can also be set via the `PREFECT_CLOUD_ACCOUNT_ID` environment variable.",
 				Optional:    true,
 			},
+			"account_handle": schema.StringAttribute{
+				Description: "Handle of the default Prefect Cloud Account, resolved to its Account ID when the provider is configured. Use this instead of `account_id` to refer to the account in a readable way. Conflicts with `account_id`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("account_id")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
-				Description: "Default Prefect Cloud Workspace ID.",
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Default Prefect Cloud Workspace ID. Workspace-scoped resources and data sources fall back to this value when their own `workspace_id` is unset.",
+				Optional:    true,
+			},
+			"verify_account": schema.BoolAttribute{
+				Description: "Verify when the provider is configured that the default Prefect Cloud Account exists and that the credentials have access to it, so that a misconfigured `account_id` fails with a single error upfront instead of errors on every resource. This costs an extra request. Ignored for self-hosted Prefect servers. Defaults to `false`.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried after a transient error (HTTP 429 or 5xx). Set to `0` to disable retries. Defaults to `3`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_base_delay": schema.StringAttribute{
+				Description: "Delay before the first retry, expressed as a duration string (e.g. `500ms`, `2s`). The delay is doubled on every subsequent retry, with jitter applied. Defaults to `1s`.",
+				Optional:    true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum sustained number of requests per second sent to the Prefect API, shared across all resources and data sources. This prevents Terraform's parallel operations from exceeding the Prefect Cloud rate limit. Bursts of up to one second worth of requests are allowed. Set to `0` to disable rate limiting. Defaults to no limit.",
 				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Deadline of a single HTTP request to the Prefect API, expressed as a duration string (e.g. `10s`, `2m`). A request exceeding it is aborted with a timeout error; retried requests get a new deadline on every attempt. This is distinct from the `timeouts` of individual resources, which bound a whole operation. Set to `0s` to disable the deadline. Defaults to `30s`.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy to send requests to the Prefect API through (e.g. `http://proxy.example.com:3128`). Hosts excluded by the `NO_PROXY` environment variable are still reached directly. Defaults to the proxy set by the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+				Optional:    true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate(s) to trust when verifying the Prefect API's TLS certificate, in addition to the system trust store. Use this for Prefect servers signed by a private CA.",
+				Optional:    true,
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Description: "Path to a file containing PEM-encoded CA certificate(s) to trust when verifying the Prefect API's TLS certificate, in addition to the system trust store. A leading `~` is expanded to the home directory.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the Prefect API's TLS certificate, such as a self-signed certificate on a staging Prefect server. This must not be used in production. Defaults to `false`.",
+				Optional:    true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Description: "Suffix appended to the `User-Agent` header sent with every request, such as a team or pipeline name to identify your traffic. The `User-Agent` always starts with `terraform-provider-prefect/<version>`.",
+				Optional:    true,
+			},
+			"api_version": schema.StringAttribute{
+				Description: fmt.Sprintf("Version of the Prefect API to request, sent as the `%s` header with every request. Pin it to keep the behavior of the API stable during Prefect upgrades, as breaking changes to the API are gated by this version. A warning is emitted when the server reports serving a different version while the provider is configured, such as when verifying the account. Defaults to `%s`, the version the provider is built against.", client.APIVersionHeader, client.DefaultAPIVersion),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: fmt.Sprintf("Custom HTTP headers sent with every request to the Prefect API, such as those required by an authenticating proxy. Their values are treated as secrets, and redacted from the logs. The headers managed by the provider (%s) cannot be set.", strings.Join(client.ReservedHeaders, ", ")),
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive(client.ReservedHeaders...)),
+				},
 			},
 		},
 	}
@@ -82,6 +199,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.BasePath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_path"),
+			"Unknown Prefect API Base Path",
+			"The Prefect API Base Path is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
 	if config.APIKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -91,6 +217,24 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.APIKeyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_file"),
+			"Unknown Prefect API Key File",
+			"The Prefect API Key File is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.AuthToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_token"),
+			"Unknown Prefect Auth Token",
+			"The Prefect Auth Token is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
 	if config.AccountID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("account_id"),
@@ -100,6 +244,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.AccountHandle.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account_handle"),
+			"Unknown Prefect Account Handle",
+			"The Prefect Account Handle is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
 	if config.WorkspaceID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("workspace_id"),
@@ -109,49 +262,189 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Prefect API Max Retries",
+			"The Prefect API Max Retries is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.RetryBaseDelay.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_base_delay"),
+			"Unknown Prefect API Retry Base Delay",
+			"The Prefect API Retry Base Delay is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Prefect API Requests Per Second",
+			"The Prefect API Requests Per Second is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Prefect API Request Timeout",
+			"The Prefect API Request Timeout is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown Prefect API Proxy URL",
+			"The Prefect API Proxy URL is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, set the HTTPS_PROXY environment variable, or remove the value.",
+		)
+	}
+
+	if config.CACertificate.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_certificate"),
+			"Unknown Prefect API CA Certificate",
+			"The Prefect API CA Certificate is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.CACertificateFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_certificate_file"),
+			"Unknown Prefect API CA Certificate File",
+			"The Prefect API CA Certificate File is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.VerifyAccount.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("verify_account"),
+			"Unknown Prefect Verify Account",
+			"The Prefect Verify Account setting is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Unknown Prefect API Insecure Skip Verify",
+			"The Prefect API Insecure Skip Verify setting is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.UserAgentSuffix.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_agent_suffix"),
+			"Unknown Prefect API User-Agent Suffix",
+			"The Prefect API User-Agent Suffix is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.APIVersion.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Unknown Prefect API Version",
+			"The Prefect API Version is not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
+	if config.Headers.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("headers"),
+			"Unknown Prefect API Headers",
+			"The Prefect API Headers are not known at configuration time. "+
+				"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the value.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Extract endpoint from configuration or environment variable.
 	// If the endpoint is not set, or the value is not a valid URL, emit an error.
+	//
+	// The Prefect CLI expects PREFECT_API_URL to point to a specific workspace
+	// (e.g. https://api.prefect.cloud/api/accounts/<id>/workspaces/<id>),
+	// so we'll also extract the account and workspace IDs from the URL
+	// to use as fallbacks if they are not otherwise configured.
 	var endpoint string
+	var envAccountID, envWorkspaceID uuid.UUID
 	if !config.Endpoint.IsNull() {
 		endpoint = config.Endpoint.ValueString()
 	} else if apiURLEnvVar, ok := os.LookupEnv("PREFECT_API_URL"); ok {
-		endpoint = apiURLEnvVar
+		endpoint, envAccountID, envWorkspaceID = splitWorkspaceScopedURL(apiURLEnvVar)
 	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
 	if endpoint == "" {
 		endpoint = "https://api.prefect.cloud"
 	}
-	// Here, we'll ensure that the /api suffix is present on the endpoint
-	if !strings.HasSuffix(endpoint, "/api") {
-		endpoint = fmt.Sprintf("%s/api", endpoint)
+
+	// Extract the base path from configuration, which defaults to /api.
+	// The endpoint may already include the base path
+	// (e.g. http://localhost:4200/api), so we'll only add it once.
+	basePath := defaultBasePath
+	if !config.BasePath.IsNull() {
+		basePath = strings.TrimSuffix("/"+strings.Trim(config.BasePath.ValueString(), "/"), "/")
 	}
+	endpoint = strings.TrimSuffix(endpoint, basePath)
 
-	endpointURL, err := url.Parse(endpoint)
+	endpointURL, err := url.Parse(endpoint + basePath)
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
 			"Invalid Prefect API Endpoint",
-			fmt.Sprintf("The Prefect API Endpoint %q is not a valid URL: %s", endpoint, err),
+			fmt.Sprintf("The Prefect API Endpoint %q is not a valid URL: %s", endpoint+basePath, err),
 		)
+
+		return
 	}
-	isPrefectCloudEndpoint := endpointURL.Host == "api.prefect.cloud" || endpointURL.Host == "api.prefect.dev" || endpointURL.Host == "api.stg.prefect.dev"
+	isPrefectCloudEndpoint := client.IsPrefectCloudHost(endpointURL.Host)
 
-	// Extract the API Key from configuration or environment variable.
+	// Extract the API Key from configuration, a key file, or environment variable.
+	// An auth token is used in place of the API Key, as both are bearer tokens,
+	// so a configured token takes precedence over the PREFECT_API_KEY environment variable.
 	var apiKey string
 	if !config.APIKey.IsNull() {
 		apiKey = config.APIKey.ValueString()
+	} else if !config.APIKeyFile.IsNull() {
+		apiKey, err = readAPIKeyFile(config.APIKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to read Prefect API Key File",
+				fmt.Sprintf("The Prefect API Key could not be read from %q: %s", config.APIKeyFile.ValueString(), err),
+			)
+
+			return
+		}
+	} else if !config.AuthToken.IsNull() {
+		apiKey = config.AuthToken.ValueString()
 	} else if apiKeyEnvVar, ok := os.LookupEnv("PREFECT_API_KEY"); ok {
 		apiKey = apiKeyEnvVar
 	}
 
 	// Extract the Account ID from configuration or environment variable.
 	// If the ID is set to an invalid UUID, emit an error.
+	// An account handle is resolved to its ID once the client is created.
 	var accountID uuid.UUID
 	if !config.AccountID.IsNull() {
 		accountID = config.AccountID.ValueUUID()
+	} else if !config.AccountHandle.IsNull() {
+		accountID = uuid.Nil
 	} else if accountIDEnvVar, ok := os.LookupEnv("PREFECT_CLOUD_ACCOUNT_ID"); ok {
 		accountID, err = uuid.Parse(accountIDEnvVar)
 		if err != nil {
@@ -161,6 +454,15 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 				fmt.Sprintf("The PREFECT_CLOUD_ACCOUNT_ID value %q is not a valid UUID: %s", accountIDEnvVar, err),
 			)
 		}
+	} else {
+		accountID = envAccountID
+	}
+
+	// Extract the Workspace ID from configuration,
+	// or from the workspace-scoped PREFECT_API_URL environment variable.
+	workspaceID := config.WorkspaceID.ValueUUID()
+	if config.WorkspaceID.IsNull() && accountID == envAccountID {
+		workspaceID = envWorkspaceID
 	}
 
 	// If the endpoint is pointed to Prefect Cloud, we will ensure
@@ -173,45 +475,310 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 				path.Root("api_key"),
 				"Missing Prefect API Key",
 				"The Prefect API Endpoint is configured to Prefect Cloud, however, the Prefect API Key is empty. "+
-					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, or configure the api_key attribute.",
+					"Potential resolutions: set the endpoint attribute or PREFECT_API_URL environment variable to a Prefect server installation, set the PREFECT_API_KEY environment variable, or configure the api_key, api_key_file or auth_token attribute.",
 			)
 		}
 
-		if accountID == uuid.Nil {
+		if accountID == uuid.Nil && config.AccountHandle.IsNull() {
 			resp.Diagnostics.AddAttributeWarning(
 				path.Root("account_id"),
 				"Missing Prefect Account ID",
 				"The Prefect API Endpoint is configured to Prefect Cloud, however, the Prefect Account ID is empty. "+
-					"Potential resolutions: set the PREFECT_CLOUD_ACCOUNT_ID environment variable, or configure the account_id attribute.",
+					"Potential resolutions: set the PREFECT_CLOUD_ACCOUNT_ID environment variable, or configure the account_id or account_handle attribute.",
 			)
 		}
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	// Extract the retry configuration, falling back to the client defaults.
+	maxRetries := client.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
 	}
 
-	prefectClient, err := client.New(
+	retryBaseDelay := client.DefaultRetryBaseDelay
+	if !config.RetryBaseDelay.IsNull() {
+		retryBaseDelay, err = time.ParseDuration(config.RetryBaseDelay.ValueString())
+		if err != nil || retryBaseDelay <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_base_delay"),
+				"Invalid Prefect API Retry Base Delay",
+				fmt.Sprintf("The Prefect API Retry Base Delay %q must be a positive duration, such as 500ms or 2s.", config.RetryBaseDelay.ValueString()),
+			)
+		}
+	}
+
+	requestTimeout := client.DefaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout, err = time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || requestTimeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Prefect API Request Timeout",
+				fmt.Sprintf("The Prefect API Request Timeout %q must be a non-negative duration, such as 10s or 2m.", config.RequestTimeout.ValueString()),
+			)
+		}
+	}
+
+	// Extract the custom headers. Individual values may still be unknown.
+	headers := map[string]string{}
+	if !config.Headers.IsNull() {
+		elements := make(map[string]types.String, len(config.Headers.Elements()))
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &elements, false)...)
+
+		for name, value := range elements {
+			if value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("headers").AtMapKey(name),
+					"Unknown Prefect API Header",
+					fmt.Sprintf("The value of the Prefect API Header %q is not known at configuration time. ", name)+
+						"Potential resolutions: target apply the source of the value first, set the value statically in the configuration, or remove the header.",
+				)
+
+				continue
+			}
+
+			headers[name] = value.ValueString()
+		}
+	}
+
+	// If the endpoint is pointed to a self-hosted Prefect server,
+	// account and workspace IDs have no meaning, as the OSS API
+	// is not scoped to accounts or workspaces.
+	// We will warn and ignore those values if they are set.
+	if !isPrefectCloudEndpoint {
+		if accountID != uuid.Nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("account_id"),
+				"Prefect Account ID is ignored for self-hosted Prefect servers",
+				"The Prefect API Endpoint is configured to a self-hosted Prefect server, which does not support accounts, so the Prefect Account ID will be ignored. "+
+					"Potential resolutions: remove the account_id attribute or unset the PREFECT_CLOUD_ACCOUNT_ID environment variable.",
+			)
+			accountID = uuid.Nil
+		}
+
+		if !config.AccountHandle.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("account_handle"),
+				"Prefect Account Handle is ignored for self-hosted Prefect servers",
+				"The Prefect API Endpoint is configured to a self-hosted Prefect server, which does not support accounts, so the Prefect Account Handle will be ignored. "+
+					"Potential resolutions: remove the account_handle attribute.",
+			)
+		}
+
+		if workspaceID != uuid.Nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("workspace_id"),
+				"Prefect Workspace ID is ignored for self-hosted Prefect servers",
+				"The Prefect API Endpoint is configured to a self-hosted Prefect server, which does not support workspaces, so the Prefect Workspace ID will be ignored. "+
+					"Potential resolutions: remove the workspace_id attribute.",
+			)
+			workspaceID = uuid.Nil
+		}
+	}
+
+	opts := []client.Option{
 		client.WithEndpoint(endpoint),
+		client.WithBasePath(basePath),
 		client.WithAPIKey(apiKey),
-		client.WithDefaults(accountID, config.WorkspaceID.ValueUUID()),
-	)
+		client.WithRetries(maxRetries, retryBaseDelay),
+		client.WithRateLimit(config.RequestsPerSecond.ValueFloat64()),
+		client.WithRequestTimeout(requestTimeout),
+		client.WithHeaders(headers),
+		client.WithUserAgent(userAgent(p.version, config.UserAgentSuffix.ValueString())),
+	}
+
+	if !config.APIVersion.IsNull() {
+		opts = append(opts, client.WithAPIVersion(config.APIVersion.ValueString()))
+	}
+
+	// Extract the proxy URL from configuration, otherwise the proxy
+	// is taken from the HTTP(S)_PROXY environment variables.
+	if !config.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Prefect API Proxy URL",
+				fmt.Sprintf("The Prefect API Proxy URL %q must be a valid URL including a scheme and host, such as http://proxy.example.com:3128.", config.ProxyURL.ValueString()),
+			)
+		}
+
+		opts = append(opts, client.WithProxyURL(config.ProxyURL.ValueString()))
+	}
+
+	// Extract the CA certificates to trust in addition to the system trust store,
+	// from the PEM contents and/or the PEM file.
+	var caCertificates []*x509.Certificate
+	if !config.CACertificate.IsNull() {
+		certificates, err := client.ParseCACertificates([]byte(config.CACertificate.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_certificate"),
+				"Invalid Prefect API CA Certificate",
+				fmt.Sprintf("The Prefect API CA Certificate could not be parsed: %s", err),
+			)
+		}
+
+		caCertificates = append(caCertificates, certificates...)
+	}
+
+	if !config.CACertificateFile.IsNull() {
+		contents, err := readFile(config.CACertificateFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_certificate_file"),
+				"Unable to read Prefect API CA Certificate File",
+				fmt.Sprintf("The Prefect API CA Certificate could not be read from %q: %s", config.CACertificateFile.ValueString(), err),
+			)
+		} else {
+			certificates, err := client.ParseCACertificates(contents)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ca_certificate_file"),
+					"Invalid Prefect API CA Certificate File",
+					fmt.Sprintf("The Prefect API CA Certificate File %q could not be parsed: %s", config.CACertificateFile.ValueString(), err),
+				)
+			}
+
+			caCertificates = append(caCertificates, certificates...)
+		}
+	}
+
+	if len(caCertificates) > 0 {
+		opts = append(opts, client.WithCACertificates(caCertificates))
+	}
+
+	// Skipping TLS verification is only meant for lower environments,
+	// so we'll make it visible in the logs whenever it is enabled.
+	if config.InsecureSkipVerify.ValueBool() {
+		tflog.Warn(ctx, "TLS certificate verification of the Prefect API is disabled by insecure_skip_verify; this must not be used in production", map[string]interface{}{
+			"endpoint": endpoint,
+		})
+
+		opts = append(opts, client.WithInsecureSkipVerify(true))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the account handle to its ID, which requires an API client
+	// with no default account; the client is then created anew with the ID.
+	if isPrefectCloudEndpoint && !config.AccountHandle.IsNull() {
+		handleClient, err := client.New(opts...)
+		if err != nil {
+			resp.Diagnostics.Append(clientCreationErrorDiagnostic(err))
+
+			return
+		}
+
+		accountID, err = handleClient.AccountIDByHandle(ctx, config.AccountHandle.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("account_handle"),
+				"Unable to resolve Prefect Account Handle",
+				fmt.Sprintf("The Prefect Account Handle %q could not be resolved to an account that the Prefect API Key has access to: %s", config.AccountHandle.ValueString(), err),
+			)
+
+			return
+		}
+
+		if config.WorkspaceID.IsNull() && accountID == envAccountID {
+			workspaceID = envWorkspaceID
+		}
+	}
+
+	prefectClient, err := client.New(append(opts, client.WithDefaults(accountID, workspaceID))...)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create Prefect API Client",
-			fmt.Sprintf("An unexpected error occurred when creating the Prefect API client. This is a bug in the provider, please create an issue against https://github.com/PrefectHQ/terraform-provider-prefect unless it has already been reported. "+
-				"Error returned by the client: %s", err),
-		)
+		resp.Diagnostics.Append(clientCreationErrorDiagnostic(err))
 
 		return
 	}
 	p.client = prefectClient
 
+	if isPrefectCloudEndpoint && config.VerifyAccount.ValueBool() {
+		resp.Diagnostics.Append(verifyAccount(ctx, prefectClient, accountID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The served API version is only known once a request was made,
+	// such as the one verifying the account.
+	if requested, served, mismatch := prefectClient.APIVersionMismatch(); mismatch {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("api_version"),
+			"Prefect API version mismatch",
+			fmt.Sprintf("The provider requested version %s of the Prefect API, but the server reported serving version %s, which may change the behavior of the provider. "+
+				"Potential resolutions: pin api_version to the version served, or upgrade the provider.", requested, served),
+		)
+	}
+
 	// Pass client to DataSource and Resource type Configure methods
 	resp.DataSourceData = prefectClient
 	resp.ResourceData = prefectClient
 }
 
+// verifyAccount checks that the default account exists and that
+// the client's credentials have access to it.
+func verifyAccount(ctx context.Context, prefectClient *client.Client, accountID uuid.UUID) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if accountID == uuid.Nil {
+		diags.AddAttributeError(
+			path.Root("verify_account"),
+			"Missing Prefect Account ID",
+			"The Prefect Account cannot be verified, as the Prefect Account ID is empty. "+
+				"Potential resolutions: set the PREFECT_CLOUD_ACCOUNT_ID environment variable, configure the account_id or account_handle attribute, or disable verify_account.",
+		)
+
+		return diags
+	}
+
+	accountsClient, err := prefectClient.Accounts(accountID)
+	if err != nil {
+		diags.Append(clientCreationErrorDiagnostic(err))
+
+		return diags
+	}
+
+	_, err = accountsClient.Get(ctx)
+
+	var responseErr *api.ResponseError
+
+	switch {
+	case err == nil:
+	case errors.Is(err, api.ErrNotFound),
+		errors.As(err, &responseErr) && (responseErr.StatusCode == http.StatusUnauthorized || responseErr.StatusCode == http.StatusForbidden):
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Unable to access Prefect Account",
+			fmt.Sprintf("The Prefect Account %s does not exist, or the Prefect API Key does not have access to it: %s. "+
+				"Potential resolutions: check the account_id attribute or PREFECT_CLOUD_ACCOUNT_ID environment variable, and that the API Key belongs to a member of the account.", accountID, err),
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Unable to verify Prefect Account",
+			fmt.Sprintf("An unexpected error occurred when verifying the Prefect Account %s: %s", accountID, err),
+		)
+	}
+
+	return diags
+}
+
+// clientCreationErrorDiagnostic returns an error diagnostic for when
+// the Prefect API client could not be created.
+//
+//nolint:ireturn // required by Terraform API
+func clientCreationErrorDiagnostic(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to create Prefect API Client",
+		fmt.Sprintf("An unexpected error occurred when creating the Prefect API client. This is a bug in the provider, please create an issue against https://github.com/PrefectHQ/terraform-provider-prefect unless it has already been reported. "+
+			"Error returned by the client: %s", err),
+	)
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -219,15 +786,28 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewAccountMemberDataSource,
 		datasources.NewAccountMembersDataSource,
 		datasources.NewAccountRoleDataSource,
+		datasources.NewArtifactDataSource,
+		datasources.NewBlockDocumentDataSource,
+		datasources.NewBlockSchemaDataSource,
+		datasources.NewBlockTypeDataSource,
+		datasources.NewCollectionsDataSource,
+		datasources.NewCurrentAccountDataSource,
+		datasources.NewDeploymentDataSource,
+		datasources.NewFlowDataSource,
 		datasources.NewServiceAccountDataSource,
+		datasources.NewTaskRunConcurrencyLimitDataSource,
 		datasources.NewTeamDataSource,
 		datasources.NewTeamsDataSource,
 		datasources.NewVariableDataSource,
+		datasources.NewVariablesDataSource,
 		datasources.NewWorkerMetadataDataSource,
 		datasources.NewWorkPoolDataSource,
 		datasources.NewWorkPoolsDataSource,
+		datasources.NewWorkQueuesDataSource,
 		datasources.NewWorkspaceDataSource,
+		datasources.NewWorkspacesDataSource,
 		datasources.NewWorkspaceRoleDataSource,
+		datasources.NewWorkspaceRolesDataSource,
 	}
 }
 
@@ -235,11 +815,101 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *PrefectProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewAccountResource,
+		resources.NewAccountMemberResource,
+		resources.NewAccountRoleResource,
+		resources.NewAutomationResource,
+		resources.NewBlockDocumentResource,
+		resources.NewConcurrencyLimitResource,
+		resources.NewDeploymentResource,
+		resources.NewDeploymentAccessResource,
+		resources.NewDeploymentScheduleResource,
+		resources.NewFlowRunNotificationPolicyResource,
+		resources.NewGlobalConcurrencyLimitResource,
 		resources.NewServiceAccountResource,
+		resources.NewTeamResource,
 		resources.NewVariableResource,
+		resources.NewVariablesResource,
+		resources.NewWebhookResource,
+		resources.NewWorkPoolAccessResource,
 		resources.NewWorkPoolResource,
+		resources.NewWorkQueueResource,
 		resources.NewWorkspaceAccessResource,
 		resources.NewWorkspaceResource,
 		resources.NewWorkspaceRoleResource,
+		resources.NewWorkspaceServiceAccountAccessResource,
+	}
+}
+
+// workspaceScopedURLRegex matches a workspace-scoped Prefect Cloud API URL,
+// as configured for the Prefect CLI via PREFECT_API_URL.
+var workspaceScopedURLRegex = regexp.MustCompile(`^(.*)/accounts/([0-9a-fA-F-]{36})/workspaces/([0-9a-fA-F-]{36})/?$`)
+
+// userAgent returns the User-Agent header sent with every request,
+// which identifies the provider version, followed by the optional suffix.
+func userAgent(version string, suffix string) string {
+	userAgent := "terraform-provider-prefect/" + version
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+
+	return userAgent
+}
+
+// splitWorkspaceScopedURL splits a workspace-scoped API URL into
+// the base API URL, the account ID, and the workspace ID.
+// If the URL is not workspace-scoped, it is returned as-is with nil IDs.
+func splitWorkspaceScopedURL(apiURL string) (string, uuid.UUID, uuid.UUID) {
+	matches := workspaceScopedURLRegex.FindStringSubmatch(apiURL)
+	if matches == nil {
+		return apiURL, uuid.Nil, uuid.Nil
 	}
+
+	accountID, err := uuid.Parse(matches[2])
+	if err != nil {
+		return apiURL, uuid.Nil, uuid.Nil
+	}
+
+	workspaceID, err := uuid.Parse(matches[3])
+	if err != nil {
+		return apiURL, uuid.Nil, uuid.Nil
+	}
+
+	return matches[1], accountID, workspaceID
+}
+
+// readFile reads the file at the given path, expanding
+// a leading ~ to the current user's home directory.
+func readFile(name string) ([]byte, error) {
+	if name == "~" || strings.HasPrefix(name, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand home directory: %w", err)
+		}
+
+		name = filepath.Join(homeDir, strings.TrimPrefix(name, "~"))
+	}
+
+	contents, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file: %w", err)
+	}
+
+	return contents, nil
+}
+
+// readAPIKeyFile reads an API key from the file at the given path,
+// trimming any trailing whitespace, such as the newline that
+// mounted secret files commonly end with.
+func readAPIKeyFile(keyFile string) (string, error) {
+	contents, err := readFile(keyFile)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := strings.TrimRightFunc(string(contents), unicode.IsSpace)
+	if apiKey == "" {
+		return "", fmt.Errorf("file %q is empty", keyFile)
+	}
+
+	return apiKey, nil
 }
@@ -98,11 +98,17 @@ func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the account",
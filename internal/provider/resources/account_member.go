@@ -0,0 +1,429 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&AccountMemberResource{})
+	_ = resource.ResourceWithImportState(&AccountMemberResource{})
+)
+
+// accountMemberStatusActive is the status of an Account Member
+// once their invitation has been accepted.
+const accountMemberStatusActive = "ACTIVE"
+
+// AccountMemberResource contains state for the resource.
+type AccountMemberResource struct {
+	client api.PrefectClient
+}
+
+// AccountMemberResourceModel defines the Terraform resource model.
+type AccountMemberResourceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+
+	Email         types.String          `tfsdk:"email"`
+	AccountRoleID customtypes.UUIDValue `tfsdk:"account_role_id"`
+	Status        types.String          `tfsdk:"status"`
+	InvitationID  customtypes.UUIDValue `tfsdk:"invitation_id"`
+	MembershipID  customtypes.UUIDValue `tfsdk:"membership_id"`
+}
+
+// NewAccountMemberResource returns a new AccountMemberResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAccountMemberResource() resource.Resource {
+	return &AccountMemberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *AccountMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_member"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *AccountMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// requiresReplaceIfInvitationPending forces a replacement when the
+// Account Role of a pending invitation changes, as invitations cannot be
+// modified; the role of an active Account Member is updated in place.
+func requiresReplaceIfInvitationPending(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var membershipID customtypes.UUIDValue
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("membership_id"), &membershipID)...)
+
+	resp.RequiresReplace = membershipID.IsNull()
+}
+
+// Schema defines the schema for the resource.
+func (r *AccountMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `account_member` represents a member of a Prefect Cloud Account. " +
+			"Creating the resource invites the user to the Account by email, and destroying it " +
+			"revokes the invitation, or removes the user from the Account once they have accepted it.\n" +
+			"\n" +
+			"Invitations are accepted asynchronously, so the `status` of the resource is `PENDING` " +
+			"until the user joins the Account, after which it is `ACTIVE`.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Account Member ID (UUID). This is the ID of the invitation when the resource is created, " +
+					"or the ID of the account membership when the resource is imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the user to invite to the Account",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_role_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account Role ID (UUID) to grant the user. Changing the role of a pending invitation re-invites the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfInvitationPending,
+						"Changing the Account Role of a pending invitation requires re-inviting the user.",
+						"Changing the Account Role of a pending invitation requires re-inviting the user.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the Account Member, either `PENDING` while the invitation has not been accepted, or `ACTIVE`",
+			},
+			"invitation_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Invitation ID (UUID), if the Account Member was invited by this resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account Membership ID (UUID), once the invitation has been accepted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// copyAccountMembershipToModel copies an api.AccountMembership to an AccountMemberResourceModel.
+func copyAccountMembershipToModel(membership *api.AccountMembership, model *AccountMemberResourceModel) {
+	model.Email = types.StringValue(membership.Email)
+	model.AccountRoleID = customtypes.NewUUIDValue(membership.AccountRoleID)
+	model.Status = types.StringValue(accountMemberStatusActive)
+	model.MembershipID = customtypes.NewUUIDValue(membership.ID)
+}
+
+// copyAccountInvitationToModel copies an api.AccountInvitation to an AccountMemberResourceModel.
+func copyAccountInvitationToModel(invitation *api.AccountInvitation, model *AccountMemberResourceModel) {
+	model.Email = types.StringValue(invitation.Email)
+	model.AccountRoleID = customtypes.NewUUIDValue(invitation.AccountRoleID)
+	model.Status = types.StringValue(invitation.Status)
+	model.InvitationID = customtypes.NewUUIDValue(invitation.ID)
+	model.MembershipID = customtypes.NewUUIDNull()
+}
+
+// findAccountMembershipByEmail returns the account membership
+// for the given email, or api.ErrNotFound if the user is not a member.
+func findAccountMembershipByEmail(ctx context.Context, client api.AccountMembershipsClient, email string) (*api.AccountMembership, error) {
+	memberships, err := client.List(ctx, []string{email}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, membership := range memberships {
+		if strings.EqualFold(membership.Email, email) {
+			return membership, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account membership email=%s: %w", email, api.ErrNotFound)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccountMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model AccountMemberResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountMemberships(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Member", err))
+
+		return
+	}
+
+	invitation, err := client.Invite(ctx, api.AccountInvitationCreate{
+		Email:         model.Email.ValueString(),
+		AccountRoleID: model.AccountRoleID.ValueUUID(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "invite", err))
+
+		return
+	}
+
+	model.ID = types.StringValue(invitation.ID.String())
+	copyAccountInvitationToModel(invitation, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccountMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model AccountMemberResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountMemberships(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Member", err))
+
+		return
+	}
+
+	var membership *api.AccountMembership
+
+	switch {
+	case !model.MembershipID.IsNull():
+		membership, err = client.Get(ctx, model.MembershipID.ValueUUID())
+		if err != nil {
+			if errors.Is(err, api.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "get", err))
+
+			return
+		}
+
+	case !model.InvitationID.IsNull():
+		invitation, err := client.GetInvitation(ctx, model.InvitationID.ValueUUID())
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "get invitation", err))
+
+			return
+		}
+
+		// A pending invitation is a valid state for the resource,
+		// as the user may accept it at any time.
+		if invitation != nil && invitation.Status == api.AccountInvitationStatusPending {
+			copyAccountInvitationToModel(invitation, &model)
+
+			break
+		}
+
+		// Otherwise, the invitation was either accepted or has expired,
+		// so we'll look for the membership of the invited user.
+		membership, err = findAccountMembershipByEmail(ctx, client, model.Email.ValueString())
+		if err != nil {
+			if errors.Is(err, api.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "list", err))
+
+			return
+		}
+
+	default:
+		// This can only happen when importing, where we only have the membership ID.
+		membershipID, err := uuid.Parse(model.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error parsing Account Member ID",
+				fmt.Sprintf("Could not parse Account Member ID to UUID, unexpected error: %s", err.Error()),
+			)
+
+			return
+		}
+
+		membership, err = client.Get(ctx, membershipID)
+		if err != nil {
+			if errors.Is(err, api.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "get", err))
+
+			return
+		}
+	}
+
+	if membership != nil {
+		copyAccountMembershipToModel(membership, &model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AccountMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model AccountMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountMemberships(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Member", err))
+
+		return
+	}
+
+	// Only the Account Role of an active member can be updated in place;
+	// any other change to the resource requires a replacement.
+	err = client.Update(ctx, model.MembershipID.ValueUUID(), api.AccountMembershipUpdate{
+		AccountRoleID: model.AccountRoleID.ValueUUID(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "update", err))
+
+		return
+	}
+
+	membership, err := client.Get(ctx, model.MembershipID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "get", err))
+
+		return
+	}
+
+	copyAccountMembershipToModel(membership, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AccountMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model AccountMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountMemberships(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Member", err))
+
+		return
+	}
+
+	if !model.MembershipID.IsNull() {
+		err = client.Delete(ctx, model.MembershipID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "delete", err))
+
+			return
+		}
+
+		return
+	}
+
+	// The invitation may have expired since the last refresh,
+	// in which case there is nothing left to revoke.
+	err = client.RevokeInvitation(ctx, model.InvitationID.ValueUUID())
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Member", "revoke invitation", err))
+
+		return
+	}
+}
+
+// ImportState allows Terraform to start managing an Account Member resource.
+func (r *AccountMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Account Member ID",
+			fmt.Sprintf("Could not parse Account Member ID to UUID, expected an account membership UUID, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,60 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccAccountMemberResource(email string, role string) string {
+	return fmt.Sprintf(`
+data "prefect_account_role" "role" {
+	name = "%s"
+}
+
+resource "prefect_account_member" "member" {
+	email = "%s"
+	account_role_id = data.prefect_account_role.role.id
+}`, role, email)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_account_member(t *testing.T) {
+	resourceName := "prefect_account_member.member"
+	email := fmt.Sprintf("%s%s@example.com", testutils.TestAccPrefix, acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the invitation is created, and left pending
+				Config: fixtureAccAccountMemberResource(email, "Member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "status", "PENDING"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_role_id", "data.prefect_account_role.role", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "invitation_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "membership_id"),
+				),
+			},
+			{
+				// Check that changing the role of a pending invitation re-invites the user
+				Config: fixtureAccAccountMemberResource(email, "Admin"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "PENDING"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_role_id", "data.prefect_account_role.role", "id"),
+				),
+			},
+		},
+	})
+}
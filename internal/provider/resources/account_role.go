@@ -0,0 +1,416 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&AccountRoleResource{})
+	_ = resource.ResourceWithImportState(&AccountRoleResource{})
+)
+
+// AccountRoleResource contains state for the resource.
+type AccountRoleResource struct {
+	client api.PrefectClient
+}
+
+// AccountRoleResourceModel defines the Terraform resource model.
+type AccountRoleResourceModel struct {
+	ID        types.String               `tfsdk:"id"`
+	Created   customtypes.TimestampValue `tfsdk:"created"`
+	Updated   customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID customtypes.UUIDValue      `tfsdk:"account_id"`
+
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// NewAccountRoleResource returns a new AccountRoleResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAccountRoleResource() resource.Resource {
+	return &AccountRoleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *AccountRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_role"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *AccountRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *AccountRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `account_role` represents a custom Prefect Cloud Account Role. " +
+			"Account Roles hold a set of permissions to the Account, and can be attached to " +
+			"an Account Member or Service Account.\n" +
+			"\n" +
+			"The system Account Roles (e.g. `Admin` or `Member`) are managed by Prefect " +
+			"and are read-only; use the `account_role` data source to reference them instead.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Account Role ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Account Role",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Account Role",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Set of permissions granted by the Account Role, e.g. `see_workspaces`",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// copyAccountRoleResourceToModel copies an api.AccountRole to an AccountRoleResourceModel.
+func copyAccountRoleResourceToModel(ctx context.Context, role *api.AccountRole, model *AccountRoleResourceModel) diag.Diagnostics {
+	model.ID = types.StringValue(role.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(role.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(role.Updated)
+
+	model.Name = types.StringValue(role.Name)
+	model.Description = types.StringValue("")
+	if role.Description != nil {
+		model.Description = types.StringValue(*role.Description)
+	}
+
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, role.Permissions)
+	if diags.HasError() {
+		return diags
+	}
+	model.Permissions = permissions
+
+	return nil
+}
+
+// accountRoleFromModel returns the api.AccountRoleUpsert
+// described by an AccountRoleResourceModel.
+func accountRoleFromModel(ctx context.Context, model *AccountRoleResourceModel) (api.AccountRoleUpsert, diag.Diagnostics) {
+	var permissions []string
+	diags := model.Permissions.ElementsAs(ctx, &permissions, false)
+
+	return api.AccountRoleUpsert{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Permissions: permissions,
+	}, diags
+}
+
+// systemAccountRoleDiagnostic returns an error diagnostic for when a
+// practitioner attempts to manage one of the system Account Roles.
+//
+//nolint:ireturn // required by Terraform API
+func systemAccountRoleDiagnostic(name string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("name"),
+		"Cannot manage a system Account Role",
+		fmt.Sprintf("The Account Role %q is a system role managed by Prefect, and is read-only. ", name)+
+			"Use the `prefect_account_role` data source to reference it, or choose a different name for a custom role.",
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccountRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model AccountRoleResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := accountRoleFromModel(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	// System Account Roles cannot be managed by the provider,
+	// so we'll reject a role that collides with one of them.
+	existingRoles, err := client.List(ctx, []string{model.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "list", err))
+
+		return
+	}
+
+	for _, existingRole := range existingRoles {
+		if existingRole.IsSystemRole {
+			resp.Diagnostics.Append(systemAccountRoleDiagnostic(existingRole.Name))
+
+			return
+		}
+	}
+
+	role, err := client.Create(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleResourceToModel(ctx, role, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccountRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model AccountRoleResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	roleID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Account Role ID",
+			fmt.Sprintf("Could not parse Account Role ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	role, err := client.Get(ctx, roleID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "get", err))
+
+		return
+	}
+
+	// This can only happen when importing one of the system Account Roles.
+	if role.IsSystemRole {
+		resp.Diagnostics.Append(systemAccountRoleDiagnostic(role.Name))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleResourceToModel(ctx, role, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AccountRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model AccountRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := accountRoleFromModel(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	roleID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Account Role ID",
+			fmt.Sprintf("Could not parse Account Role ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// The full permission set is sent, so the server
+	// reconciles the permissions of the role in place.
+	err = client.Update(ctx, roleID, data)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "update", err))
+
+		return
+	}
+
+	role, err := client.Get(ctx, roleID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleResourceToModel(ctx, role, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AccountRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model AccountRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(model.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	roleID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Account Role ID",
+			fmt.Sprintf("Could not parse Account Role ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, roleID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "delete", err))
+
+		return
+	}
+}
+
+// ImportState allows Terraform to start managing an Account Role resource.
+func (r *AccountRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Account Role ID",
+			fmt.Sprintf("Could not parse Account Role ID to UUID, expected an Account Role UUID, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,136 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccAccountRoleResource(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_account_role" "role" {
+	name = "%s"
+	description = "%s description"
+	permissions = ["see_workspaces", "see_members"]
+}`, name, name)
+}
+
+func fixtureAccAccountRoleResourceUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_account_role" "role" {
+	name = "%s"
+	description = "description for %s"
+	permissions = ["see_workspaces", "see_teams", "see_service_accounts"]
+}`, name, name)
+}
+
+func fixtureAccAccountRoleResourceSystemRole() string {
+	return `
+resource "prefect_account_role" "role" {
+	name = "Member"
+	permissions = ["see_workspaces"]
+}`
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_account_role(t *testing.T) {
+	resourceName := "prefect_account_role.role"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var accountRole api.AccountRole
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the system account roles cannot be managed
+				Config:      fixtureAccAccountRoleResourceSystemRole(),
+				ExpectError: regexp.MustCompile("Cannot manage a system Account Role"),
+			},
+			{
+				// Check creation + existence of the account role resource
+				Config: fixtureAccAccountRoleResource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRoleExists(resourceName, &accountRole),
+					testAccCheckAccountRoleValues(&accountRole, &api.AccountRole{Name: randomName, Permissions: []string{"see_workspaces", "see_members"}}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("%s description", randomName)),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_workspaces"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_members"),
+				),
+			},
+			{
+				// Check that permissions are reconciled in place
+				Config: fixtureAccAccountRoleResourceUpdated(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRoleExists(resourceName, &accountRole),
+					testAccCheckAccountRoleValues(&accountRole, &api.AccountRole{Name: randomName, Permissions: []string{"see_workspaces", "see_teams", "see_service_accounts"}}),
+					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("description for %s", randomName)),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_teams"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "see_service_accounts"),
+				),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRoleExists(roleResourceName string, role *api.AccountRole) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		accountRoleResource, ok := state.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found in state: %s", roleResourceName)
+		}
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		accountRolesClient, _ := c.AccountRoles(uuid.Nil)
+		resourceID, _ := uuid.Parse(accountRoleResource.Primary.ID)
+
+		fetchedAccountRole, err := accountRolesClient.Get(context.Background(), resourceID)
+		if err != nil {
+			return fmt.Errorf("Error fetching Account Role: %w", err)
+		}
+
+		*role = *fetchedAccountRole
+
+		return nil
+	}
+}
+
+func testAccCheckAccountRoleValues(fetchedRole *api.AccountRole, valuesToCheck *api.AccountRole) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedRole.Name != valuesToCheck.Name {
+			return fmt.Errorf("Expected Account Role name %s, got: %s", valuesToCheck.Name, fetchedRole.Name)
+		}
+
+		sort.StringSlice(fetchedRole.Permissions).Sort()
+		sort.StringSlice(valuesToCheck.Permissions).Sort()
+
+		if !reflect.DeepEqual(fetchedRole.Permissions, valuesToCheck.Permissions) {
+			return fmt.Errorf("Expected Account Role permissions %v, got: %v", valuesToCheck.Permissions, fetchedRole.Permissions)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,525 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&AutomationResource{})
+	_ = resource.ResourceWithImportState(&AutomationResource{})
+	_ = resource.ResourceWithConfigValidators(&AutomationResource{})
+)
+
+// AutomationResource contains state for the resource.
+type AutomationResource struct {
+	client api.PrefectClient
+}
+
+// AutomationResourceModel defines the Terraform resource model.
+type AutomationResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	Enabled     types.Bool                  `tfsdk:"enabled"`
+	Trigger     customtypes.JSONStringValue `tfsdk:"trigger"`
+	Actions     customtypes.JSONStringValue `tfsdk:"actions"`
+
+	EventTrigger  *EventTriggerModel  `tfsdk:"event_trigger"`
+	MetricTrigger *MetricTriggerModel `tfsdk:"metric_trigger"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewAutomationResource returns a new AutomationResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAutomationResource() resource.Resource {
+	return &AutomationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *AutomationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *AutomationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *AutomationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `automation` represents a Prefect Automation. " +
+			"Automations run their actions, such as sending a notification or pausing a deployment, when their trigger fires.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Automation ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the automation",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the automation",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether this automation is enabled",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"trigger": schema.StringAttribute{
+				CustomType: customtypes.JSONStringType{},
+				Description: "The trigger of the automation, as a JSON object. Use `jsonencode()` to provide the value. " +
+					"Use this for trigger types without a typed attribute; otherwise prefer `event_trigger` or `metric_trigger`. " +
+					"When a typed trigger is set, this holds the trigger as stored by the server.",
+				Optional: true,
+				Computed: true,
+			},
+			"event_trigger":  eventTriggerSchema(),
+			"metric_trigger": metricTriggerSchema(),
+			"actions": schema.StringAttribute{
+				CustomType:  customtypes.JSONStringType{},
+				Description: "The actions run when the trigger fires, as a JSON list. Use `jsonencode()` to provide the value.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// ConfigValidators returns the validators applied to the resource configuration.
+func (r *AutomationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("trigger"),
+			path.MatchRoot("event_trigger"),
+			path.MatchRoot("metric_trigger"),
+		),
+	}
+}
+
+// copyAutomationToModel copies an api.Automation to an AutomationResourceModel.
+func copyAutomationToModel(ctx context.Context, automation *api.Automation, model *AutomationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(automation.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(automation.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(automation.Updated)
+
+	model.Name = types.StringValue(automation.Name)
+	model.Description = types.StringValue(automation.Description)
+	model.Enabled = types.BoolValue(automation.Enabled)
+
+	trigger, err := json.Marshal(automation.Trigger)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("trigger"),
+			"Failed to serialize Automation trigger",
+			fmt.Sprintf("Failed to serialize Automation trigger as JSON string: %s", err),
+		)
+
+		return diags
+	}
+	// The server fills in defaults for the fields of the trigger and
+	// actions that are not configured, so we preserve the existing value
+	// as long as the server's representation still contains it.
+	if model.Trigger.IsNull() || model.Trigger.IsUnknown() || !helpers.JSONSubset(model.Trigger.ValueString(), string(trigger)) {
+		model.Trigger = customtypes.NewJSONStringValue(string(trigger))
+	}
+
+	actions, err := json.Marshal(automation.Actions)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("actions"),
+			"Failed to serialize Automation actions",
+			fmt.Sprintf("Failed to serialize Automation actions as JSON string: %s", err),
+		)
+
+		return diags
+	}
+	if model.Actions.IsNull() || model.Actions.IsUnknown() || !helpers.JSONSubset(model.Actions.ValueString(), string(actions)) {
+		model.Actions = customtypes.NewJSONStringValue(string(actions))
+	}
+
+	// Typed triggers are only refreshed when they are in use, so that
+	// automations configured with a raw JSON trigger keep them unset.
+	switch {
+	case model.EventTrigger != nil:
+		diags.Append(copyEventTriggerToModel(ctx, automation.Trigger, model.EventTrigger)...)
+	case model.MetricTrigger != nil:
+		diags.Append(copyMetricTriggerToModel(automation.Trigger, model.MetricTrigger)...)
+	}
+
+	return diags
+}
+
+// automationFromModel returns the api.AutomationUpsert
+// described by an AutomationResourceModel.
+func automationFromModel(ctx context.Context, model *AutomationResourceModel) (api.AutomationUpsert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	automation := api.AutomationUpsert{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Enabled:     model.Enabled.ValueBool(),
+	}
+
+	var triggerDiags diag.Diagnostics
+	switch {
+	case model.EventTrigger != nil:
+		automation.Trigger, triggerDiags = eventTriggerFromModel(ctx, model.EventTrigger)
+		diags.Append(triggerDiags...)
+	case model.MetricTrigger != nil:
+		automation.Trigger, triggerDiags = metricTriggerFromModel(model.MetricTrigger)
+		diags.Append(triggerDiags...)
+	default:
+		if err := json.Unmarshal([]byte(model.Trigger.ValueString()), &automation.Trigger); err != nil {
+			diags.AddAttributeError(
+				path.Root("trigger"),
+				"Failed to deserialize Automation trigger",
+				fmt.Sprintf("Failed to deserialize Automation trigger as JSON object: %s", err),
+			)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(model.Actions.ValueString()), &automation.Actions); err != nil {
+		diags.AddAttributeError(
+			path.Root("actions"),
+			"Failed to deserialize Automation actions",
+			fmt.Sprintf("Failed to deserialize Automation actions as JSON list of objects: %s", err),
+		)
+	}
+
+	return automation, diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model AutomationResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Automation", "create", &resp.Diagnostics)
+	defer done()
+
+	data, diags := automationFromModel(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+		return
+	}
+
+	automation, err := client.Create(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAutomationToModel(ctx, automation, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model AutomationResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Automation", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+		return
+	}
+
+	automationID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	automation, err := client.Get(ctx, automationID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAutomationToModel(ctx, automation, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AutomationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model AutomationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Automation", "update", &resp.Diagnostics)
+	defer done()
+
+	data, diags := automationFromModel(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+		return
+	}
+
+	automationID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Update(ctx, automationID, data)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "update", err))
+
+		return
+	}
+
+	automation, err := client.Get(ctx, automationID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAutomationToModel(ctx, automation, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model AutomationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Automation", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
+
+		return
+	}
+
+	automationID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, automationID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automation", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *AutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	// - "id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if _, err := uuid.Parse(identifier); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID to UUID, expected a automation UUID, got: %s", identifier),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+}
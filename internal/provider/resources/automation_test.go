@@ -0,0 +1,266 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccAutomationResource(name string, enabled bool, threshold int) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_automation" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	description = "created by acceptance tests"
+	enabled = %t
+	trigger = jsonencode({
+		type = "event"
+		posture = "Reactive"
+		match = {
+			"prefect.resource.id" = "prefect.flow-run.*"
+		}
+		expect = ["prefect.flow-run.Failed"]
+		threshold = %d
+		within = 0
+	})
+	actions = jsonencode([
+		{ type = "do-nothing" }
+	])
+}
+	`, name, enabled, threshold)
+}
+
+func fixtureAccAutomationResourceEventTrigger(name string, threshold int) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_automation" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	event_trigger = {
+		match = jsonencode({
+			"prefect.resource.id" = "prefect.flow-run.*"
+		})
+		expect = ["prefect.flow-run.Failed"]
+		threshold = %d
+		within = 60
+	}
+	actions = jsonencode([
+		{ type = "do-nothing" }
+	])
+}
+	`, name, threshold)
+}
+
+func fixtureAccAutomationResourceMetricTrigger(name string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_automation" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	metric_trigger = {
+		match = jsonencode({
+			"prefect.resource.id" = "prefect.deployment.*"
+		})
+		metric = "lateness"
+		operator = ">"
+		threshold = 600
+	}
+	actions = jsonencode([
+		{ type = "do-nothing" }
+	])
+}
+	`, name)
+}
+
+func fixtureAccAutomationResourceConflictingTriggers(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_automation" "test" {
+	name = "%s"
+	trigger = jsonencode({ type = "event" })
+	event_trigger = {
+		expect = ["prefect.flow-run.Failed"]
+	}
+	actions = jsonencode([
+		{ type = "do-nothing" }
+	])
+}
+	`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_automation(t *testing.T) {
+	resourceName := "prefect_automation.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var automation api.Automation
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the automation resource
+				Config: fixtureAccAutomationResource(randomName, true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationExists(resourceName, workspaceDatasourceName, &automation),
+					testAccCheckAutomationValues(&automation, true, 1),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				// Check updating the trigger + disabling the automation
+				Config: fixtureAccAutomationResource(randomName, false, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationExists(resourceName, workspaceDatasourceName, &automation),
+					testAccCheckAutomationValues(&automation, false, 3),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getAutomationImportStateID(resourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+				// The server fills in defaults for the trigger and actions,
+				// which only match the configuration semantically.
+				ImportStateVerifyIgnore: []string{"trigger", "actions"},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_automation_typed_trigger(t *testing.T) {
+	resourceName := "prefect_automation.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	var automation api.Automation
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that only one trigger representation may be used
+				Config:      fixtureAccAutomationResourceConflictingTriggers(randomName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				// Check creation with a typed event trigger
+				Config: fixtureAccAutomationResourceEventTrigger(randomName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationExists(resourceName, workspaceDatasourceName, &automation),
+					testAccCheckAutomationValues(&automation, true, 2),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger.posture", "Reactive"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger.threshold", "2"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger.within", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "trigger"),
+				),
+			},
+			{
+				// Check updating the typed event trigger
+				Config: fixtureAccAutomationResourceEventTrigger(randomName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationExists(resourceName, workspaceDatasourceName, &automation),
+					testAccCheckAutomationValues(&automation, true, 5),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger.threshold", "5"),
+				),
+			},
+			{
+				// Check switching to a typed metric trigger
+				Config: fixtureAccAutomationResourceMetricTrigger(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationExists(resourceName, workspaceDatasourceName, &automation),
+					resource.TestCheckNoResourceAttr(resourceName, "event_trigger"),
+					resource.TestCheckResourceAttr(resourceName, "metric_trigger.metric", "lateness"),
+					resource.TestCheckResourceAttr(resourceName, "metric_trigger.range", "300"),
+					resource.TestCheckResourceAttr(resourceName, "metric_trigger.firing_for", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationExists(automationResourceName string, workspaceDatasourceName string, automation *api.Automation) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		automationResource, exists := state.RootModule().Resources[automationResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", automationResourceName)
+		}
+		automationID, _ := uuid.Parse(automationResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		automationsClient, _ := c.Automations(uuid.Nil, workspaceID)
+
+		fetchedAutomation, err := automationsClient.Get(context.Background(), automationID)
+		if err != nil {
+			return fmt.Errorf("Error fetching automation: %w", err)
+		}
+
+		*automation = *fetchedAutomation
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationValues(fetchedAutomation *api.Automation, enabled bool, threshold int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedAutomation.Enabled != enabled {
+			return fmt.Errorf("Expected automation enabled to be %t, got %t", enabled, fetchedAutomation.Enabled)
+		}
+
+		// JSON numbers are decoded as float64
+		if value := fetchedAutomation.Trigger["threshold"]; value != float64(threshold) {
+			return fmt.Errorf("Expected automation trigger threshold to be %d, got %v", threshold, value)
+		}
+
+		return nil
+	}
+}
+
+func getAutomationImportStateID(automationResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		automationResource, exists := state.RootModule().Resources[automationResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", automationResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, automationResource.Primary.ID), nil
+	}
+}
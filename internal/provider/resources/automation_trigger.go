@@ -0,0 +1,354 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// Trigger types and postures understood by the Prefect API.
+const (
+	automationTriggerTypeEvent  = "event"
+	automationTriggerTypeMetric = "metric"
+
+	automationPostureReactive  = "Reactive"
+	automationPostureProactive = "Proactive"
+	automationPostureMetric    = "Metric"
+)
+
+// EventTriggerModel defines the typed representation of an event trigger.
+type EventTriggerModel struct {
+	Match        customtypes.JSONStringValue `tfsdk:"match"`
+	MatchRelated customtypes.JSONStringValue `tfsdk:"match_related"`
+	After        types.Set                   `tfsdk:"after"`
+	Expect       types.Set                   `tfsdk:"expect"`
+	ForEach      types.Set                   `tfsdk:"for_each"`
+	Posture      types.String                `tfsdk:"posture"`
+	Threshold    types.Int64                 `tfsdk:"threshold"`
+	Within       types.Int64                 `tfsdk:"within"`
+}
+
+// MetricTriggerModel defines the typed representation of a metric trigger.
+type MetricTriggerModel struct {
+	Match        customtypes.JSONStringValue `tfsdk:"match"`
+	MatchRelated customtypes.JSONStringValue `tfsdk:"match_related"`
+	Metric       types.String                `tfsdk:"metric"`
+	Operator     types.String                `tfsdk:"operator"`
+	Threshold    types.Float64               `tfsdk:"threshold"`
+	Range        types.Int64                 `tfsdk:"range"`
+	FiringFor    types.Int64                 `tfsdk:"firing_for"`
+}
+
+// automationMatchAttributes returns the resource matching attributes
+// shared by every typed trigger.
+func automationMatchAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"match": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
+			Description: "Labels the triggering resource must match, as a JSON object (e.g. `{\"prefect.resource.id\" = \"prefect.flow-run.*\"}`). Use `jsonencode()` to provide the value.",
+			Optional:    true,
+		},
+		"match_related": schema.StringAttribute{
+			CustomType:  customtypes.JSONStringType{},
+			Description: "Labels a related resource must match, as a JSON object. Use `jsonencode()` to provide the value.",
+			Optional:    true,
+		},
+	}
+}
+
+// eventTriggerSchema returns the schema of the event_trigger attribute.
+func eventTriggerSchema() schema.SingleNestedAttribute {
+	attributes := automationMatchAttributes()
+	attributes["expect"] = schema.SetAttribute{
+		Description: "Event names the trigger expects, such as `prefect.flow-run.Failed`",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["after"] = schema.SetAttribute{
+		Description: "Event names that must occur before the expected events are counted",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["for_each"] = schema.SetAttribute{
+		Description: "Resource labels used to evaluate the trigger separately for each distinct value",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["posture"] = schema.StringAttribute{
+		Description: "Whether the trigger fires when the expected events occur (`Reactive`) or when they do not (`Proactive`)",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(automationPostureReactive),
+		Validators: []validator.String{
+			stringvalidator.OneOf(automationPostureReactive, automationPostureProactive),
+		},
+	}
+	attributes["threshold"] = schema.Int64Attribute{
+		Description: "Number of expected events required to fire the trigger",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(1),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attributes["within"] = schema.Int64Attribute{
+		Description: "Time period, in seconds, the threshold must be reached within",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(0),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "A typed event trigger, which fires based on the events emitted by matching resources. Conflicts with `trigger` and `metric_trigger`.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// metricTriggerSchema returns the schema of the metric_trigger attribute.
+func metricTriggerSchema() schema.SingleNestedAttribute {
+	attributes := automationMatchAttributes()
+	attributes["metric"] = schema.StringAttribute{
+		Description: "Name of the metric to evaluate (`lateness`, `duration`, or `successes`)",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("lateness", "duration", "successes"),
+		},
+	}
+	attributes["operator"] = schema.StringAttribute{
+		Description: "Operator used to compare the metric to the threshold (`<`, `<=`, `>`, or `>=`)",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("<", "<=", ">", ">="),
+		},
+	}
+	attributes["threshold"] = schema.Float64Attribute{
+		Description: "Value the metric is compared to",
+		Required:    true,
+	}
+	attributes["range"] = schema.Int64Attribute{
+		Description: "Lookback period, in seconds, the metric is evaluated over",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(300),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(300),
+		},
+	}
+	attributes["firing_for"] = schema.Int64Attribute{
+		Description: "Time, in seconds, the metric must breach the threshold before the trigger fires",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(300),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(300),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "A typed metric trigger, which fires based on a metric computed from matching resources. Conflicts with `trigger` and `event_trigger`.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// matchFromModel decodes a resource specification, treating a null value as an empty object.
+func matchFromModel(value customtypes.JSONStringValue, attribute path.Path) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	match := map[string]interface{}{}
+	if value.IsNull() || value.IsUnknown() {
+		return match, diags
+	}
+
+	if err := json.Unmarshal([]byte(value.ValueString()), &match); err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Failed to deserialize Automation trigger match",
+			fmt.Sprintf("Failed to deserialize Automation trigger match as JSON object: %s", err),
+		)
+	}
+
+	return match, diags
+}
+
+// matchToModel returns the resource specification held by the server,
+// preserving the configured value when it is semantically equal and
+// keeping a null value when the server holds an empty specification.
+func matchToModel(current customtypes.JSONStringValue, match interface{}, attribute path.Path) (customtypes.JSONStringValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if specification, ok := match.(map[string]interface{}); !ok || len(specification) == 0 {
+		if current.IsNull() {
+			return current, diags
+		}
+	}
+
+	encoded, err := json.Marshal(match)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Failed to serialize Automation trigger match",
+			fmt.Sprintf("Failed to serialize Automation trigger match as JSON string: %s", err),
+		)
+
+		return current, diags
+	}
+
+	if !current.IsNull() && !current.IsUnknown() && helpers.JSONSemanticallyEqual(current.ValueString(), string(encoded)) {
+		return current, diags
+	}
+
+	return customtypes.NewJSONStringValue(string(encoded)), diags
+}
+
+// stringSetToModel returns the string list held by the server as a set,
+// keeping a null value when the server holds an empty list.
+func stringSetToModel(ctx context.Context, current types.Set, values interface{}) (types.Set, diag.Diagnostics) {
+	items, _ := values.([]interface{})
+	if len(items) == 0 && current.IsNull() {
+		return current, nil
+	}
+
+	elements := make([]string, 0, len(items))
+	for _, item := range items {
+		if value, ok := item.(string); ok {
+			elements = append(elements, value)
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, elements)
+}
+
+// int64FromJSON converts a decoded JSON number, such as a count or a
+// duration in seconds, to an Int64 value.
+func int64FromJSON(value interface{}) types.Int64 {
+	number, ok := value.(float64)
+	if !ok {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(number))
+}
+
+// eventTriggerFromModel returns the JSON trigger described by an EventTriggerModel.
+func eventTriggerFromModel(ctx context.Context, model *EventTriggerModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	match, matchDiags := matchFromModel(model.Match, path.Root("event_trigger").AtName("match"))
+	diags.Append(matchDiags...)
+	matchRelated, matchDiags := matchFromModel(model.MatchRelated, path.Root("event_trigger").AtName("match_related"))
+	diags.Append(matchDiags...)
+
+	after, setDiags := stringSetElements(ctx, model.After)
+	diags.Append(setDiags...)
+	expect, setDiags := stringSetElements(ctx, model.Expect)
+	diags.Append(setDiags...)
+	forEach, setDiags := stringSetElements(ctx, model.ForEach)
+	diags.Append(setDiags...)
+
+	return map[string]interface{}{
+		"type":          automationTriggerTypeEvent,
+		"match":         match,
+		"match_related": matchRelated,
+		"after":         after,
+		"expect":        expect,
+		"for_each":      forEach,
+		"posture":       model.Posture.ValueString(),
+		"threshold":     model.Threshold.ValueInt64(),
+		"within":        model.Within.ValueInt64(),
+	}, diags
+}
+
+// copyEventTriggerToModel copies a JSON event trigger to an EventTriggerModel.
+func copyEventTriggerToModel(ctx context.Context, trigger map[string]interface{}, model *EventTriggerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var fieldDiags diag.Diagnostics
+
+	model.Match, fieldDiags = matchToModel(model.Match, trigger["match"], path.Root("event_trigger").AtName("match"))
+	diags.Append(fieldDiags...)
+	model.MatchRelated, fieldDiags = matchToModel(model.MatchRelated, trigger["match_related"], path.Root("event_trigger").AtName("match_related"))
+	diags.Append(fieldDiags...)
+
+	model.After, fieldDiags = stringSetToModel(ctx, model.After, trigger["after"])
+	diags.Append(fieldDiags...)
+	model.Expect, fieldDiags = stringSetToModel(ctx, model.Expect, trigger["expect"])
+	diags.Append(fieldDiags...)
+	model.ForEach, fieldDiags = stringSetToModel(ctx, model.ForEach, trigger["for_each"])
+	diags.Append(fieldDiags...)
+
+	if posture, ok := trigger["posture"].(string); ok {
+		model.Posture = types.StringValue(posture)
+	}
+	model.Threshold = int64FromJSON(trigger["threshold"])
+	model.Within = int64FromJSON(trigger["within"])
+
+	return diags
+}
+
+// metricTriggerFromModel returns the JSON trigger described by a MetricTriggerModel.
+func metricTriggerFromModel(model *MetricTriggerModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	match, matchDiags := matchFromModel(model.Match, path.Root("metric_trigger").AtName("match"))
+	diags.Append(matchDiags...)
+	matchRelated, matchDiags := matchFromModel(model.MatchRelated, path.Root("metric_trigger").AtName("match_related"))
+	diags.Append(matchDiags...)
+
+	return map[string]interface{}{
+		"type":          automationTriggerTypeMetric,
+		"posture":       automationPostureMetric,
+		"match":         match,
+		"match_related": matchRelated,
+		"metric": map[string]interface{}{
+			"name":       model.Metric.ValueString(),
+			"operator":   model.Operator.ValueString(),
+			"threshold":  model.Threshold.ValueFloat64(),
+			"range":      model.Range.ValueInt64(),
+			"firing_for": model.FiringFor.ValueInt64(),
+		},
+	}, diags
+}
+
+// copyMetricTriggerToModel copies a JSON metric trigger to a MetricTriggerModel.
+func copyMetricTriggerToModel(trigger map[string]interface{}, model *MetricTriggerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var fieldDiags diag.Diagnostics
+
+	model.Match, fieldDiags = matchToModel(model.Match, trigger["match"], path.Root("metric_trigger").AtName("match"))
+	diags.Append(fieldDiags...)
+	model.MatchRelated, fieldDiags = matchToModel(model.MatchRelated, trigger["match_related"], path.Root("metric_trigger").AtName("match_related"))
+	diags.Append(fieldDiags...)
+
+	metric, _ := trigger["metric"].(map[string]interface{})
+	if name, ok := metric["name"].(string); ok {
+		model.Metric = types.StringValue(name)
+	}
+	if operator, ok := metric["operator"].(string); ok {
+		model.Operator = types.StringValue(operator)
+	}
+	if threshold, ok := metric["threshold"].(float64); ok {
+		model.Threshold = types.Float64Value(threshold)
+	}
+	model.Range = int64FromJSON(metric["range"])
+	model.FiringFor = int64FromJSON(metric["firing_for"])
+
+	return diags
+}
@@ -0,0 +1,483 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&BlockDocumentResource{})
+	_ = resource.ResourceWithImportState(&BlockDocumentResource{})
+)
+
+// BlockDocumentResource contains state for the resource.
+type BlockDocumentResource struct {
+	client api.PrefectClient
+}
+
+// BlockDocumentResourceModel defines the Terraform resource model.
+type BlockDocumentResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name          types.String                `tfsdk:"name"`
+	BlockTypeSlug types.String                `tfsdk:"block_type_slug"`
+	BlockTypeID   customtypes.UUIDValue       `tfsdk:"block_type_id"`
+	BlockSchemaID customtypes.UUIDValue       `tfsdk:"block_schema_id"`
+	Data          customtypes.JSONStringValue `tfsdk:"data"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewBlockDocumentResource returns a new BlockDocumentResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockDocumentResource() resource.Resource {
+	return &BlockDocumentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *BlockDocumentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_document"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *BlockDocumentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *BlockDocumentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `block_document` represents a Prefect Block Document. " +
+			"Blocks store configuration, such as storage credentials or notification settings, for use in your flows.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Block Document ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the block document",
+				Required:    true,
+				// Block documents cannot be renamed.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block_type_slug": schema.StringAttribute{
+				Description: "Slug of the block type, such as `s3-bucket` or `slack-webhook`",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block_type_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Type ID (UUID)",
+			},
+			"block_schema_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Schema ID (UUID), resolved to the latest schema of the block type on creation",
+			},
+			"data": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				CustomType:  customtypes.JSONStringType{},
+				Description: "The fields of the block document, as a JSON string. Use `jsonencode()` to provide the value. Marked sensitive, as blocks often hold secrets.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyBlockDocumentToModel copies an api.BlockDocument to a BlockDocumentResourceModel.
+func copyBlockDocumentToModel(blockDocument *api.BlockDocument, model *BlockDocumentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(blockDocument.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(blockDocument.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(blockDocument.Updated)
+
+	model.Name = types.StringValue(blockDocument.Name)
+	model.BlockTypeID = customtypes.NewUUIDValue(blockDocument.BlockTypeID)
+	model.BlockSchemaID = customtypes.NewUUIDValue(blockDocument.BlockSchemaID)
+
+	// The block type is not always embedded in the response,
+	// in which case we keep the configured slug.
+	if blockDocument.BlockType.Slug != "" {
+		model.BlockTypeSlug = types.StringValue(blockDocument.BlockType.Slug)
+	}
+
+	data, err := json.Marshal(blockDocument.Data)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("data"),
+			"Failed to serialize Block Document data",
+			fmt.Sprintf("Failed to serialize Block Document data as JSON string: %s", err),
+		)
+
+		return diags
+	}
+
+	model.Data = customtypes.NewJSONStringValue(string(data))
+
+	return nil
+}
+
+// decodeBlockDocumentData decodes the JSON data of a block document.
+func decodeBlockDocumentData(model *BlockDocumentResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(model.Data.ValueString()), &data); err != nil {
+		diags.AddAttributeError(
+			path.Root("data"),
+			"Failed to deserialize Block Document data",
+			fmt.Sprintf("Failed to deserialize Block Document data as JSON object: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	return data, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *BlockDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model BlockDocumentResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Block Document", "create", &resp.Diagnostics)
+	defer done()
+
+	data, diags := decodeBlockDocumentData(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Blocks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blockDocument, err := client.Create(ctx, api.BlockDocumentCreate{
+		Name:          model.Name.ValueString(),
+		BlockTypeSlug: model.BlockTypeSlug.ValueString(),
+		Data:          data,
+	})
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("block_type_slug"),
+				"Block Type not found",
+				fmt.Sprintf("Could not find block type %q: %s", model.BlockTypeSlug.ValueString(), err),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyBlockDocumentToModel(blockDocument, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *BlockDocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model BlockDocumentResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Block Document", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Blocks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blockDocumentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Block Document ID",
+			fmt.Sprintf("Could not parse block document ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	blockDocument, err := client.Get(ctx, blockDocumentID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyBlockDocumentToModel(blockDocument, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *BlockDocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model BlockDocumentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Block Document", "update", &resp.Diagnostics)
+	defer done()
+
+	data, diags := decodeBlockDocumentData(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Blocks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blockDocumentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Block Document ID",
+			fmt.Sprintf("Could not parse block document ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	// Replace the stored data rather than merging into it,
+	// so that fields removed from the configuration are removed.
+	err = client.Update(ctx, blockDocumentID, api.BlockDocumentUpdate{
+		Data:              data,
+		MergeExistingData: false,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "update", err))
+
+		return
+	}
+
+	blockDocument, err := client.Get(ctx, blockDocumentID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyBlockDocumentToModel(blockDocument, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *BlockDocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model BlockDocumentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Block Document", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Blocks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	blockDocumentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Block Document ID",
+			fmt.Sprintf("Could not parse block document ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, blockDocumentID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *BlockDocumentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	// - "id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if _, err := uuid.Parse(identifier); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Block Document ID",
+			fmt.Sprintf("Could not parse block document ID to UUID, expected a block document UUID, got: %s", identifier),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+}
@@ -0,0 +1,133 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccBlockDocumentResource(name string, value string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_block_document" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	block_type_slug = "secret"
+	data = jsonencode({
+		value = "%s"
+	})
+}
+	`, name, value)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_document(t *testing.T) {
+	resourceName := "prefect_block_document.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomValue := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomValue2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var blockDocument api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the block document resource
+				Config: fixtureAccBlockDocumentResource(randomName, randomValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockDocumentExists(resourceName, workspaceDatasourceName, &blockDocument),
+					testAccCheckBlockDocumentValue(&blockDocument, randomValue),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "block_type_slug", "secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "block_schema_id"),
+				),
+			},
+			{
+				// Check updating the data of the block document resource
+				Config: fixtureAccBlockDocumentResource(randomName, randomValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockDocumentExists(resourceName, workspaceDatasourceName, &blockDocument),
+					testAccCheckBlockDocumentValue(&blockDocument, randomValue2),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getBlockDocumentImportStateID(resourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlockDocumentExists(blockDocumentResourceName string, workspaceDatasourceName string, blockDocument *api.BlockDocument) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		blockDocumentResource, exists := state.RootModule().Resources[blockDocumentResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", blockDocumentResourceName)
+		}
+		blockDocumentID, _ := uuid.Parse(blockDocumentResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		blocksClient, _ := c.Blocks(uuid.Nil, workspaceID)
+
+		fetchedBlockDocument, err := blocksClient.Get(context.Background(), blockDocumentID)
+		if err != nil {
+			return fmt.Errorf("Error fetching block document: %w", err)
+		}
+
+		*blockDocument = *fetchedBlockDocument
+
+		return nil
+	}
+}
+
+func testAccCheckBlockDocumentValue(fetchedBlockDocument *api.BlockDocument, expected string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if value := fetchedBlockDocument.Data["value"]; value != expected {
+			return fmt.Errorf("Expected block document value to be %s, got %v", expected, value)
+		}
+
+		return nil
+	}
+}
+
+func getBlockDocumentImportStateID(blockDocumentResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		blockDocumentResource, exists := state.RootModule().Resources[blockDocumentResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", blockDocumentResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, blockDocumentResource.Primary.ID), nil
+	}
+}
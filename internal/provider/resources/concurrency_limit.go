@@ -0,0 +1,377 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&ConcurrencyLimitResource{})
+	_ = resource.ResourceWithImportState(&ConcurrencyLimitResource{})
+)
+
+// ConcurrencyLimitResource contains state for the resource.
+type ConcurrencyLimitResource struct {
+	client api.PrefectClient
+}
+
+// ConcurrencyLimitResourceModel defines the Terraform resource model.
+type ConcurrencyLimitResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Tag              types.String `tfsdk:"tag"`
+	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewConcurrencyLimitResource returns a new ConcurrencyLimitResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewConcurrencyLimitResource() resource.Resource {
+	return &ConcurrencyLimitResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ConcurrencyLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_concurrency_limit"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *ConcurrencyLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *ConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `concurrency_limit` represents a Prefect tag-based Concurrency Limit. " +
+			"Concurrency limits cap the number of task runs with a given tag that can run at the same time.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Concurrency Limit ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Tag of the task runs the limit applies to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Description: "Maximum number of concurrent task runs with the tag",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyConcurrencyLimitToModel copies an api.ConcurrencyLimit to a ConcurrencyLimitResourceModel.
+func copyConcurrencyLimitToModel(concurrencyLimit *api.ConcurrencyLimit, model *ConcurrencyLimitResourceModel) {
+	model.ID = types.StringValue(concurrencyLimit.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(concurrencyLimit.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(concurrencyLimit.Updated)
+
+	model.Tag = types.StringValue(concurrencyLimit.Tag)
+	model.ConcurrencyLimit = types.Int64Value(concurrencyLimit.ConcurrencyLimit)
+}
+
+// upsert creates the concurrency limit of the model's tag,
+// or changes the limit if the tag already has one.
+func (r *ConcurrencyLimitResource) upsert(ctx context.Context, model *ConcurrencyLimitResourceModel) (*api.ConcurrencyLimit, error) {
+	client, err := r.client.ConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Create(ctx, api.ConcurrencyLimitCreate{
+		Tag:              model.Tag.ValueString(),
+		ConcurrencyLimit: model.ConcurrencyLimit.ValueInt64(),
+	})
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model ConcurrencyLimitResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Concurrency Limit", "create", &resp.Diagnostics)
+	defer done()
+
+	concurrencyLimit, err := r.upsert(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "create", err))
+
+		return
+	}
+
+	copyConcurrencyLimitToModel(concurrencyLimit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ConcurrencyLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model ConcurrencyLimitResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Concurrency Limit", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.ConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	// Always prefer to refresh state using the ID, if it is set.
+	//
+	// If we are importing by tag, then we will need to load once using the tag.
+	var concurrencyLimit *api.ConcurrencyLimit
+
+	switch {
+	case !model.ID.IsNull():
+		var concurrencyLimitID uuid.UUID
+		concurrencyLimitID, err = uuid.Parse(model.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error parsing Concurrency Limit ID",
+				fmt.Sprintf("Could not parse concurrency limit ID to UUID, unexpected error: %s", err.Error()),
+			)
+
+			return
+		}
+		concurrencyLimit, err = client.Get(ctx, concurrencyLimitID)
+	case !model.Tag.IsNull():
+		concurrencyLimit, err = client.GetByTag(ctx, model.Tag.ValueString())
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Tag are unset",
+			"This is a bug in the Terraform provider. Please report it to the maintainers.",
+		)
+
+		return
+	}
+
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "get", err))
+
+		return
+	}
+
+	copyConcurrencyLimitToModel(concurrencyLimit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ConcurrencyLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model ConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Concurrency Limit", "update", &resp.Diagnostics)
+	defer done()
+
+	// There is no route to update a concurrency limit, but as the tag
+	// cannot change in place, upserting by tag updates the same limit.
+	concurrencyLimit, err := r.upsert(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "update", err))
+
+		return
+	}
+
+	copyConcurrencyLimitToModel(concurrencyLimit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ConcurrencyLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model ConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Concurrency Limit", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.ConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Concurrency Limit", err))
+
+		return
+	}
+
+	concurrencyLimitID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Concurrency Limit ID",
+			fmt.Sprintf("Could not parse concurrency limit ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, concurrencyLimitID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Concurrency Limit", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *ConcurrencyLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id" or "workspace_id,tag/tag"
+	// - "id" or "tag/tag"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id` or `workspace_id,tag/tag`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id` or `workspace_id,tag/tag`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if tag, isTag := strings.CutPrefix(identifier, "tag/"); isTag {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), tag)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+	}
+}
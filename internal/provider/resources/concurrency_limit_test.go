@@ -0,0 +1,174 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccConcurrencyLimitResource(tag string, limit int64) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_concurrency_limit" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	tag = "%s"
+	concurrency_limit = %d
+}
+	`, tag, limit)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_concurrency_limit(t *testing.T) {
+	resourceName := "prefect_concurrency_limit.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomTag := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var concurrencyLimit api.ConcurrencyLimit
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the concurrency limit resource
+				Config: fixtureAccConcurrencyLimitResource(randomTag, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConcurrencyLimitExists(resourceName, workspaceDatasourceName, &concurrencyLimit),
+					testAccCheckConcurrencyLimitValues(&concurrencyLimit, randomTag, 5),
+					resource.TestCheckResourceAttr(resourceName, "tag", randomTag),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+				),
+			},
+			{
+				// Check updating the limit of the concurrency limit resource
+				Config: fixtureAccConcurrencyLimitResource(randomTag, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConcurrencyLimitExists(resourceName, workspaceDatasourceName, &concurrencyLimit),
+					testAccCheckConcurrencyLimitValues(&concurrencyLimit, randomTag, 10),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "10"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getConcurrencyLimitImportStateID(resourceName, workspaceDatasourceName, false),
+				ImportStateVerify: true,
+			},
+			// Import State checks - import by workspace_id,tag/tag (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getConcurrencyLimitImportStateID(resourceName, workspaceDatasourceName, true),
+				ImportStateVerify: true,
+			},
+			{
+				// Check that a concurrency limit deleted outside of Terraform
+				// is removed from state and planned for re-creation
+				Config:             fixtureAccConcurrencyLimitResource(randomTag, 10),
+				Check:              testAccDeleteConcurrencyLimitOutOfBand(resourceName, workspaceDatasourceName),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConcurrencyLimitExists(concurrencyLimitResourceName string, workspaceDatasourceName string, concurrencyLimit *api.ConcurrencyLimit) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		concurrencyLimitResource, exists := state.RootModule().Resources[concurrencyLimitResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", concurrencyLimitResourceName)
+		}
+		concurrencyLimitID, _ := uuid.Parse(concurrencyLimitResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		concurrencyLimitsClient, _ := c.ConcurrencyLimits(uuid.Nil, workspaceID)
+
+		fetchedConcurrencyLimit, err := concurrencyLimitsClient.Get(context.Background(), concurrencyLimitID)
+		if err != nil {
+			return fmt.Errorf("Error fetching concurrency limit: %w", err)
+		}
+
+		*concurrencyLimit = *fetchedConcurrencyLimit
+
+		return nil
+	}
+}
+
+func testAccCheckConcurrencyLimitValues(fetchedConcurrencyLimit *api.ConcurrencyLimit, tag string, limit int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedConcurrencyLimit.Tag != tag {
+			return fmt.Errorf("Expected concurrency limit tag to be %s, got %s", tag, fetchedConcurrencyLimit.Tag)
+		}
+		if fetchedConcurrencyLimit.ConcurrencyLimit != limit {
+			return fmt.Errorf("Expected concurrency limit to be %d, got %d", limit, fetchedConcurrencyLimit.ConcurrencyLimit)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeleteConcurrencyLimitOutOfBand(concurrencyLimitResourceName string, workspaceDatasourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		concurrencyLimitResource, exists := state.RootModule().Resources[concurrencyLimitResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", concurrencyLimitResourceName)
+		}
+		concurrencyLimitID, _ := uuid.Parse(concurrencyLimitResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		c, _ := testutils.NewTestClient()
+		concurrencyLimitsClient, _ := c.ConcurrencyLimits(uuid.Nil, workspaceID)
+
+		if err := concurrencyLimitsClient.Delete(context.Background(), concurrencyLimitID); err != nil {
+			return fmt.Errorf("Error deleting concurrency limit: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func getConcurrencyLimitImportStateID(concurrencyLimitResourceName string, workspaceDatasourceName string, byTag bool) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		concurrencyLimitResource, exists := state.RootModule().Resources[concurrencyLimitResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", concurrencyLimitResourceName)
+		}
+
+		if byTag {
+			return fmt.Sprintf("%s,tag/%s", workspaceID, concurrencyLimitResource.Primary.Attributes["tag"]), nil
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, concurrencyLimitResource.Primary.ID), nil
+	}
+}
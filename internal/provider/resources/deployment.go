@@ -0,0 +1,591 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentResource{})
+	_ = resource.ResourceWithImportState(&DeploymentResource{})
+)
+
+// DeploymentResource contains state for the resource.
+type DeploymentResource struct {
+	client api.PrefectClient
+}
+
+// DeploymentResourceModel defines the Terraform resource model.
+type DeploymentResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name          types.String                `tfsdk:"name"`
+	FlowID        types.String                `tfsdk:"flow_id"`
+	WorkPoolName  types.String                `tfsdk:"work_pool_name"`
+	WorkQueueName types.String                `tfsdk:"work_queue_name"`
+	Parameters    customtypes.JSONStringValue `tfsdk:"parameters"`
+	Tags          types.Set                   `tfsdk:"tags"`
+	Paused        types.Bool                  `tfsdk:"paused"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewDeploymentResource returns a new DeploymentResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *DeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultEmptyTagSet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		Description: "The resource `deployment` represents a Prefect Deployment. " +
+			"Deployments are server-side representations of flows, which can be triggered and scheduled.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Deployment ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the deployment",
+				Required:    true,
+				// Deployments are identified by their flow and name,
+				// so neither can be changed in place.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flow_id": schema.StringAttribute{
+				// See the note on the id attribute for why this is not a CustomType.
+				Description: "Flow ID (UUID) of the flow this deployment runs",
+				Required:    true,
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"work_pool_name": schema.StringAttribute{
+				Description: "Name of the work pool that runs this deployment. Removing it from the configuration clears the work pool and work queue of the deployment.",
+				Optional:    true,
+			},
+			"work_queue_name": schema.StringAttribute{
+				Description: "Name of the work queue that runs this deployment, defaults to the default queue of the work pool",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					deploymentWorkQueueNamePlanModifier{},
+				},
+			},
+			"parameters": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.JSONStringType{},
+				Default:     stringdefault.StaticString("{}"),
+				Description: "Parameters passed to the flow runs of this deployment, as a JSON string. Use `jsonencode()` to provide the value.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags associated with the deployment",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(defaultEmptyTagSet),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this deployment is paused, which stops its schedules from creating flow runs",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyDeploymentToModel copies an api.Deployment to a DeploymentResourceModel.
+func copyDeploymentToModel(ctx context.Context, deployment *api.Deployment, model *DeploymentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(deployment.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(deployment.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(deployment.Updated)
+
+	model.Name = types.StringValue(deployment.Name)
+	model.FlowID = types.StringValue(deployment.FlowID.String())
+	model.WorkPoolName = types.StringPointerValue(deployment.WorkPoolName)
+	model.WorkQueueName = types.StringPointerValue(deployment.WorkQueueName)
+	model.Paused = types.BoolValue(deployment.Paused)
+
+	parameters := deployment.Parameters
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Failed to serialize Deployment parameters",
+			fmt.Sprintf("Failed to serialize Deployment parameters as JSON string: %s", err),
+		)
+
+		return diags
+	}
+	model.Parameters = customtypes.NewJSONStringValue(string(parametersJSON))
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, deployment.Tags)
+	if diags.HasError() {
+		return diags
+	}
+	model.Tags = tags
+
+	return nil
+}
+
+// decodeDeploymentParameters decodes the JSON parameters of a deployment.
+func decodeDeploymentParameters(model *DeploymentResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parameters := map[string]interface{}{}
+	if model.Parameters.IsNull() {
+		return parameters, nil
+	}
+
+	if err := json.Unmarshal([]byte(model.Parameters.ValueString()), &parameters); err != nil {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Failed to deserialize Deployment parameters",
+			fmt.Sprintf("Failed to deserialize Deployment parameters as JSON object: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	return parameters, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model DeploymentResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment", "create", &resp.Diagnostics)
+	defer done()
+
+	parameters, diags := decodeDeploymentParameters(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flowID, err := uuid.Parse(model.FlowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("flow_id"),
+			"Error parsing Flow ID",
+			fmt.Sprintf("Could not parse flow ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deployment, err := client.Create(ctx, api.DeploymentCreate{
+		Name:          model.Name.ValueString(),
+		FlowID:        flowID,
+		WorkPoolName:  model.WorkPoolName.ValueStringPointer(),
+		WorkQueueName: model.WorkQueueName.ValueStringPointer(),
+		Parameters:    parameters,
+		Tags:          tags,
+		Paused:        model.Paused.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model DeploymentResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deploymentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	deployment, err := client.Get(ctx, deploymentID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment", "update", &resp.Diagnostics)
+	defer done()
+
+	parameters, diags := decodeDeploymentParameters(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deploymentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Update(ctx, deploymentID, api.DeploymentUpdate{
+		WorkPoolName:  model.WorkPoolName.ValueStringPointer(),
+		WorkQueueName: model.WorkQueueName.ValueStringPointer(),
+		// The full parameters and tags are sent on every update,
+		// so values removed from the configuration are removed on the server.
+		Parameters: parameters,
+		Tags:       tags,
+		Paused:     model.Paused.ValueBool(),
+		// A nil work pool name leaves the work pool unmodified,
+		// so it is cleared explicitly when removed from the configuration.
+		ClearWorkPool: model.WorkPoolName.IsNull() && !state.WorkPoolName.IsNull(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "update", err))
+
+		return
+	}
+
+	deployment, err := client.Get(ctx, deploymentID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyDeploymentToModel(ctx, deployment, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	deploymentID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, deploymentID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	// - "id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if _, err := uuid.Parse(identifier); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, expected a deployment UUID, got: %s", identifier),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+}
+
+// deploymentWorkQueueNamePlanModifier plans the work queue name of a
+// deployment whose work pool changes, unless the work queue is configured.
+// The work queue belongs to the work pool, so it is cleared along with it,
+// or defaults to the default queue of the new work pool.
+type deploymentWorkQueueNamePlanModifier struct{}
+
+func (m deploymentWorkQueueNamePlanModifier) Description(_ context.Context) string {
+	return "Clears the work queue name, or plans it as unknown, when the work pool changes."
+}
+
+func (m deploymentWorkQueueNamePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m deploymentWorkQueueNamePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var planWorkPoolName, stateWorkPoolName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("work_pool_name"), &planWorkPoolName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("work_pool_name"), &stateWorkPoolName)...)
+	if resp.Diagnostics.HasError() || planWorkPoolName.Equal(stateWorkPoolName) {
+		return
+	}
+
+	if planWorkPoolName.IsNull() {
+		resp.PlanValue = types.StringNull()
+
+		return
+	}
+
+	resp.PlanValue = types.StringUnknown()
+}
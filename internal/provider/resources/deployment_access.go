@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentAccessResource{})
+	_ = resource.ResourceWithConfigValidators(&DeploymentAccessResource{})
+	_ = resource.ResourceWithImportState(&DeploymentAccessResource{})
+)
+
+type DeploymentAccessResource struct {
+	client api.PrefectClient
+}
+
+type DeploymentAccessResourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+	Role         types.String          `tfsdk:"role"`
+	ActorID      customtypes.UUIDValue `tfsdk:"actor_id"`
+	TeamID       customtypes.UUIDValue `tfsdk:"team_id"`
+
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+}
+
+// NewDeploymentAccessResource returns a new DeploymentAccessResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentAccessResource() resource.Resource {
+	return &DeploymentAccessResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DeploymentAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_access"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *DeploymentAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = objectAccessSchema("deployment_access", "Deployment", "deployment_id", schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Validators:  []validator.String{customvalidators.UUIDValidator{}},
+		Description: "ID (UUID) of the Deployment to grant access to",
+	})
+}
+
+// ConfigValidators returns the validators applied to the resource configuration.
+func (r *DeploymentAccessResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return objectAccessConfigValidators()
+}
+
+// grant returns the attributes of the model shared by the object access resources.
+func (model *DeploymentAccessResourceModel) grant() objectAccessGrant {
+	return objectAccessGrant{ID: &model.ID, Role: &model.Role, ActorID: &model.ActorID, TeamID: &model.TeamID}
+}
+
+// accessClient returns the client of the access control of the Deployment of the model.
+func (r *DeploymentAccessResource) accessClient(model *DeploymentAccessResourceModel) (objectAccessClient[uuid.UUID], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return objectAccessClient[uuid.UUID]{}, diags
+	}
+
+	return objectAccessClient[uuid.UUID]{
+		id:      model.DeploymentID.ValueUUID(),
+		lockKey: "deployment/" + model.DeploymentID.ValueString(),
+		get:     client.GetAccess,
+		set:     client.SetAccess,
+	}, diags
+}
+
+// Create grants the Deployment Access through the API and inserts it into the State.
+func (r *DeploymentAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeploymentAccessResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setObjectAccess(ctx, client, plan.grant()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Deployment", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DeploymentAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeploymentAccessResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	accessorID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment Access ID",
+			fmt.Sprintf("Could not parse Deployment Access ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	access, err := client.GetAccess(ctx, state.DeploymentID.ValueUUID())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Deployment", "read", err))
+
+		return
+	}
+
+	// The grant was revoked outside of Terraform
+	if !copyObjectAccessToModel(access, accessorID, state.grant()) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DeploymentAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DeploymentAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setObjectAccess(ctx, client, plan.grant()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Deployment", "update", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the grant and removes the Terraform state on success.
+func (r *DeploymentAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DeploymentAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := revokeObjectAccess(ctx, client, state.grant().accessorID()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Deployment", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// The import ID is a composite of the deployment ID and the ID of the actor
+// or team, in the form `deployment_id,id`.
+func (r *DeploymentAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/deployment_id/id"
+	// - "deployment_id,id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "deployment_id", "id") {
+		return
+	}
+
+	deploymentID, accessorID, found := strings.Cut(req.ID, ",")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: deployment_id,id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(deploymentID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, got: %s", deploymentID),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(accessorID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Deployment Access ID",
+			fmt.Sprintf("Could not parse Deployment Access ID to UUID, got: %s", accessorID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessorID)...)
+}
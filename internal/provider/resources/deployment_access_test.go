@@ -0,0 +1,116 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeploymentAccess(name string, flowID uuid.UUID, role string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_deployment" "deployment" {
+	name = "%s"
+	flow_id = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_service_account" "bot" {
+	name = "%s"
+}
+resource "prefect_deployment_access" "bot_access" {
+	deployment_id = prefect_deployment.deployment.id
+	workspace_id = data.prefect_workspace.evergreen.id
+	actor_id = prefect_service_account.bot.actor_id
+	role = "%s"
+}`, name, flowID, name, role)
+}
+
+func fixtureAccDeploymentAccessConcurrent(name string, flowID uuid.UUID) string {
+	return fixtureAccDeploymentAccess(name, flowID, "manage") + fmt.Sprintf(`
+resource "prefect_service_account" "other_bot" {
+	name = "%s-other"
+}
+resource "prefect_deployment_access" "other_bot_access" {
+	deployment_id = prefect_deployment.deployment.id
+	workspace_id = data.prefect_workspace.evergreen.id
+	actor_id = prefect_service_account.other_bot.actor_id
+	role = "view"
+}`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_access(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	accessResourceName := "prefect_deployment_access.bot_access"
+	botResourceName := "prefect_service_account.bot"
+	deploymentResourceName := "prefect_deployment.deployment"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	flowID := testAccCreateFlow(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the deployment access resource
+				Config: fixtureAccDeploymentAccess(randomName, flowID, "run"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "actor_id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "deployment_id", deploymentResourceName, "id"),
+					resource.TestCheckResourceAttr(accessResourceName, "role", "run"),
+				),
+			},
+			{
+				// Check updating the role of the deployment access resource in place
+				Config: fixtureAccDeploymentAccess(randomName, flowID, "manage"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttr(accessResourceName, "role", "manage"),
+				),
+			},
+			{
+				// Check that a second grant on the same deployment, applied in parallel,
+				// does not overwrite the first one. A lost grant is removed from the
+				// state on refresh, failing the empty plan check after apply.
+				Config: fixtureAccDeploymentAccessConcurrent(randomName, flowID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(accessResourceName, "role", "manage"),
+					resource.TestCheckResourceAttr("prefect_deployment_access.other_bot_access", "role", "view"),
+				),
+			},
+			// Import State checks - import by account_id/workspace_id/deployment_id/id
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getDeploymentAccessImportStateID(accessResourceName),
+				ResourceName:      accessResourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getDeploymentAccessImportStateID(accessResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		accessResource, exists := state.RootModule().Resources[accessResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", accessResourceName)
+		}
+
+		attributes := accessResource.Primary.Attributes
+
+		return fmt.Sprintf("%s/%s/%s/%s", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"), attributes["workspace_id"], attributes["deployment_id"], attributes["id"]), nil
+	}
+}
@@ -0,0 +1,496 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentScheduleResource{})
+	_ = resource.ResourceWithConfigValidators(&DeploymentScheduleResource{})
+	_ = resource.ResourceWithImportState(&DeploymentScheduleResource{})
+)
+
+// DeploymentScheduleResource contains state for the resource.
+type DeploymentScheduleResource struct {
+	client api.PrefectClient
+}
+
+// DeploymentScheduleResourceModel defines the Terraform resource model.
+type DeploymentScheduleResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	DeploymentID types.String               `tfsdk:"deployment_id"`
+	Cron         types.String               `tfsdk:"cron"`
+	Interval     types.Int64                `tfsdk:"interval"`
+	AnchorDate   customtypes.TimestampValue `tfsdk:"anchor_date"`
+	RRule        types.String               `tfsdk:"rrule"`
+	Timezone     types.String               `tfsdk:"timezone"`
+	Active       types.Bool                 `tfsdk:"active"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewDeploymentScheduleResource returns a new DeploymentScheduleResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentScheduleResource() resource.Resource {
+	return &DeploymentScheduleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DeploymentScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_schedule"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *DeploymentScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *DeploymentScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `deployment_schedule` represents one schedule of a Prefect Deployment. " +
+			"A deployment can have several schedules, each managed as its own resource. " +
+			"Exactly one of `cron`, `interval`, or `rrule` must be set.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Deployment Schedule ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				// See the note on the id attribute for why this is not a CustomType.
+				Description: "Deployment ID (UUID) the schedule belongs to",
+				Required:    true,
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron": schema.StringAttribute{
+				Description: "Cron expression of the schedule, such as `0 9 * * 1-5`",
+				Optional:    true,
+			},
+			"interval": schema.Int64Attribute{
+				Description: "Interval of the schedule, in seconds",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"anchor_date": schema.StringAttribute{
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp (RFC3339) the intervals of an `interval` schedule are counted from",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("interval")),
+				},
+			},
+			"rrule": schema.StringAttribute{
+				Description: "iCalendar recurrence rule (RFC 5545) of the schedule, such as `FREQ=WEEKLY;BYDAY=MO`",
+				Optional:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA timezone the schedule is evaluated in, such as `America/New_York`",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether this schedule creates flow runs",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// ConfigValidators returns the validators applied to the resource configuration.
+func (r *DeploymentScheduleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cron"),
+			path.MatchRoot("interval"),
+			path.MatchRoot("rrule"),
+		),
+	}
+}
+
+// scheduleFromModel returns the api.DeploymentScheduleUpsert
+// described by a DeploymentScheduleResourceModel.
+func scheduleFromModel(model *DeploymentScheduleResourceModel) api.DeploymentScheduleUpsert {
+	schedule := api.Schedule{
+		Cron:       model.Cron.ValueStringPointer(),
+		AnchorDate: model.AnchorDate.ValueTimePointer(),
+		RRule:      model.RRule.ValueStringPointer(),
+		Timezone:   model.Timezone.ValueStringPointer(),
+	}
+
+	if !model.Interval.IsNull() && !model.Interval.IsUnknown() {
+		interval := float64(model.Interval.ValueInt64())
+		schedule.Interval = &interval
+	}
+
+	return api.DeploymentScheduleUpsert{
+		Schedule: schedule,
+		Active:   model.Active.ValueBool(),
+	}
+}
+
+// copyDeploymentScheduleToModel copies an api.DeploymentSchedule to a DeploymentScheduleResourceModel.
+func copyDeploymentScheduleToModel(schedule *api.DeploymentSchedule, model *DeploymentScheduleResourceModel) {
+	model.ID = types.StringValue(schedule.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(schedule.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(schedule.Updated)
+
+	model.DeploymentID = types.StringValue(schedule.DeploymentID.String())
+	model.Cron = types.StringPointerValue(schedule.Schedule.Cron)
+	model.RRule = types.StringPointerValue(schedule.Schedule.RRule)
+	model.Timezone = types.StringPointerValue(schedule.Schedule.Timezone)
+	model.Active = types.BoolValue(schedule.Active)
+
+	model.Interval = types.Int64Null()
+	if schedule.Schedule.Interval != nil {
+		model.Interval = types.Int64Value(int64(*schedule.Schedule.Interval))
+	}
+
+	// The server defaults the anchor date of interval schedules,
+	// so we only track it when it is set in the configuration.
+	if !model.AnchorDate.IsNull() {
+		model.AnchorDate = customtypes.NewTimestampPointerValue(schedule.Schedule.AnchorDate)
+	}
+}
+
+// parseDeploymentScheduleIDs parses the deployment and schedule IDs of the model.
+func parseDeploymentScheduleIDs(model *DeploymentScheduleResourceModel) (uuid.UUID, uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deploymentID, err := uuid.Parse(model.DeploymentID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("deployment_id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	scheduleID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Deployment Schedule ID",
+			fmt.Sprintf("Could not parse deployment schedule ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return uuid.Nil, uuid.Nil, diags
+	}
+
+	return deploymentID, scheduleID, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model DeploymentScheduleResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment Schedule", "create", &resp.Diagnostics)
+	defer done()
+
+	deploymentID, err := uuid.Parse(model.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Error parsing Deployment ID",
+			fmt.Sprintf("Could not parse deployment ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	schedule, err := client.CreateSchedule(ctx, deploymentID, scheduleFromModel(&model))
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "create", err))
+
+		return
+	}
+
+	copyDeploymentScheduleToModel(schedule, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DeploymentScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model DeploymentScheduleResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment Schedule", "read", &resp.Diagnostics)
+	defer done()
+
+	deploymentID, scheduleID, diags := parseDeploymentScheduleIDs(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	schedule, err := client.GetSchedule(ctx, deploymentID, scheduleID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "get", err))
+
+		return
+	}
+
+	copyDeploymentScheduleToModel(schedule, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DeploymentScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model DeploymentScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment Schedule", "update", &resp.Diagnostics)
+	defer done()
+
+	deploymentID, scheduleID, diags := parseDeploymentScheduleIDs(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	err = client.UpdateSchedule(ctx, deploymentID, scheduleID, scheduleFromModel(&model))
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "update", err))
+
+		return
+	}
+
+	schedule, err := client.GetSchedule(ctx, deploymentID, scheduleID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "get", err))
+
+		return
+	}
+
+	copyDeploymentScheduleToModel(schedule, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DeploymentScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model DeploymentScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Deployment Schedule", "delete", &resp.Diagnostics)
+	defer done()
+
+	deploymentID, scheduleID, diags := parseDeploymentScheduleIDs(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	err = client.DeleteSchedule(ctx, deploymentID, scheduleID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedule", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *DeploymentScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/deployment_id/id"
+	// - "workspace_id,deployment_id/id"
+	// - "deployment_id/id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "deployment_id", "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,deployment_id/id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,deployment_id/id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	deploymentID, id, found := strings.Cut(identifier, "/")
+	if !found || deploymentID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the form of `account_id/workspace_id/deployment_id/id` or `deployment_id/id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,120 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeploymentSchedules(name string, flowID uuid.UUID, cron string, active bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_deployment" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	flow_id = "%s"
+}
+resource "prefect_deployment_schedule" "cron" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	deployment_id = prefect_deployment.test.id
+	cron = "%s"
+	timezone = "America/New_York"
+	active = %t
+}
+resource "prefect_deployment_schedule" "interval" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	deployment_id = prefect_deployment.test.id
+	interval = 3600
+	anchor_date = "2024-01-01T00:00:00Z"
+}
+	`, name, flowID, cron, active)
+}
+
+func fixtureAccDeploymentScheduleConflicting() string {
+	return `
+resource "prefect_deployment_schedule" "test" {
+	deployment_id = "00000000-0000-0000-0000-000000000000"
+	cron = "0 9 * * *"
+	interval = 3600
+}
+	`
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_schedule(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	cronResourceName := "prefect_deployment_schedule.cron"
+	intervalResourceName := "prefect_deployment_schedule.interval"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	flowID := testAccCreateFlow(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that setting more than one schedule type is rejected at plan time
+				Config:      fixtureAccDeploymentScheduleConflicting(),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				// Check creation of several schedules on the same deployment
+				Config: fixtureAccDeploymentSchedules(randomName, flowID, "0 9 * * *", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cronResourceName, "cron", "0 9 * * *"),
+					resource.TestCheckResourceAttr(cronResourceName, "timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(cronResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(intervalResourceName, "interval", "3600"),
+					resource.TestCheckResourceAttrPair(cronResourceName, "deployment_id", intervalResourceName, "deployment_id"),
+				),
+			},
+			{
+				// Check updating a schedule in place
+				Config: fixtureAccDeploymentSchedules(randomName, flowID, "30 17 * * 1-5", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cronResourceName, "cron", "30 17 * * 1-5"),
+					resource.TestCheckResourceAttr(cronResourceName, "active", "false"),
+				),
+			},
+			// Import State checks - import by workspace_id,deployment_id/id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      cronResourceName,
+				ImportStateIdFunc: getDeploymentScheduleImportStateID(cronResourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getDeploymentScheduleImportStateID(scheduleResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		scheduleResource, exists := state.RootModule().Resources[scheduleResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", scheduleResourceName)
+		}
+		deploymentID := scheduleResource.Primary.Attributes["deployment_id"]
+
+		return fmt.Sprintf("%s,%s/%s", workspaceID, deploymentID, scheduleResource.Primary.ID), nil
+	}
+}
@@ -0,0 +1,243 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccDeploymentResource(name string, flowID uuid.UUID, parameters string, paused bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_deployment" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	flow_id = "%s"
+	parameters = jsonencode(%s)
+	tags = ["foo", "bar"]
+	paused = %t
+}
+	`, name, flowID, parameters, paused)
+}
+
+func fixtureAccDeploymentResourceWorkPool(name string, flowID uuid.UUID) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "pool" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	type = "kubernetes"
+}
+resource "prefect_deployment" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	flow_id = "%s"
+	work_pool_name = prefect_work_pool.pool.name
+	parameters = jsonencode({ name = "terraform" })
+	tags = ["foo", "bar"]
+	paused = true
+}
+	`, name, name, flowID)
+}
+
+// testAccCreateFlow registers a flow in the evergreen workspace,
+// as there is no flow resource to create one with.
+func testAccCreateFlow(t *testing.T) uuid.UUID {
+	t.Helper()
+
+	c, _ := testutils.NewTestClient()
+	workspacesClient, _ := c.Workspaces(uuid.Nil)
+	workspace, err := workspacesClient.GetByHandle(context.Background(), "github-ci-tests")
+	if err != nil {
+		t.Fatalf("Error fetching workspace: %s", err)
+	}
+
+	flowsClient, _ := c.Flows(uuid.Nil, workspace.ID)
+	flow, err := flowsClient.Create(context.Background(), api.FlowCreate{
+		Name: testutils.TestAccPrefix + "deployments",
+	})
+	if err != nil {
+		t.Fatalf("Error creating flow: %s", err)
+	}
+
+	return flow.ID
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	resourceName := "prefect_deployment.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	flowID := testAccCreateFlow(t)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var deployment api.Deployment
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the deployment resource
+				Config: fixtureAccDeploymentResource(randomName, flowID, `{ name = "world", retries = 3 }`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, workspaceDatasourceName, &deployment),
+					testAccCheckDeploymentValues(&deployment, &api.Deployment{Name: randomName, FlowID: flowID, Paused: false}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "flow_id", flowID.String()),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
+				),
+			},
+			{
+				// Check that semantically equal parameters do not produce a diff
+				Config:   fixtureAccDeploymentResource(randomName, flowID, `{ retries = 3, name = "world" }`, false),
+				PlanOnly: true,
+			},
+			{
+				// Check pausing + updating the parameters in place
+				Config: fixtureAccDeploymentResource(randomName, flowID, `{ name = "terraform" }`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentIDAreEqual(resourceName, &deployment),
+					testAccCheckDeploymentExists(resourceName, workspaceDatasourceName, &deployment),
+					testAccCheckDeploymentValues(&deployment, &api.Deployment{Name: randomName, FlowID: flowID, Paused: true}),
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+				),
+			},
+			{
+				// Check assigning a work pool, which defaults to its default queue
+				Config: fixtureAccDeploymentResourceWorkPool(randomName, flowID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentIDAreEqual(resourceName, &deployment),
+					resource.TestCheckResourceAttrPair(resourceName, "work_pool_name", "prefect_work_pool.pool", "name"),
+					resource.TestCheckResourceAttr(resourceName, "work_queue_name", "default"),
+				),
+			},
+			{
+				// Check that removing the work pool from the configuration clears it
+				Config: fixtureAccDeploymentResource(randomName, flowID, `{ name = "terraform" }`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentIDAreEqual(resourceName, &deployment),
+					testAccCheckDeploymentExists(resourceName, workspaceDatasourceName, &deployment),
+					testAccCheckDeploymentWorkPoolCleared(&deployment),
+					resource.TestCheckNoResourceAttr(resourceName, "work_pool_name"),
+					resource.TestCheckNoResourceAttr(resourceName, "work_queue_name"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getDeploymentImportStateID(resourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentWorkPoolCleared(deployment *api.Deployment) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if deployment.WorkPoolName != nil {
+			return fmt.Errorf("Expected work pool to be cleared, got %s", *deployment.WorkPoolName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(deploymentResourceName string, workspaceDatasourceName string, deployment *api.Deployment) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		deploymentResource, exists := state.RootModule().Resources[deploymentResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", deploymentResourceName)
+		}
+		deploymentID, _ := uuid.Parse(deploymentResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		deploymentsClient, _ := c.Deployments(uuid.Nil, workspaceID)
+
+		fetchedDeployment, err := deploymentsClient.Get(context.Background(), deploymentID)
+		if err != nil {
+			return fmt.Errorf("Error fetching deployment: %w", err)
+		}
+
+		*deployment = *fetchedDeployment
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentValues(fetchedDeployment *api.Deployment, valuesToCheck *api.Deployment) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedDeployment.Name != valuesToCheck.Name {
+			return fmt.Errorf("Expected deployment name to be %s, got %s", valuesToCheck.Name, fetchedDeployment.Name)
+		}
+		if fetchedDeployment.FlowID != valuesToCheck.FlowID {
+			return fmt.Errorf("Expected deployment flow ID to be %s, got %s", valuesToCheck.FlowID, fetchedDeployment.FlowID)
+		}
+		if fetchedDeployment.Paused != valuesToCheck.Paused {
+			return fmt.Errorf("Expected deployment paused to be %t, got %t", valuesToCheck.Paused, fetchedDeployment.Paused)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentIDAreEqual(resourceName string, fetchedDeployment *api.Deployment) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		deploymentResource, exists := state.RootModule().Resources[resourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", resourceName)
+		}
+
+		id := fetchedDeployment.ID.String()
+
+		if deploymentResource.Primary.ID != id {
+			return fmt.Errorf("Expected %s and %s to be equal", deploymentResource.Primary.ID, id)
+		}
+
+		return nil
+	}
+}
+
+func getDeploymentImportStateID(deploymentResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		deploymentResource, exists := state.RootModule().Resources[deploymentResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", deploymentResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, deploymentResource.Primary.ID), nil
+	}
+}
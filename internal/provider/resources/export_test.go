@@ -0,0 +1,10 @@
+package resources
+
+// ReconcileTeamMembers exposes reconcileTeamMembers to the resources_test package.
+var ReconcileTeamMembers = reconcileTeamMembers
+
+// WorkspaceUpdatePayload exposes workspaceUpdatePayload to the resources_test package.
+var WorkspaceUpdatePayload = workspaceUpdatePayload
+
+// ReconcileVariables exposes reconcileVariables to the resources_test package.
+var ReconcileVariables = reconcileVariables
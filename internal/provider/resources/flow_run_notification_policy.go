@@ -0,0 +1,483 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&FlowRunNotificationPolicyResource{})
+	_ = resource.ResourceWithImportState(&FlowRunNotificationPolicyResource{})
+)
+
+// FlowRunNotificationPolicyResource contains state for the resource.
+type FlowRunNotificationPolicyResource struct {
+	client api.PrefectClient
+}
+
+// FlowRunNotificationPolicyResourceModel defines the Terraform resource model.
+type FlowRunNotificationPolicyResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	StateNames      types.Set             `tfsdk:"state_names"`
+	Tags            types.Set             `tfsdk:"tags"`
+	MessageTemplate types.String          `tfsdk:"message_template"`
+	BlockDocumentID customtypes.UUIDValue `tfsdk:"block_document_id"`
+	IsActive        types.Bool            `tfsdk:"is_active"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewFlowRunNotificationPolicyResource returns a new FlowRunNotificationPolicyResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewFlowRunNotificationPolicyResource() resource.Resource {
+	return &FlowRunNotificationPolicyResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *FlowRunNotificationPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_run_notification_policy"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *FlowRunNotificationPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *FlowRunNotificationPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultEmptyTagSet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		Description: "The resource `flow_run_notification_policy` represents a Prefect Flow Run Notification Policy. " +
+			"Notification policies send a message through a notification block whenever a flow run " +
+			"with matching tags enters one of the configured states.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Flow run notification policy ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"state_names": schema.SetAttribute{
+				Description: "Names of the flow run states that trigger a notification (e.g. `Failed`, `Crashed`)",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags a flow run must have to trigger a notification. An empty set matches every flow run.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(defaultEmptyTagSet),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+				},
+			},
+			"message_template": schema.StringAttribute{
+				Description: "Template for the notification message. Uses the server's default message when unset.",
+				Optional:    true,
+			},
+			"block_document_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the notification block used to send the message",
+				Required:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the policy is active",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyFlowRunNotificationPolicyToModel copies an api.FlowRunNotificationPolicy to a FlowRunNotificationPolicyResourceModel.
+func copyFlowRunNotificationPolicyToModel(ctx context.Context, policy *api.FlowRunNotificationPolicy, model *FlowRunNotificationPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(policy.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(policy.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(policy.Updated)
+
+	model.MessageTemplate = types.StringPointerValue(policy.MessageTemplate)
+	model.BlockDocumentID = customtypes.NewUUIDValue(policy.BlockDocumentID)
+	model.IsActive = types.BoolValue(policy.IsActive)
+
+	stateNames, setDiags := types.SetValueFrom(ctx, types.StringType, policy.StateNames)
+	diags.Append(setDiags...)
+	model.StateNames = stateNames
+
+	tags, setDiags := types.SetValueFrom(ctx, types.StringType, policy.Tags)
+	diags.Append(setDiags...)
+	model.Tags = tags
+
+	return diags
+}
+
+// stringSetElements returns the elements of a string set.
+// A null set yields an empty slice, so that the server clears the field.
+func stringSetElements(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	values := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+
+	diags := set.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// parseFlowRunNotificationPolicyID parses the policy ID from the model.
+func parseFlowRunNotificationPolicyID(model *FlowRunNotificationPolicyResourceModel) (uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policyID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Flow Run Notification Policy ID",
+			fmt.Sprintf("Could not parse flow run notification policy ID to UUID, unexpected error: %s", err.Error()),
+		)
+	}
+
+	return policyID, diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FlowRunNotificationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model FlowRunNotificationPolicyResourceModel
+
+	// Populate the model from the plan, so that defaults are applied, and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Flow Run Notification Policy", "create", &resp.Diagnostics)
+	defer done()
+
+	stateNames, diags := stringSetElements(ctx, model.StateNames)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := stringSetElements(ctx, model.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policy, err := client.Create(ctx, api.FlowRunNotificationPolicyCreate{
+		IsActive:        model.IsActive.ValueBool(),
+		StateNames:      stateNames,
+		Tags:            tags,
+		BlockDocumentID: model.BlockDocumentID.ValueUUID(),
+		MessageTemplate: model.MessageTemplate.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *FlowRunNotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model FlowRunNotificationPolicyResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Flow Run Notification Policy", "read", &resp.Diagnostics)
+	defer done()
+
+	policyID, diags := parseFlowRunNotificationPolicyID(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	policy, err := client.Get(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *FlowRunNotificationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model FlowRunNotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Flow Run Notification Policy", "update", &resp.Diagnostics)
+	defer done()
+
+	policyID, diags := parseFlowRunNotificationPolicyID(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The full sets are always sent, so that state names and tags
+	// removed from the configuration are also removed on the server.
+	stateNames, diags := stringSetElements(ctx, model.StateNames)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := stringSetElements(ctx, model.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	err = client.Update(ctx, policyID, api.FlowRunNotificationPolicyUpdate{
+		IsActive:        model.IsActive.ValueBool(),
+		StateNames:      stateNames,
+		Tags:            tags,
+		BlockDocumentID: model.BlockDocumentID.ValueUUID(),
+		MessageTemplate: model.MessageTemplate.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "update", err))
+
+		return
+	}
+
+	policy, err := client.Get(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyFlowRunNotificationPolicyToModel(ctx, policy, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FlowRunNotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model FlowRunNotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Flow Run Notification Policy", "delete", &resp.Diagnostics)
+	defer done()
+
+	policyID, diags := parseFlowRunNotificationPolicyID(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.FlowRunNotificationPolicies(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run Notification Policy", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run Notification Policy", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *FlowRunNotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	// - "id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if _, err := uuid.Parse(identifier); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Flow Run Notification Policy ID",
+			fmt.Sprintf("Could not parse flow run notification policy ID to UUID, expected a policy UUID, got: %s", identifier),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+}
@@ -0,0 +1,168 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccFlowRunNotificationPolicyResource(blockName string, stateNames string, tags string, isActive bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_block_document" "notifier" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	block_type_slug = "slack-webhook"
+	data = jsonencode({
+		url = "https://hooks.slack.com/services/test"
+	})
+}
+resource "prefect_flow_run_notification_policy" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	block_document_id = prefect_block_document.notifier.id
+	state_names = %s
+	tags = %s
+	is_active = %t
+	message_template = "Flow run {flow_run_name} entered state {flow_run_state_name}"
+}
+	`, blockName, stateNames, tags, isActive)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_flow_run_notification_policy(t *testing.T) {
+	resourceName := "prefect_flow_run_notification_policy.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var policy api.FlowRunNotificationPolicy
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the policy resource
+				Config: fixtureAccFlowRunNotificationPolicyResource(randomName, `["Failed", "Crashed"]`, `["prod"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowRunNotificationPolicyExists(resourceName, workspaceDatasourceName, &policy),
+					testAccCheckFlowRunNotificationPolicyValues(&policy, []string{"Crashed", "Failed"}, []string{"prod"}, true),
+					resource.TestCheckResourceAttr(resourceName, "state_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "state_names.*", "Failed"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "state_names.*", "Crashed"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "block_document_id", "prefect_block_document.notifier", "id"),
+				),
+			},
+			{
+				// Check that removed state names and tags are removed on the server
+				Config: fixtureAccFlowRunNotificationPolicyResource(randomName, `["Failed"]`, `[]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowRunNotificationPolicyExists(resourceName, workspaceDatasourceName, &policy),
+					testAccCheckFlowRunNotificationPolicyValues(&policy, []string{"Failed"}, []string{}, false),
+					resource.TestCheckResourceAttr(resourceName, "state_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "false"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccFlowRunNotificationPolicyImportStateIDFunc(resourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowRunNotificationPolicyExists(policyResourceName string, workspaceDatasourceName string, policy *api.FlowRunNotificationPolicy) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policyResource, exists := state.RootModule().Resources[policyResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", policyResourceName)
+		}
+		policyID, _ := uuid.Parse(policyResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		policiesClient, _ := c.FlowRunNotificationPolicies(uuid.Nil, workspaceID)
+
+		fetchedPolicy, err := policiesClient.Get(context.Background(), policyID)
+		if err != nil {
+			return fmt.Errorf("Error fetching flow run notification policy: %w", err)
+		}
+
+		*policy = *fetchedPolicy
+
+		return nil
+	}
+}
+
+func testAccCheckFlowRunNotificationPolicyValues(fetchedPolicy *api.FlowRunNotificationPolicy, stateNames []string, tags []string, isActive bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if !sameStringSet(fetchedPolicy.StateNames, stateNames) {
+			return fmt.Errorf("Expected state names to be %v, got %v", stateNames, fetchedPolicy.StateNames)
+		}
+		if !sameStringSet(fetchedPolicy.Tags, tags) {
+			return fmt.Errorf("Expected tags to be %v, got %v", tags, fetchedPolicy.Tags)
+		}
+		if fetchedPolicy.IsActive != isActive {
+			return fmt.Errorf("Expected is_active to be %t, got %t", isActive, fetchedPolicy.IsActive)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowRunNotificationPolicyImportStateIDFunc(policyResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		policyResource, exists := state.RootModule().Resources[policyResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", policyResourceName)
+		}
+
+		workspaceDatasource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceDatasource.Primary.ID, policyResource.Primary.ID), nil
+	}
+}
+
+func sameStringSet(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	seen := make(map[string]bool, len(a))
+	for _, value := range a {
+		seen[value] = true
+	}
+	for _, value := range b {
+		if !seen[value] {
+			return false
+		}
+	}
+
+	return true
+}
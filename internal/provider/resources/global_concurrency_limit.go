@@ -0,0 +1,399 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&GlobalConcurrencyLimitResource{})
+	_ = resource.ResourceWithImportState(&GlobalConcurrencyLimitResource{})
+)
+
+// GlobalConcurrencyLimitResource contains state for the resource.
+type GlobalConcurrencyLimitResource struct {
+	client api.PrefectClient
+}
+
+// GlobalConcurrencyLimitResourceModel defines the Terraform resource model.
+type GlobalConcurrencyLimitResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name               types.String  `tfsdk:"name"`
+	Limit              types.Int64   `tfsdk:"limit"`
+	Active             types.Bool    `tfsdk:"active"`
+	ActiveSlots        types.Int64   `tfsdk:"active_slots"`
+	SlotDecayPerSecond types.Float64 `tfsdk:"slot_decay_per_second"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewGlobalConcurrencyLimitResource returns a new GlobalConcurrencyLimitResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewGlobalConcurrencyLimitResource() resource.Resource {
+	return &GlobalConcurrencyLimitResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *GlobalConcurrencyLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_concurrency_limit"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *GlobalConcurrencyLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *GlobalConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `global_concurrency_limit` represents a named Prefect Global Concurrency Limit. " +
+			"Global concurrency limits cap the number of slots that can be occupied at the same time, " +
+			"and can be used to throttle work across all flows, such as calls to a rate-limited external API.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Global Concurrency Limit ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the global concurrency limit",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of slots that can be occupied at the same time",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the global concurrency limit is enforced",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"active_slots": schema.Int64Attribute{
+				Description: "Number of slots currently occupied",
+				Computed:    true,
+			},
+			"slot_decay_per_second": schema.Float64Attribute{
+				Description: "Rate at which occupied slots are released, in slots per second. " +
+					"Set this to use the limit as a rate limit rather than a concurrency limit",
+				Optional: true,
+				Computed: true,
+				Default:  float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyGlobalConcurrencyLimitToModel copies an api.GlobalConcurrencyLimit to a GlobalConcurrencyLimitResourceModel.
+func copyGlobalConcurrencyLimitToModel(limit *api.GlobalConcurrencyLimit, model *GlobalConcurrencyLimitResourceModel) {
+	model.ID = types.StringValue(limit.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(limit.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(limit.Updated)
+
+	model.Name = types.StringValue(limit.Name)
+	model.Limit = types.Int64Value(limit.Limit)
+	model.Active = types.BoolValue(limit.Active)
+	model.ActiveSlots = types.Int64Value(limit.ActiveSlots)
+	model.SlotDecayPerSecond = types.Float64Value(limit.SlotDecayPerSecond)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model GlobalConcurrencyLimitResourceModel
+
+	// Populate the model from the resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Global Concurrency Limit", "create", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.GlobalConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	limit, err := client.Create(ctx, api.GlobalConcurrencyLimitCreate{
+		Name:               model.Name.ValueString(),
+		Limit:              model.Limit.ValueInt64(),
+		Active:             model.Active.ValueBool(),
+		SlotDecayPerSecond: model.SlotDecayPerSecond.ValueFloat64(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "create", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model GlobalConcurrencyLimitResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Global Concurrency Limit", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.GlobalConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	// Always prefer to refresh state using the ID, if it is set.
+	//
+	// If we are importing by name, then we will need to load once using the name.
+	var idOrName string
+
+	switch {
+	case !model.ID.IsNull():
+		idOrName = model.ID.ValueString()
+	case !model.Name.IsNull():
+		idOrName = model.Name.ValueString()
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Name are unset",
+			"This is a bug in the Terraform provider. Please report it to the maintainers.",
+		)
+
+		return
+	}
+
+	limit, err := client.Get(ctx, idOrName)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "get", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *GlobalConcurrencyLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model GlobalConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Global Concurrency Limit", "update", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.GlobalConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	err = client.Update(ctx, model.ID.ValueString(), api.GlobalConcurrencyLimitUpdate{
+		Name:               model.Name.ValueStringPointer(),
+		Limit:              model.Limit.ValueInt64Pointer(),
+		Active:             model.Active.ValueBoolPointer(),
+		SlotDecayPerSecond: model.SlotDecayPerSecond.ValueFloat64Pointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "update", err))
+
+		return
+	}
+
+	limit, err := client.Get(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "get", err))
+
+		return
+	}
+
+	copyGlobalConcurrencyLimitToModel(limit, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *GlobalConcurrencyLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model GlobalConcurrencyLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Global Concurrency Limit", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.GlobalConcurrencyLimits(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Global Concurrency Limit", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *GlobalConcurrencyLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id" or "workspace_id,name"
+	// - "id" or "name"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id` or `workspace_id,name`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id` or `workspace_id,name`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	// Anything that is not a UUID is treated as the name of the limit.
+	if _, err := uuid.Parse(identifier); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identifier)...)
+	}
+}
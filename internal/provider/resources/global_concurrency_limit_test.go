@@ -0,0 +1,170 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccGlobalConcurrencyLimitResource(name string, limit int64, active bool, slotDecayPerSecond float64) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_global_concurrency_limit" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	limit = %d
+	active = %t
+	slot_decay_per_second = %g
+}
+	`, name, limit, active, slotDecayPerSecond)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_global_concurrency_limit(t *testing.T) {
+	resourceName := "prefect_global_concurrency_limit.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomName2 := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var limit api.GlobalConcurrencyLimit
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the global concurrency limit resource
+				Config: fixtureAccGlobalConcurrencyLimitResource(randomName, 5, true, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalConcurrencyLimitExists(resourceName, workspaceDatasourceName, &limit),
+					testAccCheckGlobalConcurrencyLimitValues(&limit, &api.GlobalConcurrencyLimit{Name: randomName, Limit: 5, Active: true}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "limit", "5"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "slot_decay_per_second", "0"),
+					resource.TestCheckResourceAttr(resourceName, "active_slots", "0"),
+				),
+			},
+			{
+				// Check that the global concurrency limit is updated in place
+				Config: fixtureAccGlobalConcurrencyLimitResource(randomName2, 10, false, 1.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalConcurrencyLimitIDAreEqual(resourceName, &limit),
+					testAccCheckGlobalConcurrencyLimitExists(resourceName, workspaceDatasourceName, &limit),
+					testAccCheckGlobalConcurrencyLimitValues(&limit, &api.GlobalConcurrencyLimit{Name: randomName2, Limit: 10, Active: false, SlotDecayPerSecond: 1.5}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName2),
+					resource.TestCheckResourceAttr(resourceName, "limit", "10"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "slot_decay_per_second", "1.5"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getGlobalConcurrencyLimitImportStateID(resourceName, workspaceDatasourceName, "id"),
+				ImportStateVerify: true,
+			},
+			// Import State checks - import by workspace_id,name (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getGlobalConcurrencyLimitImportStateID(resourceName, workspaceDatasourceName, "name"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalConcurrencyLimitExists(limitResourceName string, workspaceDatasourceName string, limit *api.GlobalConcurrencyLimit) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		limitResource, exists := state.RootModule().Resources[limitResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", limitResourceName)
+		}
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		limitsClient, _ := c.GlobalConcurrencyLimits(uuid.Nil, workspaceID)
+
+		fetchedLimit, err := limitsClient.Get(context.Background(), limitResource.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error fetching global concurrency limit: %w", err)
+		}
+
+		*limit = *fetchedLimit
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalConcurrencyLimitIDAreEqual(resourceName string, fetchedLimit *api.GlobalConcurrencyLimit) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		limitResource, exists := state.RootModule().Resources[resourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", resourceName)
+		}
+
+		id := fetchedLimit.ID.String()
+
+		if limitResource.Primary.ID != id {
+			return fmt.Errorf("Expected %s and %s to be equal", limitResource.Primary.ID, id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalConcurrencyLimitValues(fetchedLimit *api.GlobalConcurrencyLimit, valuesToCheck *api.GlobalConcurrencyLimit) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedLimit.Name != valuesToCheck.Name {
+			return fmt.Errorf("Expected global concurrency limit name to be %s, got %s", valuesToCheck.Name, fetchedLimit.Name)
+		}
+		if fetchedLimit.Limit != valuesToCheck.Limit {
+			return fmt.Errorf("Expected global concurrency limit to be %d, got %d", valuesToCheck.Limit, fetchedLimit.Limit)
+		}
+		if fetchedLimit.Active != valuesToCheck.Active {
+			return fmt.Errorf("Expected global concurrency limit active to be %t, got %t", valuesToCheck.Active, fetchedLimit.Active)
+		}
+		if fetchedLimit.SlotDecayPerSecond != valuesToCheck.SlotDecayPerSecond {
+			return fmt.Errorf("Expected global concurrency limit slot decay to be %g, got %g", valuesToCheck.SlotDecayPerSecond, fetchedLimit.SlotDecayPerSecond)
+		}
+
+		return nil
+	}
+}
+
+func getGlobalConcurrencyLimitImportStateID(limitResourceName string, workspaceDatasourceName string, attribute string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		limitResource, exists := state.RootModule().Resources[limitResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", limitResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, limitResource.Primary.Attributes[attribute]), nil
+	}
+}
@@ -0,0 +1,298 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// Roles that can be granted on an object with its own access control,
+// such as a Work Pool or a Deployment.
+const (
+	objectAccessRoleManage = "manage"
+	objectAccessRoleRun    = "run"
+	objectAccessRoleView   = "view"
+)
+
+// objectAccessLocks serializes the changes to the access control of an
+// object, shared by every instance of the resources granting access to it.
+// The access control is replaced as a whole, so concurrent grants on the
+// same object would otherwise overwrite each other.
+var objectAccessLocks helpers.KeyedMutex
+
+// objectAccessGrant points to the attributes shared by the models
+// of the resources granting a role on an object.
+type objectAccessGrant struct {
+	ID      *types.String
+	Role    *types.String
+	ActorID *customtypes.UUIDValue
+	TeamID  *customtypes.UUIDValue
+}
+
+// accessorID returns the ID of the actor or team of the grant.
+func (grant objectAccessGrant) accessorID() uuid.UUID {
+	if !grant.TeamID.IsNull() {
+		return grant.TeamID.ValueUUID()
+	}
+
+	return grant.ActorID.ValueUUID()
+}
+
+// objectAccessClient reads and replaces the access control of the object
+// identified by id, such as the name of a Work Pool or the ID of a Deployment.
+type objectAccessClient[K any] struct {
+	id K
+
+	// lockKey identifies the object among those whose access
+	// control changes are serialized by objectAccessLocks.
+	lockKey string
+
+	get func(ctx context.Context, id K) (*api.ObjectAccess, error)
+	set func(ctx context.Context, id K, data api.ObjectAccessControl) error
+}
+
+// objectAccessSchema returns the schema of a resource granting a role on
+// an object, identified by objectAttributeName.
+func objectAccessSchema(resourceName string, objectName string, objectAttributeName string, objectAttribute schema.StringAttribute) schema.Schema {
+	objectAttribute.Required = true
+	objectAttribute.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	return schema.Schema{
+		Description: fmt.Sprintf("The resource `%s` grants an actor (User or Service Account) or a Team ", resourceName) +
+			fmt.Sprintf("a role on a specific %s.\n", objectName) +
+			"\n" +
+			"The grantee is set with exactly one of `actor_id` or `team_id`. Changing the grantee or the " +
+			fmt.Sprintf("%s replaces the grant, while changing the `role` updates it in place.\n", objectName) +
+			"\n" +
+			fmt.Sprintf("%s-level access control is only available on some Prefect Cloud tiers.", objectName),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("%s Access ID, which is the ID (UUID) of the actor or team", objectName),
+				// attributes which are not configurable + should not show updates from the existing state value
+				// should implement `UseStateForUnknown()`
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			objectAttributeName: objectAttribute,
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Role to grant on the %s: manage | run | view", objectName),
+				Validators: []validator.String{
+					stringvalidator.OneOf(objectAccessRoleManage, objectAccessRoleRun, objectAccessRoleView),
+				},
+			},
+			"actor_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Actor ID (UUID) to grant access to. This corresponds to an `account_member.actor_id` or `service_account.actor_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the Team to grant access to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: fmt.Sprintf("Account ID (UUID) where the %s is located", objectName),
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: fmt.Sprintf("Workspace ID (UUID) where the %s is located, defaults to the workspace set in the provider", objectName),
+			},
+		},
+	}
+}
+
+// objectAccessConfigValidators returns the validators applied to the
+// configuration of a resource granting a role on an object.
+func objectAccessConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("actor_id"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
+// objectAccessErrorDiagnostic returns a diagnostic for an error returned
+// by the access control API of an object.
+func objectAccessErrorDiagnostic(objectName string, operation string, err error) diag.Diagnostic {
+	if errors.Is(err, api.ErrUnsupported) {
+		return diag.NewErrorDiagnostic(
+			fmt.Sprintf("%s Access is not available", objectName),
+			fmt.Sprintf("Access control on individual %ss is only offered on some Prefect Cloud tiers. ", objectName)+
+				"Check the plan of your account.\n\n"+
+				fmt.Sprintf("Error: %s", err.Error()),
+		)
+	}
+
+	return helpers.ResourceClientErrorDiagnostic(objectName+" Access", operation, err)
+}
+
+// objectAccessControl converts the access of an object into the
+// access control payload, leaving out the given accessor.
+func objectAccessControl(access *api.ObjectAccess, accessorID uuid.UUID) api.ObjectAccessControl {
+	control := api.ObjectAccessControl{
+		ManageActorIDs: []uuid.UUID{},
+		RunActorIDs:    []uuid.UUID{},
+		ViewActorIDs:   []uuid.UUID{},
+		ManageTeamIDs:  []uuid.UUID{},
+		RunTeamIDs:     []uuid.UUID{},
+		ViewTeamIDs:    []uuid.UUID{},
+	}
+
+	split := func(actors []api.ObjectAccessActor, actorIDs *[]uuid.UUID, teamIDs *[]uuid.UUID) {
+		for _, actor := range actors {
+			switch {
+			case actor.ID == accessorID:
+				continue
+			case actor.Type == api.ObjectAccessActorTypeTeam:
+				*teamIDs = append(*teamIDs, actor.ID)
+			default:
+				*actorIDs = append(*actorIDs, actor.ID)
+			}
+		}
+	}
+
+	split(access.ManageActors, &control.ManageActorIDs, &control.ManageTeamIDs)
+	split(access.RunActors, &control.RunActorIDs, &control.RunTeamIDs)
+	split(access.ViewActors, &control.ViewActorIDs, &control.ViewTeamIDs)
+
+	return control
+}
+
+// grantObjectAccess adds the accessor of the grant to the access control
+// under the role of the grant.
+func grantObjectAccess(control *api.ObjectAccessControl, grant objectAccessGrant) {
+	var actorIDs, teamIDs *[]uuid.UUID
+	switch grant.Role.ValueString() {
+	case objectAccessRoleManage:
+		actorIDs, teamIDs = &control.ManageActorIDs, &control.ManageTeamIDs
+	case objectAccessRoleRun:
+		actorIDs, teamIDs = &control.RunActorIDs, &control.RunTeamIDs
+	default:
+		actorIDs, teamIDs = &control.ViewActorIDs, &control.ViewTeamIDs
+	}
+
+	if !grant.TeamID.IsNull() {
+		*teamIDs = append(*teamIDs, grant.TeamID.ValueUUID())
+	} else {
+		*actorIDs = append(*actorIDs, grant.ActorID.ValueUUID())
+	}
+}
+
+// copyObjectAccessToModel copies the role granted to the accessor to the
+// grant, returning false if the accessor has no access to the object.
+func copyObjectAccessToModel(access *api.ObjectAccess, accessorID uuid.UUID, grant objectAccessGrant) bool {
+	roles := []struct {
+		role   string
+		actors []api.ObjectAccessActor
+	}{
+		{objectAccessRoleManage, access.ManageActors},
+		{objectAccessRoleRun, access.RunActors},
+		{objectAccessRoleView, access.ViewActors},
+	}
+
+	for _, granted := range roles {
+		for _, actor := range granted.actors {
+			if actor.ID != accessorID {
+				continue
+			}
+
+			*grant.ID = types.StringValue(actor.ID.String())
+			*grant.Role = types.StringValue(granted.role)
+
+			if actor.Type == api.ObjectAccessActorTypeTeam {
+				*grant.TeamID = customtypes.NewUUIDValue(actor.ID)
+				*grant.ActorID = customtypes.NewUUIDNull()
+			} else {
+				*grant.ActorID = customtypes.NewUUIDValue(actor.ID)
+				*grant.TeamID = customtypes.NewUUIDNull()
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// setObjectAccess grants the role of the grant to its accessor, replacing
+// any role previously granted to it on the object.
+func setObjectAccess[K any](ctx context.Context, client objectAccessClient[K], grant objectAccessGrant) error {
+	unlock := objectAccessLocks.Lock(client.lockKey)
+	defer unlock()
+
+	access, err := client.get(ctx, client.id)
+	if err != nil {
+		return err
+	}
+
+	control := objectAccessControl(access, grant.accessorID())
+	grantObjectAccess(&control, grant)
+
+	if err := client.set(ctx, client.id, control); err != nil {
+		return err
+	}
+
+	*grant.ID = types.StringValue(grant.accessorID().String())
+
+	return nil
+}
+
+// revokeObjectAccess revokes the role granted to the accessor on the object.
+// Revoking access to an object that no longer exists is not an error.
+func revokeObjectAccess[K any](ctx context.Context, client objectAccessClient[K], accessorID uuid.UUID) error {
+	unlock := objectAccessLocks.Lock(client.lockKey)
+	defer unlock()
+
+	access, err := client.get(ctx, client.id)
+	if err != nil {
+		// The object, and with it the grant, no longer exists
+		if errors.Is(err, api.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	err = client.set(ctx, client.id, objectAccessControl(access, accessorID))
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -2,11 +2,14 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +21,7 @@ import (
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
@@ -36,7 +40,10 @@ type ServiceAccountResourceModel struct {
 	Updated customtypes.TimestampValue `tfsdk:"updated"`
 
 	Name            types.String          `tfsdk:"name"`
+	Description     types.String          `tfsdk:"description"`
+	ActorID         customtypes.UUIDValue `tfsdk:"actor_id"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountRoleID   customtypes.UUIDValue `tfsdk:"account_role_id"`
 	AccountRoleName types.String          `tfsdk:"account_role_name"`
 
 	APIKeyID         types.String               `tfsdk:"api_key_id"`
@@ -44,8 +51,15 @@ type ServiceAccountResourceModel struct {
 	APIKeyCreated    customtypes.TimestampValue `tfsdk:"api_key_created"`
 	APIKeyExpiration customtypes.TimestampValue `tfsdk:"api_key_expiration"`
 	APIKey           types.String               `tfsdk:"api_key"`
+	KeyRotationID    types.String               `tfsdk:"key_rotation_id"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
 }
 
+// defaultServiceAccountRoleName is the Account Role assigned to a
+// Service Account when neither a role ID nor a role name are configured.
+const defaultServiceAccountRoleName = "Member"
+
 // ArePointerTimesEqual is a helper to compare equality of two pointer times
 // as this can get verbose to do inline with the resource logic.
 func ArePointerTimesEqual(t1 *time.Time, t2 *time.Time) bool {
@@ -89,7 +103,11 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"Service Accounts are used to configure API access for workers or programs. Use this resource to provision " +
 			"and rotate Keys as well as assign Account and Workspace Access through Roles.\n" +
 			"\n" +
-			"API Keys for `service_account` resources can be rotated by modifying the `api_key_expiration` attribute.",
+			"API Keys for `service_account` resources can be rotated by modifying the `api_key_expiration` attribute, " +
+			"or by changing the `key_rotation_id` attribute to rotate the key without changing its expiration. " +
+			"Rotating the key invalidates the previous one. " +
+			"As the API Key is only returned by the API when it is generated, it is stored in state and is not re-read on refresh, " +
+			"so the state must be treated as a secret.",
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -103,29 +121,60 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 				Description: "Name of the service account",
 			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Description of the service account",
+			},
+			"actor_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Actor ID (UUID) of the service account, used by some access grants to refer to it",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"created": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 			},
+			"account_role_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account Role ID (UUID) of the service account. Conflicts with `account_role_name`.",
+				Validators: []validator.String{
+					customvalidators.UUIDValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("account_role_name")),
+				},
+			},
 			"account_role_name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Account Role name of the service account",
-				Default:     stringdefault.StaticString("Member"),
+				Description: "Account Role name of the service account. Conflicts with `account_role_id`. If neither are set, the service account is created with the `Member` role.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("Admin", "Member"),
+					stringvalidator.ConflictsWith(path.MatchRoot("account_role_id")),
 				},
 			},
 			"api_key_id": schema.StringAttribute{
@@ -140,6 +189,9 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of the API Key creation (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"api_key_expiration": schema.StringAttribute{
 				Optional:    true,
@@ -148,9 +200,22 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"api_key": schema.StringAttribute{
 				Computed:    true,
-				Description: "API Key associated with the service account",
+				Description: "API Key associated with the service account. It is stored in state in plain text.",
 				Sensitive:   true,
 			},
+			"key_rotation_id": schema.StringAttribute{
+				Optional: true,
+				Description: "Arbitrary value that rotates the API Key when changed, such as a date or a counter, " +
+					"so that keys can be rotated on a schedule without recreating the service account. " +
+					"The new key is available in `api_key`, and the previous key is invalidated. " +
+					"Setting it for the first time also rotates the key, while removing it does not.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
 		},
 	}
 }
@@ -164,15 +229,53 @@ func copyServiceAccountResponseToModel(serviceAccount *api.ServiceAccount, model
 	model.Updated = customtypes.NewTimestampPointerValue(serviceAccount.Updated)
 
 	model.Name = types.StringValue(serviceAccount.Name)
+	model.Description = types.StringValue("")
+	if serviceAccount.Description != nil {
+		model.Description = types.StringValue(*serviceAccount.Description)
+	}
+	model.ActorID = customtypes.NewUUIDValue(serviceAccount.ActorID)
 	model.AccountID = customtypes.NewUUIDValue(serviceAccount.AccountID)
 	model.AccountRoleName = types.StringValue(serviceAccount.AccountRoleName)
 
+	// The Account Role ID is resolved before Create/Update operations,
+	// so we only overwrite it when the API response includes it.
+	if serviceAccount.AccountRoleID != uuid.Nil {
+		model.AccountRoleID = customtypes.NewUUIDValue(serviceAccount.AccountRoleID)
+	}
+
 	model.APIKeyID = types.StringValue(serviceAccount.APIKey.ID)
 	model.APIKeyName = types.StringValue(serviceAccount.APIKey.Name)
 	model.APIKeyCreated = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Created)
 	model.APIKeyExpiration = customtypes.NewTimestampPointerValue(serviceAccount.APIKey.Expiration)
 }
 
+// resolveAccountRoleID returns the Account Role ID with the given name.
+//
+//nolint:ireturn // required by Terraform API
+func (r *ServiceAccountResource) resolveAccountRoleID(ctx context.Context, accountID uuid.UUID, name string) (uuid.UUID, diag.Diagnostic) {
+	accountRoleClient, err := r.client.AccountRoles(accountID)
+	if err != nil {
+		return uuid.Nil, helpers.CreateClientErrorDiagnostic("Account Role", err)
+	}
+
+	accountRoles, err := accountRoleClient.List(ctx, []string{name})
+	if err != nil {
+		return uuid.Nil, diag.NewErrorDiagnostic(
+			"Error fetching Account Role",
+			fmt.Sprintf("Could not fetch Account Role, unexpected error: %s", err),
+		)
+	}
+
+	if len(accountRoles) != 1 {
+		return uuid.Nil, diag.NewErrorDiagnostic(
+			"Could not find Account Role",
+			fmt.Sprintf("Could not find Account Role with name %q", name),
+		)
+	}
+
+	return accountRoles[0].ID, nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model ServiceAccountResourceModel
@@ -183,6 +286,9 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Service Account", "create", &resp.Diagnostics)
+	defer done()
+
 	serviceAccountClient, err := r.client.ServiceAccounts(model.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
@@ -191,44 +297,37 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 	}
 
 	createReq := api.ServiceAccountCreateRequest{
-		Name: model.Name.ValueString(),
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
 	}
 
-	// If the Account Role Name is provided, we'll need to fetch the Account Role ID
-	// and attach it to the Create request.
-	if !model.AccountRoleName.IsNull() && !model.AccountRoleName.IsUnknown() {
-		accountRoleClient, err := r.client.AccountRoles(model.AccountID.ValueUUID())
-		if err != nil {
-			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
-
-			return
-		}
-
-		accountRoles, err := accountRoleClient.List(ctx, []string{model.AccountRoleName.ValueString()})
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error fetching Account Role",
-				fmt.Sprintf("Could not fetch Account Role, unexpected error: %s", err),
-			)
-
-			return
+	// The Account Role ID is attached to the Create request as provided.
+	// Otherwise, we'll fetch the ID of the Account Role with the provided name,
+	// falling back to the default role if no name is provided either.
+	if !model.AccountRoleID.IsNull() && !model.AccountRoleID.IsUnknown() {
+		accountRoleID := model.AccountRoleID.ValueUUID()
+		createReq.AccountRoleID = &accountRoleID
+	} else {
+		accountRoleName := defaultServiceAccountRoleName
+		if !model.AccountRoleName.IsNull() && !model.AccountRoleName.IsUnknown() {
+			accountRoleName = model.AccountRoleName.ValueString()
 		}
 
-		if len(accountRoles) != 1 {
-			resp.Diagnostics.AddError(
-				"Could not find Account Role",
-				fmt.Sprintf("Could not find Account Role with name %s", model.AccountRoleName.String()),
-			)
+		accountRoleID, diagnostic := r.resolveAccountRoleID(ctx, model.AccountID.ValueUUID(), accountRoleName)
+		if diagnostic != nil {
+			resp.Diagnostics.Append(diagnostic)
 
 			return
 		}
 
-		createReq.AccountRoleID = &accountRoles[0].ID
+		createReq.AccountRoleID = &accountRoleID
 	}
 
+	model.AccountRoleID = customtypes.NewUUIDValue(*createReq.AccountRoleID)
+
 	// Conditionally set APIKeyExpiration if it's provided
 	if !model.APIKeyExpiration.ValueTime().IsZero() {
-		expiration := model.APIKeyExpiration.ValueTime().Format(time.RFC3339)
+		expiration := model.APIKeyExpiration.ValueTime().UTC().Format(time.RFC3339)
 		createReq.APIKeyExpiration = expiration
 	}
 
@@ -265,6 +364,9 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Service Account", "read", &resp.Diagnostics)
+	defer done()
+
 	if model.ID.IsNull() && model.Name.IsNull() {
 		resp.Diagnostics.AddError(
 			"Both ID and Name are unset",
@@ -293,7 +395,7 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 		// The error from the API call should take precedence
 		// followed by this custom error if a specific service account is not returned
 		if err == nil && len(serviceAccounts) != 1 {
-			err = fmt.Errorf("a Service Account with the name=%s could not be found", model.Name.ValueString())
+			err = fmt.Errorf("service account name=%s: %w", model.Name.ValueString(), api.ErrNotFound)
 		}
 
 		if len(serviceAccounts) == 1 {
@@ -301,24 +403,33 @@ func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 		}
 	}
 
-	if serviceAccount == nil {
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing Service Account state",
-			fmt.Sprintf("Could not find Service Account with ID=%s and Name=%s", model.ID.ValueString(), model.Name.ValueString()),
+			fmt.Sprintf("Could not read Service Account, unexpected error: %s", err.Error()),
 		)
 
 		return
 	}
 
-	if err != nil {
+	if serviceAccount == nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Service Account state",
-			fmt.Sprintf("Could not read Service Account, unexpected error: %s", err.Error()),
+			fmt.Sprintf("Could not find Service Account with ID=%s and Name=%s", model.ID.ValueString(), model.Name.ValueString()),
 		)
 
 		return
 	}
 
+	// Only the Service Account metadata is refreshed here, as the API Key
+	// is never returned on reads; the value held in state is preserved.
+
 	copyServiceAccountResponseToModel(serviceAccount, &model)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
@@ -338,6 +449,9 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, plan.Timeouts, "Service Account", "update", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.ServiceAccounts(plan.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
@@ -359,40 +473,36 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 	apiKey := state.APIKey.ValueString()
 
 	updateReq := api.ServiceAccountUpdateRequest{
-		Name: plan.Name.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
 	}
 
-	// Check if the Account Role Name was specifically changed in the plan,
-	// so we can only perform the Account Role lookup if we need to.
-	accountRoleName := state.AccountRoleName.ValueString()
-	if accountRoleName != plan.AccountRoleName.ValueString() {
-		accountRoleClient, err := r.client.AccountRoles(plan.AccountID.ValueUUID())
-		if err != nil {
-			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
-
-			return
-		}
-
-		accountRoles, err := accountRoleClient.List(ctx, []string{plan.AccountRoleName.ValueString()})
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error fetching Account Role",
-				fmt.Sprintf("Could not fetch Account Role, unexpected error: %s", err),
-			)
-
-			return
+	// The Account Role is only updated when configured. If the Account Role Name
+	// was specifically changed in the plan, we'll perform the Account Role lookup.
+	// When neither attribute is configured, the current Account Role is kept.
+	switch {
+	case !plan.AccountRoleID.IsNull() && !plan.AccountRoleID.IsUnknown():
+		accountRoleID := plan.AccountRoleID.ValueUUID()
+		updateReq.AccountRoleID = &accountRoleID
+	case !plan.AccountRoleName.IsNull() && !plan.AccountRoleName.IsUnknown():
+		accountRoleID := state.AccountRoleID.ValueUUID()
+		if state.AccountRoleName.ValueString() != plan.AccountRoleName.ValueString() || accountRoleID == uuid.Nil {
+			var diagnostic diag.Diagnostic
+			accountRoleID, diagnostic = r.resolveAccountRoleID(ctx, plan.AccountID.ValueUUID(), plan.AccountRoleName.ValueString())
+			if diagnostic != nil {
+				resp.Diagnostics.Append(diagnostic)
+
+				return
+			}
 		}
 
-		if len(accountRoles) != 1 {
-			resp.Diagnostics.AddError(
-				"Could not find Account Role",
-				fmt.Sprintf("Could not find Account Role with name %s", plan.AccountRoleName.String()),
-			)
-
-			return
-		}
+		updateReq.AccountRoleID = &accountRoleID
+	default:
+		plan.AccountRoleID = state.AccountRoleID
+	}
 
-		updateReq.AccountRoleID = &accountRoles[0].ID
+	if updateReq.AccountRoleID != nil {
+		plan.AccountRoleID = customtypes.NewUUIDValue(*updateReq.AccountRoleID)
 	}
 
 	// Update client method requires context, botID, request args
@@ -420,9 +530,12 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 	// `api_key_expiration` attribute. If the provided value is different than the current
 	// value, we'll call the RotateKey method on the client, which returns the
 	// ServiceAccount object with the new API Key value included in the response.
+	// Changing the `key_rotation_id` attribute to a new value also rotates the key,
+	// keeping the configured expiration.
 	providedExpiration := plan.APIKeyExpiration.ValueTimePointer()
 	currentExpiration := serviceAccount.APIKey.Expiration
-	if !ArePointerTimesEqual(providedExpiration, currentExpiration) {
+	rotationRequested := !plan.KeyRotationID.IsNull() && !plan.KeyRotationID.Equal(state.KeyRotationID)
+	if rotationRequested || !ArePointerTimesEqual(providedExpiration, currentExpiration) {
 		serviceAccount, err = client.RotateKey(ctx, plan.ID.ValueString(), api.ServiceAccountRotateKeyRequest{
 			APIKeyExpiration: providedExpiration,
 		})
@@ -463,6 +576,9 @@ func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Service Account", "delete", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.ServiceAccounts(model.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
@@ -48,6 +48,14 @@ resource "prefect_service_account" "bot" {
 }`, name)
 }
 
+func fixtureAccServiceAccountResourceDescription(name string, description string) string {
+	return fmt.Sprintf(`
+resource "prefect_service_account" "bot" {
+	name = "%s"
+	description = "%s"
+}`, name, description)
+}
+
 func fixtureAccServiceAccountResourceUpdateKeyExpiration(name string, expiration time.Time) string {
 	return fmt.Sprintf(`
 resource "prefect_service_account" "bot" {
@@ -56,6 +64,15 @@ resource "prefect_service_account" "bot" {
 }`, name, expiration.Format(time.RFC3339))
 }
 
+func fixtureAccServiceAccountResourceKeyRotationID(name string, expiration time.Time, keyRotationID string) string {
+	return fmt.Sprintf(`
+resource "prefect_service_account" "bot" {
+	name = "%s"
+	api_key_expiration = "%s"
+	key_rotation_id = "%s"
+}`, name, expiration.Format(time.RFC3339), keyRotationID)
+}
+
 func fixtureAccServiceAccountResourceUpdateAccountRoleName(name string, roleName string) string {
 	return fmt.Sprintf(`
 resource "prefect_service_account" "bot" {
@@ -64,6 +81,17 @@ resource "prefect_service_account" "bot" {
 }`, name, roleName)
 }
 
+func fixtureAccServiceAccountResourceUpdateAccountRoleID(name string, roleName string) string {
+	return fmt.Sprintf(`
+data "prefect_account_role" "role" {
+	name = "%s"
+}
+resource "prefect_service_account" "bot" {
+	name = "%s"
+	account_role_id = data.prefect_account_role.role.id
+}`, roleName, name)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_service_account(t *testing.T) {
 	botResourceName := "prefect_service_account.bot"
@@ -88,6 +116,17 @@ func TestAccResource_service_account(t *testing.T) {
 					testAccCheckServiceAccountValues(&bot, &api.ServiceAccount{Name: botRandomName, AccountRoleName: "Member"}),
 					textAccCheckServiceAccountAPIKeyStored(botResourceName, &apiKey),
 					resource.TestCheckResourceAttr(botResourceName, "name", botRandomName),
+					resource.TestCheckResourceAttr(botResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(botResourceName, "actor_id"),
+				),
+			},
+			{
+				// Ensure updates of the description
+				Config: fixtureAccServiceAccountResourceDescription(botRandomName, "CI identity for the data platform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountResourceExists(botResourceName, &bot),
+					testAccCheckServiceAccountAPIKeyUnchanged(botResourceName, &apiKey),
+					resource.TestCheckResourceAttr(botResourceName, "description", "CI identity for the data platform"),
 				),
 			},
 			{
@@ -107,9 +146,37 @@ func TestAccResource_service_account(t *testing.T) {
 					testAccCheckServiceAccountResourceExists(botResourceName, &bot),
 					testAccCheckServiceAccountValues(&bot, &api.ServiceAccount{Name: botRandomName, AccountRoleName: "Member"}),
 					testAccCheckServiceAccountAPIKeyRotated(botResourceName, &apiKey),
+					textAccCheckServiceAccountAPIKeyStored(botResourceName, &apiKey),
 					resource.TestCheckResourceAttr(botResourceName, "name", botRandomName),
 				),
 			},
+			{
+				// Ensure that setting a key rotation ID DOES trigger a key rotation
+				Config: fixtureAccServiceAccountResourceKeyRotationID(botRandomName, expiration, "2024-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyRotated(botResourceName, &apiKey),
+					textAccCheckServiceAccountAPIKeyStored(botResourceName, &apiKey),
+					resource.TestCheckResourceAttr(botResourceName, "key_rotation_id", "2024-01"),
+				),
+			},
+			{
+				// Ensure that changing the key rotation ID DOES trigger a key rotation,
+				// while keeping the key expiration
+				Config: fixtureAccServiceAccountResourceKeyRotationID(botRandomName, expiration, "2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyRotated(botResourceName, &apiKey),
+					textAccCheckServiceAccountAPIKeyStored(botResourceName, &apiKey),
+					resource.TestCheckResourceAttr(botResourceName, "key_rotation_id", "2024-02"),
+					resource.TestCheckResourceAttr(botResourceName, "api_key_expiration", expiration.UTC().Format(time.RFC3339)),
+				),
+			},
+			{
+				// Ensure that an unchanged key rotation ID DOESN'T trigger a key rotation
+				Config: fixtureAccServiceAccountResourceKeyRotationID(botRandomName, expiration, "2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyUnchanged(botResourceName, &apiKey),
+				),
+			},
 			{
 				// Ensure updates of the account role
 				Config: fixtureAccServiceAccountResourceUpdateAccountRoleName(botRandomName, "Admin"),
@@ -128,6 +195,16 @@ func TestAccResource_service_account(t *testing.T) {
 					resource.TestCheckResourceAttr(botResourceName, "name", botRandomName2),
 				),
 			},
+			{
+				// Ensure updates of the account role by ID
+				Config: fixtureAccServiceAccountResourceUpdateAccountRoleID(botRandomName2, "Member"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountResourceExists(botResourceName, &bot),
+					testAccCheckServiceAccountValues(&bot, &api.ServiceAccount{Name: botRandomName2, AccountRoleName: "Member"}),
+					resource.TestCheckResourceAttrPair(botResourceName, "account_role_id", "data.prefect_account_role.role", "id"),
+					resource.TestCheckResourceAttr(botResourceName, "account_role_name", "Member"),
+				),
+			},
 			// Import State checks - import by name
 			{
 				ImportState:                          true,
@@ -0,0 +1,581 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&TeamResource{})
+	_ = resource.ResourceWithImportState(&TeamResource{})
+)
+
+// TeamResource contains state for the resource.
+type TeamResource struct {
+	client api.PrefectClient
+}
+
+// TeamResourceModel defines the Terraform resource model.
+type TeamResourceModel struct {
+	ID        types.String               `tfsdk:"id"`
+	Created   customtypes.TimestampValue `tfsdk:"created"`
+	Updated   customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID customtypes.UUIDValue      `tfsdk:"account_id"`
+
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+}
+
+// NewTeamResource returns a new TeamResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewTeamResource() resource.Resource {
+	return &TeamResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `team` represents a Prefect Cloud Team. " +
+			"Teams are used to group Account Members, so that Workspace Access can be managed for all of them at once.\n" +
+			"\n" +
+			"When `members` is set, the Team membership is fully managed by this resource: " +
+			"members that are not listed are removed from the Team.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Team ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the team",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the team",
+				Optional:    true,
+				Computed:    true,
+			},
+			"members": schema.SetAttribute{
+				Description: "Set of Account Member user IDs (UUID) belonging to the team. " +
+					"These correspond to the `user_id` attribute of the `prefect_account_member` data source. " +
+					"If left unset, the team membership is not managed by this resource.",
+				Optional:    true,
+				ElementType: customtypes.UUIDType{},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.UUIDValidator{}),
+				},
+			},
+		},
+	}
+}
+
+// copyTeamToModel copies an api.Team to a TeamResourceModel.
+func copyTeamToModel(team *api.Team, model *TeamResourceModel) {
+	model.ID = types.StringValue(team.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(team.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(team.Updated)
+
+	model.Name = types.StringValue(team.Name)
+	model.Description = types.StringValue(team.Description)
+}
+
+// copyTeamMembersToModel copies the user IDs of the team members to a TeamResourceModel.
+func copyTeamMembersToModel(ctx context.Context, members []*api.TeamMember, model *TeamResourceModel) diag.Diagnostics {
+	memberIDs := make([]customtypes.UUIDValue, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, customtypes.NewUUIDValue(member.MemberID))
+	}
+
+	set, diags := types.SetValueFrom(ctx, customtypes.UUIDType{}, memberIDs)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Members = set
+
+	return nil
+}
+
+// memberIDsFromModel returns the user IDs configured in the members attribute.
+func memberIDsFromModel(ctx context.Context, model *TeamResourceModel) ([]uuid.UUID, diag.Diagnostics) {
+	var members []customtypes.UUIDValue
+	diags := model.Members.ElementsAs(ctx, &members, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ValueUUID())
+	}
+
+	return memberIDs, nil
+}
+
+// validateTeamMembers ensures that every configured member ID
+// belongs to an existing Account Member.
+func (r *TeamResource) validateTeamMembers(ctx context.Context, accountID uuid.UUID, memberIDs []uuid.UUID) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(memberIDs) == 0 {
+		return diags
+	}
+
+	client, err := r.client.AccountMemberships(accountID)
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Account Memberships", err))
+
+		return diags
+	}
+
+	accountMemberships, err := client.List(ctx, nil, "")
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Account Memberships", "list", err))
+
+		return diags
+	}
+
+	existing := make(map[uuid.UUID]struct{}, len(accountMemberships))
+	for _, accountMembership := range accountMemberships {
+		existing[accountMembership.UserID] = struct{}{}
+	}
+
+	for _, memberID := range memberIDs {
+		if _, ok := existing[memberID]; !ok {
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Unknown Account Member",
+				fmt.Sprintf("Could not find an Account Member with the user ID %s. Ensure the user is a member of the account before adding them to a team.", memberID),
+			)
+		}
+	}
+
+	return diags
+}
+
+// listTeamMemberIDs returns the user IDs of the current members of a team.
+func listTeamMemberIDs(ctx context.Context, client api.TeamsClient, teamID uuid.UUID) ([]uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members, err := client.ListMembers(ctx, teamID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Team members", "list", err))
+
+		return nil, diags
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.MemberID)
+	}
+
+	return memberIDs, diags
+}
+
+// teamMemberDeltas returns the member IDs to add and to remove,
+// so that the current team membership matches the desired member IDs.
+func teamMemberDeltas(current []uuid.UUID, desired []uuid.UUID) ([]uuid.UUID, []uuid.UUID) {
+	currentIDs := make(map[uuid.UUID]struct{}, len(current))
+	for _, memberID := range current {
+		currentIDs[memberID] = struct{}{}
+	}
+
+	desiredIDs := make(map[uuid.UUID]struct{}, len(desired))
+	toAdd := []uuid.UUID{}
+	for _, memberID := range desired {
+		desiredIDs[memberID] = struct{}{}
+
+		if _, ok := currentIDs[memberID]; !ok {
+			toAdd = append(toAdd, memberID)
+		}
+	}
+
+	toRemove := []uuid.UUID{}
+	for _, memberID := range current {
+		if _, ok := desiredIDs[memberID]; !ok {
+			toRemove = append(toRemove, memberID)
+		}
+	}
+
+	return toAdd, toRemove
+}
+
+// reconcileTeamMembers adds and removes team members, so that the team
+// membership changes from the current to the desired member IDs.
+//
+// When the client supports it, all changes are sent in a single bulk request.
+// Otherwise, or if the server does not provide the bulk endpoint, members
+// are added in one request and removed one by one.
+func reconcileTeamMembers(ctx context.Context, client api.TeamsClient, teamID uuid.UUID, current []uuid.UUID, desired []uuid.UUID) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	toAdd, toRemove := teamMemberDeltas(current, desired)
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return diags
+	}
+
+	if bulkClient, ok := client.(api.TeamMembersBulkUpdater); ok {
+		data := api.TeamMembersUpdate{
+			Add:    make([]api.TeamMember, 0, len(toAdd)),
+			Remove: toRemove,
+		}
+		for _, memberID := range toAdd {
+			data.Add = append(data.Add, api.TeamMember{MemberID: memberID, MemberType: "user"})
+		}
+
+		err := bulkClient.UpdateMembers(ctx, teamID, data)
+		if err == nil {
+			return diags
+		}
+
+		if !errors.Is(err, api.ErrUnsupported) {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Team members", "update", err))
+
+			return diags
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := client.AddMembers(ctx, teamID, toAdd); err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Team members", "add", err))
+
+			return diags
+		}
+	}
+
+	for _, memberID := range toRemove {
+		if err := client.RemoveMember(ctx, teamID, memberID); err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Team members", "remove", err))
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberIDs []uuid.UUID
+	if !plan.Members.IsNull() {
+		var diags diag.Diagnostics
+		memberIDs, diags = memberIDsFromModel(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.validateTeamMembers(ctx, plan.AccountID.ValueUUID(), memberIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client, err := r.client.Teams(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team", err))
+
+		return
+	}
+
+	team, err := client.Create(ctx, api.TeamCreate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "create", err))
+
+		return
+	}
+
+	copyTeamToModel(team, &plan)
+
+	if !plan.Members.IsNull() {
+		currentIDs, diags := listTeamMemberIDs(ctx, client, team.ID)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			resp.Diagnostics.Append(reconcileTeamMembers(ctx, client, team.ID, currentIDs, memberIDs)...)
+		}
+	}
+
+	// The team is persisted in state even if its members could not be reconciled,
+	// so that it isn't orphaned; the membership will be reconciled on the next apply.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Team ID",
+			fmt.Sprintf("Could not parse team ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	client, err := r.client.Teams(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team", err))
+
+		return
+	}
+
+	team, err := client.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "get", err))
+
+		return
+	}
+
+	copyTeamToModel(team, &state)
+
+	// Team membership is only refreshed when it is managed by this resource.
+	if !state.Members.IsNull() {
+		members, err := client.ListMembers(ctx, teamID)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team members", "list", err))
+
+			return
+		}
+
+		resp.Diagnostics.Append(copyTeamMembersToModel(ctx, members, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Team ID",
+			fmt.Sprintf("Could not parse team ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	var memberIDs []uuid.UUID
+	if !plan.Members.IsNull() {
+		var diags diag.Diagnostics
+		memberIDs, diags = memberIDsFromModel(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.validateTeamMembers(ctx, plan.AccountID.ValueUUID(), memberIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client, err := r.client.Teams(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team", err))
+
+		return
+	}
+
+	err = client.Update(ctx, teamID, api.TeamUpdate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "update", err))
+
+		return
+	}
+
+	if !plan.Members.IsNull() {
+		// The members in state were refreshed before planning, so the deltas
+		// can be computed without listing the members again. If the membership
+		// was not managed before, we don't know the current members yet.
+		var currentIDs []uuid.UUID
+		var diags diag.Diagnostics
+		if state.Members.IsNull() {
+			currentIDs, diags = listTeamMemberIDs(ctx, client, teamID)
+		} else {
+			currentIDs, diags = memberIDsFromModel(ctx, &state)
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(reconcileTeamMembers(ctx, client, teamID, currentIDs, memberIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	team, err := client.Get(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "get", err))
+
+		return
+	}
+
+	copyTeamToModel(team, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Team ID",
+			fmt.Sprintf("Could not parse team ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	client, err := r.client.Teams(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Team", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Team ID",
+			fmt.Sprintf("Could not parse team ID to UUID, expected a team UUID, got: %s", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
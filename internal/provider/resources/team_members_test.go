@@ -0,0 +1,110 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
+)
+
+// fakeTeamsClient records the membership calls made by the resource.
+// Calls to any other method panic via the nil embedded interface.
+type fakeTeamsClient struct {
+	api.TeamsClient
+
+	added   [][]uuid.UUID
+	removed []uuid.UUID
+}
+
+func (c *fakeTeamsClient) AddMembers(_ context.Context, _ uuid.UUID, memberIDs []uuid.UUID) error {
+	c.added = append(c.added, memberIDs)
+
+	return nil
+}
+
+func (c *fakeTeamsClient) RemoveMember(_ context.Context, _ uuid.UUID, memberID uuid.UUID) error {
+	c.removed = append(c.removed, memberID)
+
+	return nil
+}
+
+// fakeBulkTeamsClient additionally supports bulk membership updates.
+type fakeBulkTeamsClient struct {
+	fakeTeamsClient
+
+	bulkErr     error
+	bulkUpdates []api.TeamMembersUpdate
+}
+
+func (c *fakeBulkTeamsClient) UpdateMembers(_ context.Context, _ uuid.UUID, data api.TeamMembersUpdate) error {
+	c.bulkUpdates = append(c.bulkUpdates, data)
+
+	return c.bulkErr
+}
+
+func TestReconcileTeamMembers_unchanged(t *testing.T) {
+	t.Parallel()
+
+	members := []uuid.UUID{uuid.New(), uuid.New()}
+	client := &fakeBulkTeamsClient{}
+
+	// The desired members are the same, in a different order.
+	diags := resources.ReconcileTeamMembers(context.Background(), client, uuid.New(), members, []uuid.UUID{members[1], members[0]})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(client.bulkUpdates) != 0 || len(client.added) != 0 || len(client.removed) != 0 {
+		t.Errorf("expected no API calls, got %d bulk updates, %d adds and %d removes", len(client.bulkUpdates), len(client.added), len(client.removed))
+	}
+}
+
+func TestReconcileTeamMembers_bulk(t *testing.T) {
+	t.Parallel()
+
+	kept, removed, added := uuid.New(), uuid.New(), uuid.New()
+	client := &fakeBulkTeamsClient{}
+
+	diags := resources.ReconcileTeamMembers(context.Background(), client, uuid.New(), []uuid.UUID{kept, removed}, []uuid.UUID{kept, added})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(client.bulkUpdates) != 1 {
+		t.Fatalf("expected 1 bulk update, got %d", len(client.bulkUpdates))
+	}
+
+	update := client.bulkUpdates[0]
+	if len(update.Add) != 1 || update.Add[0].MemberID != added {
+		t.Errorf("expected bulk update to add %s, got: %v", added, update.Add)
+	}
+	if len(update.Remove) != 1 || update.Remove[0] != removed {
+		t.Errorf("expected bulk update to remove %s, got: %v", removed, update.Remove)
+	}
+
+	if len(client.added) != 0 || len(client.removed) != 0 {
+		t.Errorf("expected no individual calls, got %d adds and %d removes", len(client.added), len(client.removed))
+	}
+}
+
+func TestReconcileTeamMembers_bulkUnsupported(t *testing.T) {
+	t.Parallel()
+
+	removed, added := uuid.New(), uuid.New()
+	client := &fakeBulkTeamsClient{bulkErr: fmt.Errorf("bulk team membership update: %w", api.ErrUnsupported)}
+
+	diags := resources.ReconcileTeamMembers(context.Background(), client, uuid.New(), []uuid.UUID{removed}, []uuid.UUID{added})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(client.added) != 1 || len(client.added[0]) != 1 || client.added[0][0] != added {
+		t.Errorf("expected %s to be added individually, got: %v", added, client.added)
+	}
+	if len(client.removed) != 1 || client.removed[0] != removed {
+		t.Errorf("expected %s to be removed individually, got: %v", removed, client.removed)
+	}
+}
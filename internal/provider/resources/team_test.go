@@ -0,0 +1,137 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccTeamCreate(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_team" "team" {
+	name = "%s"
+}
+`, name)
+}
+
+func fixtureAccTeamUpdate(name string, description string) string {
+	return fmt.Sprintf(`
+data "prefect_account_member" "member" {
+	email = "marvin+tf-acceptance-tester@prefect.io"
+}
+resource "prefect_team" "team" {
+	name = "%s"
+	description = "%s"
+	members = [data.prefect_account_member.member.user_id]
+}
+`, name, description)
+}
+
+func fixtureAccTeamNoMembers(name string, description string) string {
+	return fmt.Sprintf(`
+resource "prefect_team" "team" {
+	name = "%s"
+	description = "%s"
+	members = []
+}
+`, name, description)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_team(t *testing.T) {
+	resourceName := "prefect_team.team"
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomName2 := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomDescription := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var team api.Team
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the team resource
+				Config: fixtureAccTeamCreate(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamExists(resourceName, &team),
+					testAccCheckTeamValues(&team, &api.Team{Name: randomName}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckNoResourceAttr(resourceName, "members"),
+				),
+			},
+			{
+				// Check updating the name, description and members of the team resource
+				Config: fixtureAccTeamUpdate(randomName2, randomDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamExists(resourceName, &team),
+					testAccCheckTeamValues(&team, &api.Team{Name: randomName2, Description: randomDescription}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName2),
+					resource.TestCheckResourceAttr(resourceName, "description", randomDescription),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*", "data.prefect_account_member.member", "user_id"),
+				),
+			},
+			{
+				// Check that members are removed from the team resource
+				Config: fixtureAccTeamNoMembers(randomName2, randomDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamExists(resourceName, &team),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+				),
+			},
+			// Import State checks - import by ID
+			{
+				ImportState:             true,
+				ResourceName:            resourceName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"members"},
+			},
+		},
+	})
+}
+
+func testAccCheckTeamExists(teamResourceName string, team *api.Team) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		teamResource, exists := state.RootModule().Resources[teamResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", teamResourceName)
+		}
+		teamID, _ := uuid.Parse(teamResource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		teamsClient, _ := c.Teams(uuid.Nil)
+
+		fetchedTeam, err := teamsClient.Get(context.Background(), teamID)
+		if err != nil {
+			return fmt.Errorf("Error fetching team: %w", err)
+		}
+
+		*team = *fetchedTeam
+
+		return nil
+	}
+}
+
+func testAccCheckTeamValues(fetchedTeam *api.Team, valuesToCheck *api.Team) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedTeam.Name != valuesToCheck.Name {
+			return fmt.Errorf("Expected team name to be %s, got %s", valuesToCheck.Name, fetchedTeam.Name)
+		}
+		if fetchedTeam.Description != valuesToCheck.Description {
+			return fmt.Errorf("Expected team description to be %s, got %s", valuesToCheck.Description, fetchedTeam.Description)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+// TestResources_equivalentTimestampsPlanNoDiff checks that refreshing a
+// computed timestamp returned as `+00:00` instead of `Z` plans no change
+// on any resource.
+func TestResources_equivalentTimestampsPlanNoDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := types.StringValue("2024-03-01T13:30:00+00:00")
+	plan := types.StringValue("2024-03-01T13:30:00Z")
+
+	for _, newResource := range provider.New("test").Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "prefect"}, &metadata)
+
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+		for name, attribute := range resp.Schema.Attributes {
+			timestamp, ok := attribute.(schema.StringAttribute)
+			if !ok || timestamp.CustomType != (customtypes.TimestampType{}) || !timestamp.Computed || timestamp.Optional {
+				continue
+			}
+
+			req := planmodifier.StringRequest{ConfigValue: types.StringNull(), StateValue: state, PlanValue: plan}
+			modified := &planmodifier.StringResponse{PlanValue: plan}
+			for _, modifier := range timestamp.PlanModifiers {
+				modifier.PlanModifyString(ctx, req, modified)
+				req.PlanValue = modified.PlanValue
+			}
+
+			if !modified.PlanValue.Equal(state) {
+				t.Errorf("%s.%s: expected the equivalent timestamp to plan no change, got %s", metadata.TypeName, name, modified.PlanValue)
+			}
+		}
+	}
+}
@@ -2,23 +2,30 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var (
@@ -26,6 +33,9 @@ var (
 	_ = resource.ResourceWithImportState(&VariableResource{})
 )
 
+// variableNameRegex matches the characters Prefect allows in a variable name.
+var variableNameRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
 // VariableResource contains state for the resource.
 type VariableResource struct {
 	client api.PrefectClient
@@ -41,7 +51,9 @@ type VariableResourceModel struct {
 
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
-	Tags  types.List   `tfsdk:"tags"`
+	Tags  types.Set    `tfsdk:"tags"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // NewVariableResource returns a new VariableResource.
@@ -77,7 +89,7 @@ func (r *VariableResource) Configure(_ context.Context, req resource.ConfigureRe
 
 // Schema defines the schema for the resource.
 func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	defaultEmptyTagList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
+	defaultEmptyTagSet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
 		Description: "The resource `variable` represents a Prefect Cloud Variable. " +
@@ -98,38 +110,58 @@ func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
 			"workspace_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 				Optional:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the variable",
+				Description: "Name of the variable. May only contain lowercase letters, numbers, and underscores.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						variableNameRegex,
+						"must contain only lowercase alphanumeric characters and underscores",
+					),
+				},
 			},
 			"value": schema.StringAttribute{
-				Description: "Value of the variable",
+				Description: "Value of the variable. To store structured data, encode it with `jsonencode()`; semantically equal JSON returned by the server will not produce a diff.",
 				Required:    true,
 			},
-			"tags": schema.ListAttribute{
+			"tags": schema.SetAttribute{
 				Description: "Tags associated with the variable",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
-				Default:     listdefault.StaticValue(defaultEmptyTagList),
+				Default:     setdefault.StaticValue(defaultEmptyTagSet),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -140,9 +172,15 @@ func copyVariableToModel(ctx context.Context, variable *api.Variable, model *Var
 	model.Updated = customtypes.NewTimestampPointerValue(variable.Updated)
 
 	model.Name = types.StringValue(variable.Name)
-	model.Value = types.StringValue(variable.Value)
 
-	tags, diags := types.ListValueFrom(ctx, types.StringType, variable.Tags)
+	// Values can be JSON documents, so we'll preserve the existing value
+	// if the server returns a semantically equal representation
+	// (e.g. with different whitespace or key ordering) to avoid spurious diffs.
+	if model.Value.IsNull() || model.Value.IsUnknown() || !helpers.JSONSemanticallyEqual(model.Value.ValueString(), variable.Value) {
+		model.Value = types.StringValue(variable.Value)
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, variable.Tags)
 	if diags.HasError() {
 		return diags
 	}
@@ -161,6 +199,9 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variable", "create", &resp.Diagnostics)
+	defer done()
+
 	var tags []string
 	resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
 	if resp.Diagnostics.HasError() {
@@ -169,10 +210,7 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 
 	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -212,12 +250,12 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variable", "read", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -253,6 +291,12 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing variable state",
 			fmt.Sprintf("Could not read variable, unexpected error: %s", err.Error()),
@@ -281,12 +325,12 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variable", "update", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -352,12 +396,12 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variable", "delete", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating variable client",
-			fmt.Sprintf("Could not create variable client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
 
 		return
 	}
@@ -391,6 +435,14 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports the resource into Terraform state.
 func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "id"
+	// - "name/name"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
 	if strings.HasPrefix(req.ID, "name/") {
 		name := strings.TrimPrefix(req.ID, "name/")
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
@@ -3,6 +3,8 @@ package resources_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -40,6 +42,35 @@ resource "prefect_variable" "test" {
 	`, name, value)
 }
 
+func fixtureAccVariableResourceInvalidName() string {
+	return `
+resource "prefect_variable" "test" {
+	name = "Invalid-Variable-Name"
+	value = "foo"
+}
+	`
+}
+
+func fixtureAccVariableResourceEmptyTag() string {
+	return `
+resource "prefect_variable" "test" {
+	name = "foo"
+	value = "foo"
+	tags = ["foo", " "]
+}
+	`
+}
+
+func fixtureAccVariableResourceInvalidWorkspaceID() string {
+	return `
+resource "prefect_variable" "test" {
+	workspace_id = "not-a-uuid"
+	name = "foo"
+	value = "foo"
+}
+	`
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_variable(t *testing.T) {
 	resourceName := "prefect_variable.test"
@@ -59,6 +90,21 @@ func TestAccResource_variable(t *testing.T) {
 		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
 		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
 		Steps: []resource.TestStep{
+			{
+				// Check that an invalid name is rejected at plan time
+				Config:      fixtureAccVariableResourceInvalidName(),
+				ExpectError: regexp.MustCompile("must contain only lowercase alphanumeric characters and underscores"),
+			},
+			{
+				// Check that a malformed workspace ID is rejected at plan time
+				Config:      fixtureAccVariableResourceInvalidWorkspaceID(),
+				ExpectError: regexp.MustCompile("Invalid UUID String Value"),
+			},
+			{
+				// Check that an empty tag is rejected at plan time
+				Config:      fixtureAccVariableResourceEmptyTag(),
+				ExpectError: regexp.MustCompile("Invalid Tag Value"),
+			},
 			{
 				// Check creation + existence of the variable resource
 				Config: fixtureAccVariableResource(randomName, randomValue),
@@ -88,10 +134,24 @@ func TestAccResource_variable(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", randomName2),
 					resource.TestCheckResourceAttr(resourceName, "value", randomValue2),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0", "foo"),
-					resource.TestCheckResourceAttr(resourceName, "tags.1", "bar"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "foo"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "bar"),
 				),
 			},
+			// Import State checks - import by account_id/workspace_id/id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getVariableCompositeImportStateID(resourceName, workspaceDatsourceName),
+				ImportStateVerify: true,
+			},
+			{
+				// Check that a variable deleted outside of Terraform
+				// is removed from state and planned for re-creation
+				Config:             fixtureAccVariableResourceWithTags(randomName2, randomValue2),
+				Check:              testAccDeleteVariableOutOfBand(resourceName, workspaceDatsourceName),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -129,6 +189,47 @@ func testAccCheckVariableExists(variableResourceName string, workspaceDatasource
 		return nil
 	}
 }
+func testAccDeleteVariableOutOfBand(variableResourceName string, workspaceDatasourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		variableResource, exists := state.RootModule().Resources[variableResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", variableResourceName)
+		}
+		variableResourceID, _ := uuid.Parse(variableResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		c, _ := testutils.NewTestClient()
+		variablesClient, _ := c.Variables(uuid.Nil, workspaceID)
+
+		if err := variablesClient.Delete(context.Background(), variableResourceID); err != nil {
+			return fmt.Errorf("Error deleting variable: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func getVariableCompositeImportStateID(variableResourceName string, workspaceDatsourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatsourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatsourceName)
+		}
+
+		variableResource, exists := state.RootModule().Resources[variableResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", variableResourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"), workspaceDatsource.Primary.ID, variableResource.Primary.ID), nil
+	}
+}
+
 func testAccCheckVariableValues(fetchedVariable *api.Variable, valuesToCheck *api.Variable) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if fetchedVariable.Name != valuesToCheck.Name {
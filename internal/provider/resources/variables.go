@@ -0,0 +1,601 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&VariablesResource{})
+	_ = resource.ResourceWithImportState(&VariablesResource{})
+)
+
+// VariablesResource contains state for the resource.
+type VariablesResource struct {
+	client api.PrefectClient
+}
+
+// VariablesResourceModel defines the Terraform resource model.
+type VariablesResourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Values        types.Map  `tfsdk:"values"`
+	VariableIDs   types.Map  `tfsdk:"variable_ids"`
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewVariablesResource returns a new VariablesResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesResource() resource.Resource {
+	return &VariablesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *VariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *VariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *VariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `variables` manages a set of Prefect Cloud Variables in a workspace as a single unit. " +
+			"Variables in `values` are created when missing and updated when changed, and variables removed from `values` are deleted. " +
+			"Other variables in the workspace are left untouched. " +
+			"Adding a variable that already exists with the same name fails, unless `adopt_existing` is set. " +
+			"Do not manage the same variable with both this resource and `prefect_variable`.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the set of variables",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.MapAttribute{
+				Description: "Map of variable names to their values. Names may only contain lowercase letters, numbers, and underscores. " +
+					"To store structured data, encode a value with `jsonencode()`; semantically equal JSON returned by the server will not produce a diff.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							variableNameRegex,
+							"must contain only lowercase alphanumeric characters and underscores",
+						),
+					),
+				},
+			},
+			"variable_ids": schema.MapAttribute{
+				Description: "Map of variable names to their IDs (UUID)",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"adopt_existing": variablesAdoptExistingAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// variablesAdoptExistingAttribute returns the schema of the `adopt_existing`
+// attribute. Unlike other resources, the values of the adopted variables
+// are updated to match the configuration.
+func variablesAdoptExistingAttribute() schema.BoolAttribute {
+	attribute := helpers.AdoptExistingAttribute("Variable")
+	attribute.Description = "Whether to adopt the existing variables of the same names when adding them to `values`, updating their values and preserving their tags. " +
+		"Adopted variables are deleted along with the resource. Defaults to `false`."
+
+	return attribute
+}
+
+// listVariablesByName returns the variables matching names, keyed by name.
+func listVariablesByName(ctx context.Context, client api.VariablesClient, names []string) (map[string]api.Variable, error) {
+	variables := map[string]api.Variable{}
+	if len(names) == 0 {
+		return variables, nil
+	}
+
+	fetched, err := client.List(ctx, api.VariableFilter{
+		Name: &api.VariableFilterName{Any: names},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variable := range fetched {
+		variables[variable.Name] = variable
+	}
+
+	return variables, nil
+}
+
+// copyVariablesToModel copies the variables named in the model's values
+// to a VariablesResourceModel. Names that no longer exist are dropped.
+func copyVariablesToModel(ctx context.Context, variables map[string]api.Variable, model *VariablesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current := map[string]string{}
+	diags.Append(model.Values.ElementsAs(ctx, &current, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	values := map[string]string{}
+	ids := map[string]string{}
+	for name, value := range current {
+		variable, ok := variables[name]
+		if !ok {
+			continue
+		}
+
+		// Values can be JSON documents, so we'll preserve the existing value
+		// if the server returns a semantically equal representation.
+		if variableValuesEqual(value, variable.Value) {
+			values[name] = value
+		} else {
+			values[name] = variable.Value
+		}
+		ids[name] = variable.ID.String()
+	}
+
+	var d diag.Diagnostics
+	model.Values, d = types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+
+	model.VariableIDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+
+	return diags
+}
+
+// variableValuesEqual reports whether two variable values are equal,
+// either verbatim or as semantically equal JSON documents.
+func variableValuesEqual(a string, b string) bool {
+	return a == b || helpers.JSONSemanticallyEqual(a, b)
+}
+
+// reconcileVariables creates, updates, and deletes variables so that the
+// workspace matches planned, deleting the previously managed names that
+// are no longer planned. Planned names that are not managed yet must not
+// exist, unless adoptExisting is set.
+//
+// It returns the names of the variables that remain managed: the planned
+// ones, the ones whose update failed, and the removed ones whose delete
+// failed, so that a later apply retries them. Planned variables whose
+// create failed are left out. It returns nil if nothing was changed.
+func reconcileVariables(ctx context.Context, client api.VariablesClient, planned map[string]string, managed []string, adoptExisting bool) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(planned)+len(managed))
+	for name := range planned {
+		names = append(names, name)
+	}
+	for _, name := range managed {
+		if _, ok := planned[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	existing, err := listVariablesByName(ctx, client, names)
+	if err != nil {
+		diags.AddError(
+			"Error refreshing variables state",
+			fmt.Sprintf("Could not list variables, unexpected error: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	// Check for conflicts before changing anything, so that
+	// a conflict does not leave the workspace half reconciled.
+	if !adoptExisting {
+		isManaged := map[string]bool{}
+		for _, name := range managed {
+			isManaged[name] = true
+		}
+
+		for _, name := range names {
+			if _, ok := planned[name]; !ok || isManaged[name] {
+				continue
+			}
+
+			if _, ok := existing[name]; ok {
+				diags.Append(helpers.AlreadyExistsAttributeDiagnostic(path.Root("values").AtMapKey(name), "Variable", name, nil))
+			}
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	kept := make([]string, 0, len(names))
+
+	// Delete removed variables first, so that their names are free to reuse.
+	for _, name := range names {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+
+		variable, ok := existing[name]
+		if !ok {
+			continue
+		}
+
+		if err := client.Delete(ctx, variable.ID); err != nil {
+			diags.AddError(
+				"Error deleting variable",
+				fmt.Sprintf("Could not delete variable %s, unexpected error: %s", name, err),
+			)
+
+			kept = append(kept, name)
+		}
+	}
+
+	for _, name := range names {
+		value, ok := planned[name]
+		if !ok {
+			continue
+		}
+
+		variable, exists := existing[name]
+
+		switch {
+		case !exists:
+			_, err = client.Create(ctx, api.VariableCreate{
+				Name:  name,
+				Value: value,
+				Tags:  []string{},
+			})
+			if err != nil {
+				diags.AddError(
+					"Error creating variable",
+					fmt.Sprintf("Could not create variable %s, unexpected error: %s", name, err),
+				)
+
+				continue
+			}
+		case !variableValuesEqual(value, variable.Value):
+			err = client.Update(ctx, variable.ID, api.VariableUpdate{
+				Name:  name,
+				Value: value,
+				Tags:  variable.Tags,
+			})
+			if err != nil {
+				diags.AddError(
+					"Error updating variable",
+					fmt.Sprintf("Could not update variable %s, unexpected error: %s", name, err),
+				)
+			}
+		}
+
+		kept = append(kept, name)
+	}
+
+	sort.Strings(kept)
+
+	return kept, diags
+}
+
+// apply reconciles the planned variables and sets the resulting state.
+// If an error occurs after some variables were changed, the model keeps
+// the variables that remain managed, with their values on the server,
+// so that they can be persisted. Otherwise, the model is left as planned.
+func (r *VariablesResource) apply(ctx context.Context, model *VariablesResourceModel, managed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return diags
+	}
+
+	planned := map[string]string{}
+	diags.Append(model.Values.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	names, d := reconcileVariables(ctx, client, planned, managed, model.AdoptExisting.ValueBool())
+	diags.Append(d...)
+	if diags.HasError() {
+		if names == nil {
+			return diags
+		}
+
+		// Variables pending delete have no planned value, so their
+		// value on the server is copied to the model instead.
+		kept := map[string]string{}
+		for _, name := range names {
+			kept[name] = planned[name]
+		}
+
+		model.Values, d = types.MapValueFrom(ctx, types.StringType, kept)
+		diags.Append(d...)
+
+		if len(names) == 0 {
+			model.VariableIDs = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+			return diags
+		}
+	}
+
+	variables, err := listVariablesByName(ctx, client, names)
+	if err != nil {
+		diags.AddError(
+			"Error refreshing variables state",
+			fmt.Sprintf("Could not list variables, unexpected error: %s", err),
+		)
+
+		return diags
+	}
+
+	diags.Append(copyVariablesToModel(ctx, variables, model)...)
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *VariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model VariablesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variables", "create", &resp.Diagnostics)
+	defer done()
+
+	model.ID = types.StringValue(uuid.NewString())
+
+	// The model is populated from the configuration, where the default is not applied.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+
+	diags := r.apply(ctx, &model, nil)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		// Persist the variables created before the error, so that they are
+		// not orphaned. Terraform then taints the resource and replaces it.
+		if len(model.VariableIDs.Elements()) > 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *VariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model VariablesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variables", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	names := make([]string, 0, len(model.Values.Elements()))
+	for name := range model.Values.Elements() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	variables, err := listVariablesByName(ctx, client, names)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error refreshing variables state",
+			fmt.Sprintf("Could not list variables, unexpected error: %s", err),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyVariablesToModel(ctx, variables, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources have no adopt_existing value, so we fall back to the default.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *VariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model VariablesResourceModel
+	var state VariablesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variables", "update", &resp.Diagnostics)
+	defer done()
+
+	managed := make([]string, 0, len(state.Values.Elements()))
+	for name := range state.Values.Elements() {
+		managed = append(managed, name)
+	}
+
+	diags := r.apply(ctx, &model, managed)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		// Persist the variables changed before the error, as Terraform
+		// otherwise keeps the prior state, which does not know about them.
+		if !model.VariableIDs.IsUnknown() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *VariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model VariablesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Variables", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	managed := make([]string, 0, len(model.Values.Elements()))
+	for name := range model.Values.Elements() {
+		managed = append(managed, name)
+	}
+
+	_, diags := reconcileVariables(ctx, client, map[string]string{}, managed, false)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *VariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/name,name,..."
+	// - "name,name,..."
+	names := req.ID
+
+	parts := strings.Split(req.ID, "/")
+	if len(parts) == 3 {
+		for i, name := range []string{"account_id", "workspace_id"} {
+			if _, err := uuid.Parse(parts[i]); err != nil {
+				resp.Diagnostics.AddError(
+					"Unexpected Import Identifier",
+					fmt.Sprintf("Expected %s to be a UUID, in the form of `account_id/workspace_id/name,name`. Got %q", name, parts[i]),
+				)
+
+				return
+			}
+
+			// The account is left unset when it is the default account, as
+			// configurations relying on the provider's account do not set it.
+			if accountID, _ := uuid.Parse(parts[i]); name == "account_id" && r.client != nil && accountID == r.client.DefaultAccountID() {
+				continue
+			}
+
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+		}
+
+		names = parts[2]
+	}
+
+	values := map[string]string{}
+	for _, name := range strings.Split(names, ",") {
+		if !variableNameRegex.MatchString(name) {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected a comma-separated list of variable names, in the form of `name,name` or `account_id/workspace_id/name,name`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		// The values are refreshed from the server after the import.
+		values[name] = ""
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), uuid.NewString())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("values"), values)...)
+}
@@ -0,0 +1,116 @@
+package resources_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
+)
+
+// fakeVariablesClient serves the variables it holds, failing the
+// creates and deletes of the names in failCreate and failDelete.
+// Calls to any other method panic via the nil embedded interface.
+type fakeVariablesClient struct {
+	api.VariablesClient
+
+	variables  map[string]api.Variable
+	failCreate map[string]bool
+	failDelete map[string]bool
+}
+
+func (c *fakeVariablesClient) List(_ context.Context, filter api.VariableFilter) ([]api.Variable, error) {
+	variables := []api.Variable{}
+	for _, name := range filter.Name.Any {
+		if variable, ok := c.variables[name]; ok {
+			variables = append(variables, variable)
+		}
+	}
+
+	return variables, nil
+}
+
+func (c *fakeVariablesClient) Create(_ context.Context, data api.VariableCreate) (*api.Variable, error) {
+	if c.failCreate[data.Name] {
+		return nil, errors.New("status code 500")
+	}
+
+	variable := api.Variable{BaseModel: api.BaseModel{ID: uuid.New()}, Name: data.Name, Value: data.Value}
+	c.variables[data.Name] = variable
+
+	return &variable, nil
+}
+
+func (c *fakeVariablesClient) Update(_ context.Context, variableID uuid.UUID, data api.VariableUpdate) error {
+	c.variables[data.Name] = api.Variable{BaseModel: api.BaseModel{ID: variableID}, Name: data.Name, Value: data.Value}
+
+	return nil
+}
+
+func (c *fakeVariablesClient) Delete(_ context.Context, variableID uuid.UUID) error {
+	for name, variable := range c.variables {
+		if variable.ID != variableID {
+			continue
+		}
+
+		if c.failDelete[name] {
+			return errors.New("status code 500")
+		}
+
+		delete(c.variables, name)
+	}
+
+	return nil
+}
+
+func TestReconcileVariables_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeVariablesClient{
+		variables: map[string]api.Variable{
+			"kept":    {BaseModel: api.BaseModel{ID: uuid.New()}, Name: "kept", Value: "foo"},
+			"removed": {BaseModel: api.BaseModel{ID: uuid.New()}, Name: "removed", Value: "bar"},
+		},
+		failCreate: map[string]bool{"failed": true},
+		failDelete: map[string]bool{"removed": true},
+	}
+
+	planned := map[string]string{"kept": "baz", "added": "qux", "failed": "quux"}
+	kept, diags := resources.ReconcileVariables(context.Background(), client, planned, []string{"kept", "removed"}, false)
+	if !diags.HasError() {
+		t.Fatal("expected the failed create and delete to be reported")
+	}
+
+	// The variable pending delete remains managed, so that a later apply
+	// retries the delete, while the one that failed to create is left out.
+	expected := []string{"added", "kept", "removed"}
+	if !reflect.DeepEqual(kept, expected) {
+		t.Errorf("expected managed variables %v, got %v", expected, kept)
+	}
+
+	if client.variables["kept"].Value != "baz" || client.variables["added"].Value != "qux" {
+		t.Errorf("expected the other variables to be reconciled, got %v", client.variables)
+	}
+}
+
+func TestReconcileVariables_conflictChangesNothing(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeVariablesClient{
+		variables: map[string]api.Variable{
+			"existing": {BaseModel: api.BaseModel{ID: uuid.New()}, Name: "existing", Value: "foo"},
+		},
+	}
+
+	kept, diags := resources.ReconcileVariables(context.Background(), client, map[string]string{"existing": "bar", "added": "baz"}, nil, false)
+	if !diags.HasError() {
+		t.Fatal("expected the existing variable to be reported")
+	}
+
+	if kept != nil || len(client.variables) != 1 {
+		t.Errorf("expected no change, got managed variables %v and variables %v", kept, client.variables)
+	}
+}
@@ -0,0 +1,224 @@
+package resources_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccVariablesResource(values map[string]string) string {
+	entries := ""
+	for name, value := range values {
+		entries += fmt.Sprintf("\t\t%s = %q\n", name, value)
+	}
+
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_variables" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	values = {
+%s	}
+}
+	`, entries)
+}
+
+func fixtureAccVariablesResourceAdoptExisting(name string, value string, adoptExisting bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_variables" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	adopt_existing = %t
+	values = {
+		%s = %q
+	}
+}
+	`, adoptExisting, name, value)
+}
+
+const fixtureAccVariablesWorkspace = `
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+`
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables(t *testing.T) {
+	resourceName := "prefect_variables.test"
+	const workspaceDatsourceName = "data.prefect_workspace.evergreen"
+
+	// Names are restricted to lowercase letters, numbers, and underscores.
+	suffix := acctest.RandStringFromCharSet(10, "abcdefghijklmnopqrstuvwxyz0123456789")
+	kept := "tfacc_kept_" + suffix
+	removed := "tfacc_removed_" + suffix
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the set of variables
+				Config: fixtureAccVariablesResource(map[string]string{kept: "foo", removed: "bar"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableByName(workspaceDatsourceName, kept, "foo"),
+					testAccCheckVariableByName(workspaceDatsourceName, removed, "bar"),
+					resource.TestCheckResourceAttr(resourceName, "values.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values."+kept, "foo"),
+					resource.TestCheckResourceAttrSet(resourceName, "variable_ids."+kept),
+				),
+			},
+			{
+				// Check that changing a value updates the variable, and that
+				// removing a key deletes the corresponding variable
+				Config: fixtureAccVariablesResource(map[string]string{kept: "baz"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableByName(workspaceDatsourceName, kept, "baz"),
+					testAccCheckVariableDeleted(workspaceDatsourceName, removed),
+					resource.TestCheckResourceAttr(resourceName, "values.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable_ids.%", "1"),
+				),
+			},
+			// Import State checks - import by account_id/workspace_id/name,name (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getVariablesCompositeImportStateID(workspaceDatsourceName, kept),
+				ImportStateVerify: true,
+				// The ID identifies the set of variables in state only.
+				ImportStateVerifyIgnore: []string{"id"},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables_existing(t *testing.T) {
+	resourceName := "prefect_variables.test"
+	const workspaceDatsourceName = "data.prefect_workspace.evergreen"
+
+	existing := "tfacc_existing_" + acctest.RandStringFromCharSet(10, "abcdefghijklmnopqrstuvwxyz0123456789")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create a variable outside of Terraform
+				Config: fixtureAccVariablesWorkspace,
+				Check:  testAccCreateVariable(workspaceDatsourceName, existing, "foo"),
+			},
+			{
+				// Check that an existing variable is not overwritten by default
+				Config:      fixtureAccVariablesResourceAdoptExisting(existing, "bar", false),
+				ExpectError: regexp.MustCompile("Variable already exists"),
+			},
+			{
+				// Check that an existing variable is adopted when requested
+				Config: fixtureAccVariablesResourceAdoptExisting(existing, "bar", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableByName(workspaceDatsourceName, existing, "bar"),
+					resource.TestCheckResourceAttr(resourceName, "values."+existing, "bar"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
+func getVariablesCompositeImportStateID(workspaceDatsourceName string, names ...string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatsourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatsourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"), workspaceDatsource.Primary.ID, strings.Join(names, ",")), nil
+	}
+}
+
+// variablesClientForWorkspace returns a variables client for the workspace
+// found in state under workspaceDatasourceName.
+func variablesClientForWorkspace(state *terraform.State, workspaceDatasourceName string) (api.VariablesClient, error) {
+	workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+	if !exists {
+		return nil, fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+	}
+	workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+	c, _ := testutils.NewTestClient()
+
+	return c.Variables(uuid.Nil, workspaceID)
+}
+
+func testAccCheckVariableByName(workspaceDatasourceName string, name string, value string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		variablesClient, err := variablesClientForWorkspace(state, workspaceDatasourceName)
+		if err != nil {
+			return err
+		}
+
+		variable, err := variablesClient.GetByName(context.Background(), name)
+		if err != nil {
+			return fmt.Errorf("Error fetching variable %s: %w", name, err)
+		}
+
+		if variable.Value != value {
+			return fmt.Errorf("Expected variable %s value to be %s, got %s", name, value, variable.Value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateVariable(workspaceDatasourceName string, name string, value string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		variablesClient, err := variablesClientForWorkspace(state, workspaceDatasourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = variablesClient.Create(context.Background(), api.VariableCreate{
+			Name:  name,
+			Value: value,
+			Tags:  []string{},
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating variable %s: %w", name, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVariableDeleted(workspaceDatasourceName string, name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		variablesClient, err := variablesClientForWorkspace(state, workspaceDatasourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = variablesClient.GetByName(context.Background(), name)
+		if err == nil {
+			return fmt.Errorf("Expected variable %s to be deleted", name)
+		}
+		if !errors.Is(err, api.ErrNotFound) {
+			return fmt.Errorf("Error fetching variable %s: %w", name, err)
+		}
+
+		return nil
+	}
+}
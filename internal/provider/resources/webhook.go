@@ -0,0 +1,459 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WebhookResource{})
+	_ = resource.ResourceWithImportState(&WebhookResource{})
+)
+
+// webhookResourceIDPrefix prefixes the ID of a webhook in the ID of the
+// related resource that Prefect Cloud adds to the events it emits.
+const webhookResourceIDPrefix = "prefect-cloud.webhook."
+
+// WebhookResource contains state for the resource.
+type WebhookResource struct {
+	client api.PrefectClient
+}
+
+// WebhookResourceModel defines the Terraform resource model.
+type WebhookResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Template    types.String `tfsdk:"template"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+	URL         types.String `tfsdk:"url"`
+	ResourceID  types.String `tfsdk:"resource_id"`
+	Event       types.String `tfsdk:"event"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewWebhookResource returns a new WebhookResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `webhook` represents a Prefect Webhook. " +
+			"Webhooks receive requests from external systems at a generated URL, and turn them into Prefect events using their template.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Webhook ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the webhook",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the webhook",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether this webhook is enabled, and accepting requests",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"template": schema.StringAttribute{
+				Description: "Jinja template that turns the requests received by the webhook into Prefect events",
+				Required:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "Generated slug of the webhook, identifying it in its URL",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "Full URL that external systems send requests to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "Event resource ID of the webhook (`prefect-cloud.webhook.<id>`), which is added as a related resource " +
+					"to the events it emits. Use it in the `match_related` of an automation trigger to match only the events of this webhook",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event": schema.StringAttribute{
+				Description: "Name of the events emitted by the webhook, when its template sets a static `event`, such as `github.push`. " +
+					"Use it in the `expect` of an automation trigger. Null when the event name is templated",
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyWebhookToModel copies an api.Webhook to a WebhookResourceModel.
+func copyWebhookToModel(webhook *api.Webhook, model *WebhookResourceModel) {
+	model.ID = types.StringValue(webhook.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(webhook.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(webhook.Updated)
+
+	model.Name = types.StringValue(webhook.Name)
+	model.Description = types.StringValue(webhook.Description)
+	model.Template = types.StringValue(webhook.Template)
+	model.Enabled = types.BoolValue(webhook.IsActive)
+	model.Endpoint = types.StringValue(webhook.Slug)
+	model.URL = types.StringValue(webhook.URL)
+	model.ResourceID = types.StringValue(webhookResourceIDPrefix + webhook.ID.String())
+	model.Event = webhookEventName(webhook.Template)
+}
+
+// webhookEventName returns the name of the events emitted by a webhook
+// template, or null if the template is not JSON or templates the name.
+func webhookEventName(template string) types.String {
+	var event struct {
+		Event *string `json:"event"`
+	}
+	if err := json.Unmarshal([]byte(template), &event); err != nil || event.Event == nil {
+		return types.StringNull()
+	}
+
+	if strings.Contains(*event.Event, "{{") || strings.Contains(*event.Event, "{%") {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*event.Event)
+}
+
+// webhookFromModel returns the api.WebhookUpsert
+// described by a WebhookResourceModel.
+func webhookFromModel(model *WebhookResourceModel) api.WebhookUpsert {
+	return api.WebhookUpsert{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Template:    model.Template.ValueString(),
+		IsActive:    model.Enabled.ValueBool(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model WebhookResourceModel
+
+	// Populate the model from resource plan and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Webhook", "create", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Webhooks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhook, err := client.Create(ctx, webhookFromModel(&model))
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "create", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model WebhookResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Webhook", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Webhooks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Webhook ID",
+			fmt.Sprintf("Could not parse webhook ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	webhook, err := client.Get(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "get", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Webhook", "update", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Webhooks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Webhook ID",
+			fmt.Sprintf("Could not parse webhook ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Update(ctx, webhookID, webhookFromModel(&model))
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "update", err))
+
+		return
+	}
+
+	webhook, err := client.Get(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "get", err))
+
+		return
+	}
+
+	copyWebhookToModel(webhook, &model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Webhook", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.Webhooks(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Webhook", err))
+
+		return
+	}
+
+	webhookID, err := uuid.Parse(model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Webhook ID",
+			fmt.Sprintf("Could not parse webhook ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Webhook", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	// - "id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,id`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	if _, err := uuid.Parse(identifier); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Webhook ID",
+			fmt.Sprintf("Could not parse webhook ID to UUID, expected a webhook UUID, got: %s", identifier),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identifier)...)
+}
@@ -0,0 +1,143 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWebhookResource(name string, enabled bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_webhook" "test" {
+	workspace_id = data.prefect_workspace.evergreen.id
+	name = "%s"
+	description = "created by acceptance tests"
+	template = jsonencode({
+		event = "external.thing.happened"
+		resource = {
+			"prefect.resource.id" = "external.thing.{{ body.id }}"
+		}
+	})
+	enabled = %t
+}
+	`, name, enabled)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_webhook(t *testing.T) {
+	resourceName := "prefect_webhook.test"
+	const workspaceDatasourceName = "data.prefect_workspace.evergreen"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var webhook api.Webhook
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the webhook resource
+				Config: fixtureAccWebhookResource(randomName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebhookExists(resourceName, workspaceDatasourceName, &webhook),
+					testAccCheckWebhookValues(&webhook, &api.Webhook{WebhookUpsert: api.WebhookUpsert{Name: randomName, IsActive: true}}),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://.+/hooks/.+$`)),
+					resource.TestMatchResourceAttr(resourceName, "resource_id", regexp.MustCompile(`^prefect-cloud\.webhook\.[0-9a-f-]{36}$`)),
+					resource.TestCheckResourceAttr(resourceName, "event", "external.thing.happened"),
+				),
+			},
+			{
+				// Check disabling the webhook
+				Config: fixtureAccWebhookResource(randomName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebhookExists(resourceName, workspaceDatasourceName, &webhook),
+					testAccCheckWebhookValues(&webhook, &api.Webhook{WebhookUpsert: api.WebhookUpsert{Name: randomName, IsActive: false}}),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			// Import State checks - import by workspace_id,id (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getWebhookImportStateID(resourceName, workspaceDatasourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookExists(webhookResourceName string, workspaceDatasourceName string, webhook *api.Webhook) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		webhookResource, exists := state.RootModule().Resources[webhookResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", webhookResourceName)
+		}
+		webhookID, _ := uuid.Parse(webhookResource.Primary.ID)
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		webhooksClient, _ := c.Webhooks(uuid.Nil, workspaceID)
+
+		fetchedWebhook, err := webhooksClient.Get(context.Background(), webhookID)
+		if err != nil {
+			return fmt.Errorf("Error fetching webhook: %w", err)
+		}
+
+		*webhook = *fetchedWebhook
+
+		return nil
+	}
+}
+
+func testAccCheckWebhookValues(fetchedWebhook *api.Webhook, valuesToCheck *api.Webhook) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedWebhook.Name != valuesToCheck.Name {
+			return fmt.Errorf("Expected webhook name to be %s, got %s", valuesToCheck.Name, fetchedWebhook.Name)
+		}
+		if fetchedWebhook.IsActive != valuesToCheck.IsActive {
+			return fmt.Errorf("Expected webhook is_active to be %t, got %t", valuesToCheck.IsActive, fetchedWebhook.IsActive)
+		}
+
+		return nil
+	}
+}
+
+func getWebhookImportStateID(webhookResourceName string, workspaceDatasourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		webhookResource, exists := state.RootModule().Resources[webhookResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", webhookResourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", workspaceID, webhookResource.Primary.ID), nil
+	}
+}
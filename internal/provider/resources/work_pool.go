@@ -3,10 +3,12 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,17 +17,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var (
 	_ = resource.ResourceWithConfigure(&WorkPoolResource{})
 	_ = resource.ResourceWithImportState(&WorkPoolResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkPoolResource{})
 )
 
+// workPoolBaseJobTemplateKeys are the top-level keys of a base job template.
+var workPoolBaseJobTemplateKeys = []string{"job_configuration", "variables"}
+
 // WorkPoolResource contains state for the resource.
 type WorkPoolResource struct {
 	client api.PrefectClient
@@ -39,13 +49,17 @@ type WorkPoolResourceModel struct {
 	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
 
-	Name             types.String          `tfsdk:"name"`
-	Description      types.String          `tfsdk:"description"`
-	Type             types.String          `tfsdk:"type"`
-	Paused           types.Bool            `tfsdk:"paused"`
-	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
-	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
-	BaseJobTemplate  jsontypes.Normalized  `tfsdk:"base_job_template"`
+	Name             types.String                `tfsdk:"name"`
+	Description      types.String                `tfsdk:"description"`
+	Type             types.String                `tfsdk:"type"`
+	Paused           types.Bool                  `tfsdk:"paused"`
+	ConcurrencyLimit types.Int64                 `tfsdk:"concurrency_limit"`
+	DefaultQueueID   customtypes.UUIDValue       `tfsdk:"default_queue_id"`
+	BaseJobTemplate  customtypes.JSONStringValue `tfsdk:"base_job_template"`
+	AdoptExisting    types.Bool                  `tfsdk:"adopt_existing"`
+	WaitForReady     types.Bool                  `tfsdk:"wait_for_ready"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // NewWorkPoolResource returns a new WorkPoolResource.
@@ -109,20 +123,26 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				// do not have a default value set here in the Schema.
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					helpers.EquivalentTimestamp(),
 				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
 			"workspace_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 				Optional:    true,
 			},
@@ -159,8 +179,12 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"concurrency_limit": schema.Int64Attribute{
-				Description: "The concurrency limit applied to this work pool",
-				Optional:    true,
+				Description: "The maximum number of concurrent flow runs of this work pool. `0` prevents any flow run from starting, while leaving it unset means unlimited. " +
+					"Removing it from the configuration clears the limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"default_queue_id": schema.StringAttribute{
 				Computed:    true,
@@ -172,11 +196,22 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"base_job_template": schema.StringAttribute{
 				Computed:    true,
-				CustomType:  jsontypes.NormalizedType{},
+				CustomType:  customtypes.JSONStringType{},
 				Default:     stringdefault.StaticString("{}"),
-				Description: "The base job template for the work pool, as a JSON string",
+				Description: "The base job template for the work pool, as a JSON string. Use `jsonencode()` or `file()` to provide the value; differences in formatting or key ordering are ignored.",
 				Optional:    true,
 			},
+			"adopt_existing": helpers.AdoptExistingAttribute("Work Pool"),
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Whether to wait on create until the work pool can be read from the API, so that dependent resources, such as deployments, do not race its creation. " +
+					"The wait is bounded by `timeouts.create`; if it fails, the created work pool is kept in state and replaced on the next apply. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
 		},
 	}
 }
@@ -211,12 +246,174 @@ func copyWorkPoolToModel(_ context.Context, pool *api.WorkPool, model *WorkPoolR
 			return diags
 		}
 
-		model.BaseJobTemplate = jsontypes.NewNormalizedValue(strings.TrimSuffix(builder.String(), "\n"))
+		model.BaseJobTemplate = customtypes.NewJSONStringValue(strings.TrimSuffix(builder.String(), "\n"))
 	}
 
 	return nil
 }
 
+// workPoolMismatches returns the attributes of an existing api.WorkPool
+// that differ from the configuration, preventing it from being adopted.
+func workPoolMismatches(pool *api.WorkPool, model *WorkPoolResourceModel, baseJobTemplate map[string]interface{}) []string {
+	mismatches := []string{}
+
+	if !model.Type.IsNull() && pool.Type != model.Type.ValueString() {
+		mismatches = append(mismatches, "type")
+	}
+
+	if !reflect.DeepEqual(pool.Description, model.Description.ValueStringPointer()) {
+		mismatches = append(mismatches, "description")
+	}
+
+	if pool.IsPaused != model.Paused.ValueBool() {
+		mismatches = append(mismatches, "paused")
+	}
+
+	if !reflect.DeepEqual(pool.ConcurrencyLimit, model.ConcurrencyLimit.ValueInt64Pointer()) {
+		mismatches = append(mismatches, "concurrency_limit")
+	}
+
+	// The server fills in defaults for the base job template,
+	// so the configured template only needs to be a subset of it.
+	if len(baseJobTemplate) > 0 {
+		configured, _ := json.Marshal(baseJobTemplate)
+		existing, _ := json.Marshal(pool.BaseJobTemplate)
+
+		if !helpers.JSONSubset(string(configured), string(existing)) {
+			mismatches = append(mismatches, "base_job_template")
+		}
+	}
+
+	return mismatches
+}
+
+// ModifyPlan validates the base job template at plan time, as the server
+// otherwise rejects an invalid template with an error that is hard to trace.
+func (r *WorkPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on deletion
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var poolType types.String
+	var baseJobTemplate customtypes.JSONStringValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &poolType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("base_job_template"), &baseJobTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if poolType.IsUnknown() || baseJobTemplate.IsNull() || baseJobTemplate.IsUnknown() {
+		return
+	}
+
+	// The JSON syntax is validated by the attribute type, so only the structure is checked here.
+	var template map[string]interface{}
+	if err := json.Unmarshal([]byte(baseJobTemplate.ValueString()), &template); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_job_template"),
+			"Invalid Base Job Template",
+			fmt.Sprintf("The base job template must be a JSON object, with the %s keys: %s", strings.Join(workPoolBaseJobTemplateKeys, " and "), err),
+		)
+
+		return
+	}
+
+	// An empty template is left for the server to default.
+	if len(template) == 0 {
+		return
+	}
+
+	var missing []string
+	for _, key := range workPoolBaseJobTemplateKeys {
+		value, ok := template[key]
+		if !ok {
+			missing = append(missing, key)
+
+			continue
+		}
+
+		if _, ok := value.(map[string]interface{}); !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_job_template"),
+				"Invalid Base Job Template",
+				fmt.Sprintf("The %q key of the base job template must be a JSON object.", key),
+			)
+		}
+	}
+
+	if len(missing) == 0 {
+		return
+	}
+
+	// Some work pool types, such as prefect-agent, do not use every key,
+	// which their default template shows when it can be fetched.
+	defaultKeys, ok := r.defaultBaseJobTemplateKeys(ctx, poolType.ValueString())
+	if ok {
+		required := missing[:0]
+		for _, key := range missing {
+			if defaultKeys[key] {
+				required = append(required, key)
+			}
+		}
+
+		missing = required
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_job_template"),
+			"Invalid Base Job Template",
+			fmt.Sprintf("The base job template of a %q work pool is missing the top-level keys: %s. "+
+				"Use the prefect_worker_metadata data source to start from the default template of the work pool type.", poolType.ValueString(), strings.Join(missing, ", ")),
+		)
+	}
+}
+
+// defaultBaseJobTemplateKeys returns the top-level keys of the default
+// base job template of a work pool type, reporting whether it was found.
+func (r *WorkPoolResource) defaultBaseJobTemplateKeys(ctx context.Context, poolType string) (map[string]bool, bool) {
+	if r.client == nil {
+		return nil, false
+	}
+
+	client, err := r.client.Collections()
+	if err != nil {
+		return nil, false
+	}
+
+	workerTypeByPackage, err := client.GetWorkerMetadataViews(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not fetch the default base job templates, validating against the standard keys", map[string]interface{}{
+			"error": err.Error(),
+		})
+
+		return nil, false
+	}
+
+	for _, metadataByWorkerType := range workerTypeByPackage {
+		for workerType, metadata := range metadataByWorkerType {
+			if workerType != poolType && metadata.Type != poolType {
+				continue
+			}
+
+			var defaultTemplate map[string]json.RawMessage
+			if err := json.Unmarshal(metadata.DefaultBaseJobConfiguration, &defaultTemplate); err != nil {
+				return nil, false
+			}
+
+			keys := make(map[string]bool, len(defaultTemplate))
+			for key := range defaultTemplate {
+				keys[key] = true
+			}
+
+			return keys, true
+		}
+	}
+
+	return nil, false
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model WorkPoolResourceModel
@@ -227,6 +424,9 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Pool", "create", &resp.Diagnostics)
+	defer done()
+
 	baseJobTemplate := map[string]interface{}{}
 	if !model.BaseJobTemplate.IsNull() {
 		reader := strings.NewReader(model.BaseJobTemplate.ValueString())
@@ -245,10 +445,7 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 
 	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating work pool client",
-			fmt.Sprintf("Could not create work pool client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
 
 		return
 	}
@@ -261,7 +458,32 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		IsPaused:         model.Paused.ValueBool(),
 		ConcurrencyLimit: model.ConcurrencyLimit.ValueInt64Pointer(),
 	})
-	if err != nil {
+
+	switch {
+	case errors.Is(err, api.ErrAlreadyExists) && model.AdoptExisting.ValueBool():
+		// Another pipeline may have created the same work pool concurrently,
+		// in which case we'll adopt it if it is configured the same way.
+		pool, err = helpers.GetAfterConflict(ctx, func(ctx context.Context) (*api.WorkPool, error) {
+			return client.Get(ctx, model.Name.ValueString())
+		})
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "get", err))
+
+			return
+		}
+
+		if mismatches := workPoolMismatches(pool, &model, baseJobTemplate); len(mismatches) > 0 {
+			resp.Diagnostics.Append(helpers.AlreadyExistsDiagnostic("Work Pool", model.Name.ValueString(), mismatches))
+
+			return
+		}
+
+	case errors.Is(err, api.ErrAlreadyExists):
+		resp.Diagnostics.Append(helpers.AlreadyExistsDiagnostic("Work Pool", model.Name.ValueString(), nil))
+
+		return
+
+	case err != nil:
 		resp.Diagnostics.AddError(
 			"Error creating work pool",
 			fmt.Sprintf("Could not create work pool, unexpected error: %s", err),
@@ -270,6 +492,31 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// The model is populated from the configuration, where the defaults are not applied.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+	model.WaitForReady = types.BoolValue(model.WaitForReady.ValueBool())
+
+	resp.Diagnostics.Append(copyWorkPoolToModel(ctx, pool, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the work pool before waiting for it, so that it is not orphaned
+	// if the wait fails. Terraform then taints the resource and replaces it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() || !model.WaitForReady.ValueBool() {
+		return
+	}
+
+	pool, err = helpers.WaitForReady(ctx, func(ctx context.Context) (*api.WorkPool, error) {
+		return client.Get(ctx, model.Name.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "get", err))
+
+		return
+	}
+
 	resp.Diagnostics.Append(copyWorkPoolToModel(ctx, pool, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -291,18 +538,26 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Pool", "read", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating work pool client",
-			fmt.Sprintf("Could not create work pool client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
 
 		return
 	}
 
 	pool, err := client.Get(ctx, model.Name.ValueString())
 	if err != nil {
+		// A resource deleted outside of Terraform is removed from state,
+		// so that the next plan proposes to recreate it.
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing work pool state",
 			fmt.Sprintf("Could not read work pool, unexpected error: %s", err),
@@ -311,6 +566,10 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Imported resources have no adopt_existing or wait_for_ready value, so we fall back to the defaults.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+	model.WaitForReady = types.BoolValue(model.WaitForReady.ValueBool())
+
 	resp.Diagnostics.Append(copyWorkPoolToModel(ctx, pool, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -331,6 +590,9 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Pool", "update", &resp.Diagnostics)
+	defer done()
+
 	baseJobTemplate := map[string]interface{}{}
 	if !model.BaseJobTemplate.IsNull() {
 		reader := strings.NewReader(model.BaseJobTemplate.ValueString())
@@ -349,10 +611,7 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 
 	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating work pool client",
-			fmt.Sprintf("Could not create work pool client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
 
 		return
 	}
@@ -402,12 +661,12 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Pool", "delete", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating work pool client",
-			fmt.Sprintf("Could not create work pool client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
-		)
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
 
 		return
 	}
@@ -431,8 +690,13 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports the resource into Terraform state.
 func (r *WorkPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/name"
 	// - "workspace_id,name"
 	// - "name"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "name") {
+		return
+	}
+
 	maxInputCount := 2
 	inputParts := strings.Split(req.ID, ",")
 
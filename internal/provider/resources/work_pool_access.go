@@ -0,0 +1,284 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WorkPoolAccessResource{})
+	_ = resource.ResourceWithConfigValidators(&WorkPoolAccessResource{})
+	_ = resource.ResourceWithImportState(&WorkPoolAccessResource{})
+)
+
+type WorkPoolAccessResource struct {
+	client api.PrefectClient
+}
+
+type WorkPoolAccessResourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	WorkPoolName types.String          `tfsdk:"work_pool_name"`
+	Role         types.String          `tfsdk:"role"`
+	ActorID      customtypes.UUIDValue `tfsdk:"actor_id"`
+	TeamID       customtypes.UUIDValue `tfsdk:"team_id"`
+
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+}
+
+// NewWorkPoolAccessResource returns a new WorkPoolAccessResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolAccessResource() resource.Resource {
+	return &WorkPoolAccessResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkPoolAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_pool_access"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WorkPoolAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkPoolAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = objectAccessSchema("work_pool_access", "Work Pool", "work_pool_name", schema.StringAttribute{
+		Description: "Name of the Work Pool to grant access to",
+	})
+}
+
+// ConfigValidators returns the validators applied to the resource configuration.
+func (r *WorkPoolAccessResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return objectAccessConfigValidators()
+}
+
+// grant returns the attributes of the model shared by the object access resources.
+func (model *WorkPoolAccessResourceModel) grant() objectAccessGrant {
+	return objectAccessGrant{ID: &model.ID, Role: &model.Role, ActorID: &model.ActorID, TeamID: &model.TeamID}
+}
+
+// accessClient returns the client of the access control of the Work Pool of the model.
+func (r *WorkPoolAccessResource) accessClient(model *WorkPoolAccessResourceModel) (objectAccessClient[string], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return objectAccessClient[string]{}, diags
+	}
+
+	return objectAccessClient[string]{
+		id: model.WorkPoolName.ValueString(),
+		// Work Pool names are only unique within a workspace, so this may
+		// serialize changes to unrelated Work Pools, which is harmless.
+		lockKey: "work_pool/" + model.WorkPoolName.ValueString(),
+		get:     client.GetAccess,
+		set:     client.SetAccess,
+	}, diags
+}
+
+// Create grants the Work Pool Access through the API and inserts it into the State.
+func (r *WorkPoolAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WorkPoolAccessResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setObjectAccess(ctx, client, plan.grant()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Work Pool", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WorkPoolAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkPoolAccessResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkPools(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return
+	}
+
+	accessorID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Work Pool Access ID",
+			fmt.Sprintf("Could not parse Work Pool Access ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	access, err := client.GetAccess(ctx, state.WorkPoolName.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Work Pool", "read", err))
+
+		return
+	}
+
+	// The grant was revoked outside of Terraform
+	if !copyObjectAccessToModel(access, accessorID, state.grant()) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WorkPoolAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkPoolAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setObjectAccess(ctx, client, plan.grant()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Work Pool", "update", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the grant and removes the Terraform state on success.
+func (r *WorkPoolAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkPoolAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.accessClient(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := revokeObjectAccess(ctx, client, state.grant().accessorID()); err != nil {
+		resp.Diagnostics.Append(objectAccessErrorDiagnostic("Work Pool", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// The import ID is a composite of the workspace ID, the work pool name and
+// the ID of the actor or team, in the form `workspace_id,work_pool_name,id`.
+func (r *WorkPoolAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/work_pool_name/id"
+	// - "workspace_id,work_pool_name,id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "work_pool_name", "id") {
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 3 || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace_id,work_pool_name,id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	workspaceID, workPoolName, accessorID := parts[0], parts[1], parts[2]
+
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace ID",
+			fmt.Sprintf("Could not parse workspace ID to UUID, got: %s", workspaceID),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(accessorID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Work Pool Access ID",
+			fmt.Sprintf("Could not parse Work Pool Access ID to UUID, got: %s", accessorID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("work_pool_name"), workPoolName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessorID)...)
+}
@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkPoolAccess(name string, role string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "pool" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_service_account" "bot" {
+	name = "%s"
+}
+resource "prefect_work_pool_access" "bot_access" {
+	work_pool_name = prefect_work_pool.pool.name
+	workspace_id = data.prefect_workspace.evergreen.id
+	actor_id = prefect_service_account.bot.actor_id
+	role = "%s"
+}`, name, name, role)
+}
+
+func fixtureAccWorkPoolAccessConcurrent(name string) string {
+	return fixtureAccWorkPoolAccess(name, "manage") + fmt.Sprintf(`
+resource "prefect_service_account" "other_bot" {
+	name = "%s-other"
+}
+resource "prefect_work_pool_access" "other_bot_access" {
+	work_pool_name = prefect_work_pool.pool.name
+	workspace_id = data.prefect_workspace.evergreen.id
+	actor_id = prefect_service_account.other_bot.actor_id
+	role = "view"
+}`, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_access(t *testing.T) {
+	accessResourceName := "prefect_work_pool_access.bot_access"
+	botResourceName := "prefect_service_account.bot"
+	workPoolResourceName := "prefect_work_pool.pool"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the work pool access resource
+				Config: fixtureAccWorkPoolAccess(randomName, "run"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "actor_id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "work_pool_name", workPoolResourceName, "name"),
+					resource.TestCheckResourceAttr(accessResourceName, "role", "run"),
+				),
+			},
+			{
+				// Check updating the role of the work pool access resource in place
+				Config: fixtureAccWorkPoolAccess(randomName, "manage"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttr(accessResourceName, "role", "manage"),
+				),
+			},
+			{
+				// Check that a second grant on the same work pool, applied in parallel,
+				// does not overwrite the first one. A lost grant is removed from the
+				// state on refresh, failing the empty plan check after apply.
+				Config: fixtureAccWorkPoolAccessConcurrent(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(accessResourceName, "role", "manage"),
+					resource.TestCheckResourceAttr("prefect_work_pool_access.other_bot_access", "role", "view"),
+				),
+			},
+			// Import State checks - import by workspace_id,work_pool_name,id
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getWorkPoolAccessImportStateID(accessResourceName),
+				ResourceName:      accessResourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getWorkPoolAccessImportStateID(accessResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		accessResource, exists := state.RootModule().Resources[accessResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", accessResourceName)
+		}
+
+		attributes := accessResource.Primary.Attributes
+
+		return fmt.Sprintf("%s,%s,%s", attributes["workspace_id"], attributes["work_pool_name"], attributes["id"]), nil
+	}
+}
@@ -3,6 +3,7 @@ package resources_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -27,6 +28,48 @@ resource "prefect_work_pool" "test" {
 `, name, poolType, paused)
 }
 
+func fixtureAccWorkPoolConcurrencyLimit(name string, poolType string, concurrencyLimit string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+	concurrency_limit = %s
+}
+`, name, poolType, concurrencyLimit)
+}
+
+func fixtureAccWorkPoolWaitForReady(name string, poolType string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+	wait_for_ready = true
+}
+`, name, poolType)
+}
+
+func fixtureAccWorkPoolBaseJobTemplate(name string, poolType string, baseJobTemplate string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "%s"
+	workspace_id = data.prefect_workspace.evergreen.id
+	base_job_template = jsonencode(%s)
+}
+`, name, poolType, baseJobTemplate)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_work_pool(t *testing.T) {
 	resourceName := "prefect_work_pool.test"
@@ -44,6 +87,16 @@ func TestAccResource_work_pool(t *testing.T) {
 		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
 		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
 		Steps: []resource.TestStep{
+			{
+				// Check that a base job template that is not an object is rejected at plan time
+				Config:      fixtureAccWorkPoolBaseJobTemplate(randomName, poolType, `["job_configuration"]`),
+				ExpectError: regexp.MustCompile("must be a JSON object"),
+			},
+			{
+				// Check that a base job template missing its top-level keys is rejected at plan time
+				Config:      fixtureAccWorkPoolBaseJobTemplate(randomName, poolType, `{ job_configuration = {} }`),
+				ExpectError: regexp.MustCompile("missing the top-level keys: variables"),
+			},
 			{
 				// Check creation + existence of the work pool resource
 				Config: fixtureAccWorkPoolCreate(randomName, poolType, true),
@@ -67,6 +120,39 @@ func TestAccResource_work_pool(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
 				),
 			},
+			{
+				// Check setting a concurrency limit
+				Config: fixtureAccWorkPoolConcurrencyLimit(randomName, poolType, "5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDAreEqual(resourceName, &workPool),
+					testAccCheckWorkPoolExists(resourceName, workspaceDatsourceName, &workPool),
+					testAccCheckWorkPoolConcurrencyLimit(&workPool, int64Pointer(5)),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+				),
+			},
+			{
+				// Check that a concurrency limit of 0 is kept, rather than cleared
+				Config: fixtureAccWorkPoolConcurrencyLimit(randomName, poolType, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(resourceName, workspaceDatsourceName, &workPool),
+					testAccCheckWorkPoolConcurrencyLimit(&workPool, int64Pointer(0)),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "0"),
+				),
+			},
+			{
+				// Check setting the concurrency limit again, before clearing it
+				Config: fixtureAccWorkPoolConcurrencyLimit(randomName, poolType, "5"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+			},
+			{
+				// Check that a null concurrency limit clears the limit
+				Config: fixtureAccWorkPoolConcurrencyLimit(randomName, poolType, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(resourceName, workspaceDatsourceName, &workPool),
+					testAccCheckWorkPoolConcurrencyLimit(&workPool, nil),
+					resource.TestCheckNoResourceAttr(resourceName, "concurrency_limit"),
+				),
+			},
 			{
 				// Check that changing the name will re-create the resource
 				Config: fixtureAccWorkPoolCreate(randomName2, poolType, false),
@@ -92,6 +178,59 @@ func TestAccResource_work_pool(t *testing.T) {
 				ImportStateIdFunc: getWorkPoolImportStateID(resourceName, workspaceDatsourceName),
 				ImportStateVerify: true,
 			},
+			{
+				// Check that a work pool created with wait_for_ready is readable once created
+				Config: fixtureAccWorkPoolWaitForReady(randomName, poolType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(resourceName, workspaceDatsourceName, &workPool),
+					testAccCheckWorkPoolValues(&workPool, &api.WorkPool{Name: randomName, Type: poolType, IsPaused: false}),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_ready", "true"),
+				),
+			},
+			{
+				// Check that a work pool deleted outside of Terraform
+				// is removed from state and planned for re-creation
+				Config:             fixtureAccWorkPoolCreate(randomName2, poolType2, false),
+				Check:              testAccDeleteWorkPoolOutOfBand(resourceName, workspaceDatsourceName),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func fixtureAccWorkPoolConflict(name string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_work_pool" "conflict" {
+	name = "%s"
+	type = "ecs"
+	workspace_id = data.prefect_workspace.evergreen.id
+	adopt_existing = true
+	depends_on = [prefect_work_pool.test]
+}
+`, name, name)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_conflict(t *testing.T) {
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that an existing work pool with a different configuration is not adopted
+				Config:      fixtureAccWorkPoolConflict(randomName),
+				ExpectError: regexp.MustCompile("these attributes differ from the configuration: type"),
+			},
 		},
 	})
 }
@@ -129,6 +268,30 @@ func testAccCheckWorkPoolExists(workPoolResourceName string, workspaceDatasource
 	}
 }
 
+func testAccDeleteWorkPoolOutOfBand(workPoolResourceName string, workspaceDatasourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		workPoolResource, exists := state.RootModule().Resources[workPoolResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workPoolResourceName)
+		}
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		c, _ := testutils.NewTestClient()
+		workPoolsClient, _ := c.WorkPools(uuid.Nil, workspaceID)
+
+		if err := workPoolsClient.Delete(context.Background(), workPoolResource.Primary.Attributes["name"]); err != nil {
+			return fmt.Errorf("Error deleting work pool: %w", err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckWorkPoolValues(fetchedWorkPool *api.WorkPool, valuesToCheck *api.WorkPool) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if fetchedWorkPool.Name != valuesToCheck.Name {
@@ -147,6 +310,21 @@ func testAccCheckWorkPoolValues(fetchedWorkPool *api.WorkPool, valuesToCheck *ap
 	}
 }
 
+func testAccCheckWorkPoolConcurrencyLimit(fetchedWorkPool *api.WorkPool, expected *int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		switch {
+		case expected == nil && fetchedWorkPool.ConcurrencyLimit != nil:
+			return fmt.Errorf("Expected work pool concurrency limit to be cleared, got %d", *fetchedWorkPool.ConcurrencyLimit)
+		case expected != nil && fetchedWorkPool.ConcurrencyLimit == nil:
+			return fmt.Errorf("Expected work pool concurrency limit to be %d, got null", *expected)
+		case expected != nil && *fetchedWorkPool.ConcurrencyLimit != *expected:
+			return fmt.Errorf("Expected work pool concurrency limit to be %d, got %d", *expected, *fetchedWorkPool.ConcurrencyLimit)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckIDAreEqual(resourceName string, fetchedWorkPool *api.WorkPool) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workPoolResource, exists := state.RootModule().Resources[resourceName]
@@ -0,0 +1,479 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WorkQueueResource{})
+	_ = resource.ResourceWithImportState(&WorkQueueResource{})
+)
+
+// WorkQueueResource contains state for the resource.
+type WorkQueueResource struct {
+	client api.PrefectClient
+}
+
+// WorkQueueResourceModel defines the Terraform resource model.
+type WorkQueueResourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	Created     customtypes.TimestampValue `tfsdk:"created"`
+	Updated     customtypes.TimestampValue `tfsdk:"updated"`
+	AccountID   customtypes.UUIDValue      `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue      `tfsdk:"workspace_id"`
+
+	WorkPoolName     types.String `tfsdk:"work_pool_name"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	IsPaused         types.Bool   `tfsdk:"is_paused"`
+	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+	Priority         types.Int64  `tfsdk:"priority"`
+	AdoptExisting    types.Bool   `tfsdk:"adopt_existing"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewWorkQueueResource returns a new WorkQueueResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkQueueResource() resource.Resource {
+	return &WorkQueueResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkQueueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_queue"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WorkQueueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *WorkQueueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `work_queue` represents a Prefect Cloud Work Queue. " +
+			"Work Queues belong to a Work Pool, and are used to prioritize and limit the flow runs picked up by workers.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				// We cannot use a CustomType due to a conflict with PlanModifiers; see
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/763
+				// https://github.com/hashicorp/terraform-plugin-framework/issues/754
+				Description: "Work queue ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"work_pool_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the work pool the work queue belongs to",
+				// Work Queues cannot be moved between Work Pools,
+				// so any changes to this attribute will force a replacement.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the work queue",
+				// Work Queue names are the identifier on the API side, so
+				// we do not support modifying this value. Therefore, any changes
+				// to this attribute will force a replacement.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the work queue",
+			},
+			"is_paused": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this work queue is paused",
+				Optional:    true,
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Description: "The maximum number of concurrent flow runs of this work queue. `0` prevents any flow run from starting, while leaving it unset means unlimited. " +
+					"Removing it from the configuration clears the limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The priority of this work queue within its work pool, where 1 is the highest priority. Defaults to the lowest priority in the work pool.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopt_existing": helpers.AdoptExistingAttribute("Work Queue"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
+	}
+}
+
+// copyWorkQueueToModel copies an api.WorkQueue to a WorkQueueResourceModel.
+func copyWorkQueueToModel(_ context.Context, queue *api.WorkQueue, model *WorkQueueResourceModel) diag.Diagnostics {
+	model.ID = types.StringValue(queue.ID.String())
+	model.Created = customtypes.NewTimestampPointerValue(queue.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(queue.Updated)
+
+	model.Name = types.StringValue(queue.Name)
+	model.Description = types.StringPointerValue(queue.Description)
+	model.IsPaused = types.BoolValue(queue.IsPaused)
+	model.ConcurrencyLimit = types.Int64PointerValue(queue.ConcurrencyLimit)
+	model.Priority = types.Int64PointerValue(queue.Priority)
+
+	return nil
+}
+
+// workQueueMismatches returns the attributes of an existing api.WorkQueue
+// that differ from the configuration, preventing it from being adopted.
+func workQueueMismatches(queue *api.WorkQueue, model *WorkQueueResourceModel) []string {
+	mismatches := []string{}
+
+	if !reflect.DeepEqual(queue.Description, model.Description.ValueStringPointer()) {
+		mismatches = append(mismatches, "description")
+	}
+
+	if queue.IsPaused != model.IsPaused.ValueBool() {
+		mismatches = append(mismatches, "is_paused")
+	}
+
+	if !reflect.DeepEqual(queue.ConcurrencyLimit, model.ConcurrencyLimit.ValueInt64Pointer()) {
+		mismatches = append(mismatches, "concurrency_limit")
+	}
+
+	// The priority is assigned by the server when it is not configured.
+	if !model.Priority.IsNull() && !reflect.DeepEqual(queue.Priority, model.Priority.ValueInt64Pointer()) {
+		mismatches = append(mismatches, "priority")
+	}
+
+	return mismatches
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WorkQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model WorkQueueResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Queue", "create", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	queue, err := client.Create(ctx, api.WorkQueueCreate{
+		Name:             model.Name.ValueString(),
+		Description:      model.Description.ValueStringPointer(),
+		IsPaused:         model.IsPaused.ValueBool(),
+		ConcurrencyLimit: model.ConcurrencyLimit.ValueInt64Pointer(),
+		Priority:         model.Priority.ValueInt64Pointer(),
+	})
+
+	switch {
+	case errors.Is(err, api.ErrAlreadyExists) && model.AdoptExisting.ValueBool():
+		// Another pipeline may have created the same work queue concurrently,
+		// in which case we'll adopt it if it is configured the same way.
+		queue, err = helpers.GetAfterConflict(ctx, func(ctx context.Context) (*api.WorkQueue, error) {
+			return client.Get(ctx, model.Name.ValueString())
+		})
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "get", err))
+
+			return
+		}
+
+		if mismatches := workQueueMismatches(queue, &model); len(mismatches) > 0 {
+			resp.Diagnostics.Append(helpers.AlreadyExistsDiagnostic("Work Queue", model.Name.ValueString(), mismatches))
+
+			return
+		}
+
+	case errors.Is(err, api.ErrAlreadyExists):
+		resp.Diagnostics.Append(helpers.AlreadyExistsDiagnostic("Work Queue", model.Name.ValueString(), nil))
+
+		return
+
+	case err != nil:
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "create", err))
+
+		return
+	}
+
+	// The model is populated from the configuration, where the default is not applied.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+
+	resp.Diagnostics.Append(copyWorkQueueToModel(ctx, queue, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WorkQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model WorkQueueResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Queue", "read", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	queue, err := client.Get(ctx, model.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "get", err))
+
+		return
+	}
+
+	// Imported resources have no adopt_existing value, so we fall back to the default.
+	model.AdoptExisting = types.BoolValue(model.AdoptExisting.ValueBool())
+
+	resp.Diagnostics.Append(copyWorkQueueToModel(ctx, queue, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WorkQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model WorkQueueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Queue", "update", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	err = client.Update(ctx, model.Name.ValueString(), api.WorkQueueUpdate{
+		Description:      model.Description.ValueStringPointer(),
+		IsPaused:         model.IsPaused.ValueBoolPointer(),
+		ConcurrencyLimit: model.ConcurrencyLimit.ValueInt64Pointer(),
+		Priority:         model.Priority.ValueInt64Pointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "update", err))
+
+		return
+	}
+
+	queue, err := client.Get(ctx, model.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyWorkQueueToModel(ctx, queue, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WorkQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model WorkQueueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Work Queue", "delete", &resp.Diagnostics)
+	defer done()
+
+	client, err := r.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, model.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Queue", "delete", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *WorkQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/work_pool_name/name"
+	// - "workspace_id,work_pool_name/name"
+	// - "work_pool_name/name"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "work_pool_name", "name") {
+		return
+	}
+
+	maxInputCount := 2
+	identifier := req.ID
+	inputParts := strings.Split(identifier, ",")
+
+	if len(inputParts) > maxInputCount {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a maximum of 2 import identifiers, in the form of `workspace_id,work_pool_name/name`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	if len(inputParts) == maxInputCount {
+		if inputParts[0] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected non-empty import identifiers, in the form of `workspace_id,work_pool_name/name`. Got %q", req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), inputParts[0])...)
+		identifier = inputParts[1]
+	}
+
+	workPoolName, name, found := strings.Cut(identifier, "/")
+	if !found || workPoolName == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the form of `account_id/workspace_id/work_pool_name/name` or `work_pool_name/name`. Got %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("work_pool_name"), workPoolName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,285 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkQueueCreate(poolName string, name string, priority int64, paused bool) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_work_queue" "test" {
+	name = "%s"
+	work_pool_name = prefect_work_pool.test.name
+	workspace_id = data.prefect_workspace.evergreen.id
+	priority = %d
+	is_paused = %t
+}
+`, poolName, name, priority, paused)
+}
+
+func fixtureAccWorkQueueConcurrencyLimit(poolName string, name string, concurrencyLimit string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_work_queue" "test" {
+	name = "%s"
+	work_pool_name = prefect_work_pool.test.name
+	workspace_id = data.prefect_workspace.evergreen.id
+	priority = 1
+	is_paused = true
+	concurrency_limit = %s
+}
+`, poolName, name, concurrencyLimit)
+}
+
+func fixtureAccWorkQueueWithTimeouts(poolName string, name string, createTimeout string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_work_pool" "test" {
+	name = "%s"
+	type = "kubernetes"
+	workspace_id = data.prefect_workspace.evergreen.id
+}
+resource "prefect_work_queue" "test" {
+	name = "%s"
+	work_pool_name = prefect_work_pool.test.name
+	workspace_id = data.prefect_workspace.evergreen.id
+	priority = 1
+	is_paused = true
+	timeouts {
+		create = "%s"
+		update = "2m"
+	}
+}
+`, poolName, name, createTimeout)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_queue(t *testing.T) {
+	resourceName := "prefect_work_queue.test"
+	workspaceDatsourceName := "data.prefect_workspace.evergreen"
+	randomPoolName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var workQueue api.WorkQueue
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the work queue resource
+				Config: fixtureAccWorkQueueCreate(randomPoolName, randomName, 1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueExists(resourceName, workspaceDatsourceName, &workQueue),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "work_pool_name", randomPoolName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "false"),
+				),
+			},
+			{
+				// Check that changing the paused state will update the resource in place
+				Config: fixtureAccWorkQueueCreate(randomPoolName, randomName, 1, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueIDUnchanged(resourceName, &workQueue),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+				),
+			},
+			// Import State checks - import by workspace_id,work_pool_name/name (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getWorkQueueImportStateID(resourceName, workspaceDatsourceName),
+				ImportStateVerify: true,
+			},
+			// Import State checks - import by account_id/workspace_id/work_pool_name/name (dynamic)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateIdFunc: getWorkQueueCompositeImportStateID(resourceName, workspaceDatsourceName),
+				ImportStateVerify: true,
+			},
+			{
+				// Check that an invalid composite import ID is rejected with the expected format
+				ImportState:   true,
+				ResourceName:  resourceName,
+				ImportStateId: uuid.NewString() + "/not-a-uuid/pool/queue",
+				ExpectError:   regexp.MustCompile("Expected workspace_id to be a UUID"),
+			},
+			{
+				// Check that an invalid timeout is rejected at plan time
+				Config:      fixtureAccWorkQueueWithTimeouts(randomPoolName, randomName, "soon"),
+				ExpectError: regexp.MustCompile("must be a positive duration"),
+			},
+			{
+				// Check that configuring timeouts will update the resource in place
+				Config: fixtureAccWorkQueueWithTimeouts(randomPoolName, randomName, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueIDUnchanged(resourceName, &workQueue),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "10m"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.update", "2m"),
+				),
+			},
+			{
+				// Check setting a concurrency limit
+				Config: fixtureAccWorkQueueConcurrencyLimit(randomPoolName, randomName, "5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueExists(resourceName, workspaceDatsourceName, &workQueue),
+					testAccCheckWorkQueueConcurrencyLimit(&workQueue, int64Pointer(5)),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+				),
+			},
+			{
+				// Check that a concurrency limit of 0 is kept, rather than cleared
+				Config: fixtureAccWorkQueueConcurrencyLimit(randomPoolName, randomName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueExists(resourceName, workspaceDatsourceName, &workQueue),
+					testAccCheckWorkQueueConcurrencyLimit(&workQueue, int64Pointer(0)),
+					resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "0"),
+				),
+			},
+			{
+				// Check setting the concurrency limit again, before clearing it
+				Config: fixtureAccWorkQueueConcurrencyLimit(randomPoolName, randomName, "5"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "concurrency_limit", "5"),
+			},
+			{
+				// Check that a null concurrency limit clears the limit
+				Config: fixtureAccWorkQueueConcurrencyLimit(randomPoolName, randomName, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkQueueExists(resourceName, workspaceDatsourceName, &workQueue),
+					testAccCheckWorkQueueConcurrencyLimit(&workQueue, nil),
+					resource.TestCheckNoResourceAttr(resourceName, "concurrency_limit"),
+				),
+			},
+		},
+	})
+}
+
+// int64Pointer returns a pointer to value.
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func testAccCheckWorkQueueConcurrencyLimit(fetchedWorkQueue *api.WorkQueue, expected *int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		switch {
+		case expected == nil && fetchedWorkQueue.ConcurrencyLimit != nil:
+			return fmt.Errorf("Expected work queue concurrency limit to be cleared, got %d", *fetchedWorkQueue.ConcurrencyLimit)
+		case expected != nil && fetchedWorkQueue.ConcurrencyLimit == nil:
+			return fmt.Errorf("Expected work queue concurrency limit to be %d, got null", *expected)
+		case expected != nil && *fetchedWorkQueue.ConcurrencyLimit != *expected:
+			return fmt.Errorf("Expected work queue concurrency limit to be %d, got %d", *expected, *fetchedWorkQueue.ConcurrencyLimit)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkQueueExists(workQueueResourceName string, workspaceDatasourceName string, workQueue *api.WorkQueue) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		workQueueResource, exists := state.RootModule().Resources[workQueueResourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workQueueResourceName)
+		}
+
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatasourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", workspaceDatasourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		workPoolName := workQueueResource.Primary.Attributes["work_pool_name"]
+		workQueuesClient, _ := c.WorkQueues(uuid.Nil, workspaceID, workPoolName)
+
+		workQueueName := workQueueResource.Primary.Attributes["name"]
+
+		fetchedWorkQueue, err := workQueuesClient.Get(context.Background(), workQueueName)
+		if err != nil {
+			return fmt.Errorf("Error fetching work queue: %w", err)
+		}
+
+		*workQueue = *fetchedWorkQueue
+
+		return nil
+	}
+}
+
+func testAccCheckWorkQueueIDUnchanged(resourceName string, fetchedWorkQueue *api.WorkQueue) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		workQueueResource, exists := state.RootModule().Resources[resourceName]
+		if !exists {
+			return fmt.Errorf("Resource not found in state: %s", resourceName)
+		}
+
+		if workQueueResource.Primary.ID != fetchedWorkQueue.ID.String() {
+			return fmt.Errorf("Expected %s and %s to be equal", workQueueResource.Primary.ID, fetchedWorkQueue.ID)
+		}
+
+		return nil
+	}
+}
+
+func getWorkQueueImportStateID(workQueueResourceName string, workspaceDatsourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceDatsource, exists := state.RootModule().Resources[workspaceDatsourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workspaceDatsourceName)
+		}
+		workspaceID, _ := uuid.Parse(workspaceDatsource.Primary.ID)
+
+		workQueueResource, exists := state.RootModule().Resources[workQueueResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", workQueueResourceName)
+		}
+		workPoolName := workQueueResource.Primary.Attributes["work_pool_name"]
+		workQueueName := workQueueResource.Primary.Attributes["name"]
+
+		return fmt.Sprintf("%s,%s/%s", workspaceID, workPoolName, workQueueName), nil
+	}
+}
+
+func getWorkQueueCompositeImportStateID(workQueueResourceName string, workspaceDatsourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		importStateID, err := getWorkQueueImportStateID(workQueueResourceName, workspaceDatsourceName)(state)
+		if err != nil {
+			return "", err
+		}
+
+		workspaceID, workQueueID, _ := strings.Cut(importStateID, ",")
+
+		return fmt.Sprintf("%s/%s/%s", os.Getenv("PREFECT_CLOUD_ACCOUNT_ID"), workspaceID, workQueueID), nil
+	}
+}
@@ -2,20 +2,31 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var (
@@ -23,6 +34,9 @@ var (
 	_ = resource.ResourceWithImportState(&WorkspaceResource{})
 )
 
+// workspaceHandleRegex matches the characters Prefect allows in a workspace handle.
+var workspaceHandleRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
 // WorkspaceResource contains state for the resource.
 type WorkspaceResource struct {
 	client api.PrefectClient
@@ -38,6 +52,10 @@ type WorkspaceResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Handle      types.String `tfsdk:"handle"`
 	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Force       types.Bool   `tfsdk:"force"`
+
+	Timeouts *helpers.TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // NewWorkspaceResource returns a new WorkspaceResource.
@@ -73,6 +91,8 @@ func (r *WorkspaceResource) Configure(_ context.Context, req resource.ConfigureR
 
 // Schema defines the schema for the resource.
 func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultEmptyTagSet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
 	resp.Schema = schema.Schema{
 		// Description: "Resource representing a Prefect Workspace",
 		Description: "The resource `workspace` represents a Prefect Cloud Workspace. " +
@@ -94,14 +114,21 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
 				Optional:    true,
 			},
@@ -112,25 +139,64 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"handle": schema.StringAttribute{
 				Description: "Unique handle for the workspace",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						workspaceHandleRegex,
+						"must contain only lowercase alphanumeric characters and hyphens",
+					),
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Description for the workspace",
+				Description: "Description for the workspace. Removing it from the configuration clears the description, while an empty string sets it to an empty description.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					workspaceDescriptionPlanModifier{},
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags associated with the workspace",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(defaultEmptyTagSet),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(customvalidators.TagValidator{}),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description: "Whether to delete the deployments of the workspace when destroying it, which otherwise fails while the workspace still has deployments. " +
+					"The value must be applied before the destroy to take effect.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(),
+		},
 	}
 }
 
 // copyWorkspaceToModel copies an api.Workspace to a WorkspaceResourceModel.
-func copyWorkspaceToModel(_ context.Context, workspace *api.Workspace, model *WorkspaceResourceModel) diag.Diagnostics {
+// A description left null in the model is kept null when the server returns
+// an empty one, as it does for workspaces created or cleared without one.
+func copyWorkspaceToModel(ctx context.Context, workspace *api.Workspace, model *WorkspaceResourceModel) diag.Diagnostics {
 	model.ID = types.StringValue(workspace.ID.String())
 	model.Created = customtypes.NewTimestampPointerValue(workspace.Created)
 	model.Updated = customtypes.NewTimestampPointerValue(workspace.Updated)
 
 	model.Name = types.StringValue(workspace.Name)
 	model.Handle = types.StringValue(workspace.Handle)
-	model.Description = types.StringValue(*workspace.Description)
+	if !model.Description.IsNull() || workspace.Description == nil || *workspace.Description != "" {
+		model.Description = types.StringPointerValue(workspace.Description)
+	}
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, workspace.Tags)
+	if diags.HasError() {
+		return diags
+	}
+	model.Tags = tags
 
 	return nil
 }
@@ -145,18 +211,30 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Workspace", "create", &resp.Diagnostics)
+	defer done()
+
+	var tags []string
+	resp.Diagnostics.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	client, err := r.client.Workspaces(model.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workspace client",
 			fmt.Sprintf("Could not create workspace client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
 		)
+
+		return
 	}
 
 	workspace, err := client.Create(ctx, api.WorkspaceCreate{
 		Name:        model.Name.ValueString(),
 		Handle:      model.Handle.ValueString(),
 		Description: model.Description.ValueStringPointer(),
+		Tags:        tags,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -172,6 +250,11 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// The configuration holds no value when force is left to its default.
+	if model.Force.IsNull() {
+		model.Force = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -188,6 +271,9 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Workspace", "read", &resp.Diagnostics)
+	defer done()
+
 	if model.ID.IsNull() && model.Handle.IsNull() {
 		resp.Diagnostics.AddError(
 			"Both ID and Handle are unset",
@@ -203,6 +289,8 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 			"Error creating workspace client",
 			fmt.Sprintf("Could not create workspace client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
 		)
+
+		return
 	}
 
 	// A workspace can be imported + read by either ID or Handle
@@ -223,21 +311,16 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 		workspace, err = client.Get(ctx, workspaceID)
 	} else if !model.Handle.IsNull() {
-		var workspaces []*api.Workspace
-		workspaces, err = client.List(ctx, []string{model.Handle.ValueString()})
+		workspace, err = client.GetByHandle(ctx, model.Handle.ValueString())
+	}
 
-		// The error from the API call should take precedence
-		// followed by this custom error if a specific workspace is not returned
-		if err == nil && len(workspaces) != 1 {
-			err = fmt.Errorf("a workspace with the handle=%s could not be found", model.Handle.ValueString())
-		}
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
 
-		if len(workspaces) == 1 {
-			workspace = workspaces[0]
+			return
 		}
-	}
 
-	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error refreshing Workspace state",
 			fmt.Sprintf("Could not read Workspace, unexpected error: %s", err.Error()),
@@ -251,6 +334,12 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Imported workspaces, and those created before force was
+	// introduced, hold no value for it yet.
+	if model.Force.IsNull() {
+		model.Force = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -259,19 +348,25 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model WorkspaceResourceModel
+	var model, state WorkspaceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Workspace", "update", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.Workspaces(model.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workspace client",
 			fmt.Sprintf("Could not create workspace client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
 		)
+
+		return
 	}
 
 	workspaceID, err := uuid.Parse(model.ID.ValueString())
@@ -285,11 +380,12 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	payload := api.WorkspaceUpdate{
-		Name:        model.Name.ValueStringPointer(),
-		Handle:      model.Handle.ValueStringPointer(),
-		Description: model.Description.ValueStringPointer(),
+	payload, diags := workspaceUpdatePayload(ctx, &model, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
 	err = client.Update(ctx, workspaceID, payload)
 
 	if err != nil {
@@ -322,6 +418,64 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// workspaceDescriptionPlanModifier plans a description that was removed from
+// the configuration as null, so that it is cleared on the server. Otherwise,
+// as a Computed attribute, the prior description would be kept.
+type workspaceDescriptionPlanModifier struct{}
+
+func (m workspaceDescriptionPlanModifier) Description(_ context.Context) string {
+	return "Clears the description when it is removed from the configuration."
+}
+
+func (m workspaceDescriptionPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m workspaceDescriptionPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// The server computes the description on creation.
+	if req.State.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// An empty description computed on creation is already stored as null,
+	// so only a configured description, even an empty one, is planned to change.
+	resp.PlanValue = types.StringNull()
+}
+
+// workspaceUpdatePayload returns the PATCH payload for the attributes that
+// differ between the plan and the state, so that fields managed outside of
+// Terraform, or changed concurrently, are not overwritten.
+// Unknown attributes are left for the server to compute.
+func workspaceUpdatePayload(ctx context.Context, plan *WorkspaceResourceModel, state *WorkspaceResourceModel) (api.WorkspaceUpdate, diag.Diagnostics) {
+	var payload api.WorkspaceUpdate
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		payload.Name = plan.Name.ValueStringPointer()
+	}
+
+	if !plan.Handle.IsUnknown() && !plan.Handle.Equal(state.Handle) {
+		payload.Handle = plan.Handle.ValueStringPointer()
+	}
+
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		payload.Description = plan.Description.ValueStringPointer()
+		payload.ClearDescription = plan.Description.IsNull()
+	}
+
+	if !plan.Tags.IsUnknown() && !plan.Tags.Equal(state.Tags) {
+		// The full set of tags is sent whenever they change,
+		// so tags removed from the configuration are removed on the server.
+		tags := []string{}
+		if diags := plan.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return payload, diags
+		}
+
+		payload.Tags = &tags
+	}
+
+	return payload, nil
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var model WorkspaceResourceModel
@@ -331,12 +485,17 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, done := helpers.WithOperationTimeout(ctx, model.Timeouts, "Workspace", "delete", &resp.Diagnostics)
+	defer done()
+
 	client, err := r.client.Workspaces(model.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workspace client",
 			fmt.Sprintf("Could not create workspace client, unexpected error: %s. This is a bug in the provider, please report this to the maintainers.", err.Error()),
 		)
+
+		return
 	}
 
 	workspaceID, err := uuid.Parse(model.ID.ValueString())
@@ -350,8 +509,28 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if model.Force.ValueBool() {
+		if err := r.deleteDeployments(ctx, model.AccountID.ValueUUID(), workspaceID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting Workspace",
+				fmt.Sprintf("Could not delete the deployments of the Workspace before deleting it, unexpected error: %s", err),
+			)
+
+			return
+		}
+	}
+
 	err = client.Delete(ctx, workspaceID)
 	if err != nil {
+		if errors.Is(err, api.ErrHasDependents) {
+			resp.Diagnostics.AddError(
+				"Workspace has dependent objects",
+				r.dependentsDetail(ctx, model.AccountID.ValueUUID(), workspaceID, err),
+			)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error deleting Workspace",
 			fmt.Sprintf("Could not delete Workspace, unexpected error: %s", err),
@@ -366,12 +545,110 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// deleteDeployments deletes every deployment in the workspace,
+// so that the workspace itself can be deleted.
+func (r *WorkspaceResource) deleteDeployments(ctx context.Context, accountID uuid.UUID, workspaceID uuid.UUID) error {
+	client, err := r.client.Deployments(accountID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("could not create deployments client: %w", err)
+	}
+
+	deployments, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list deployments: %w", err)
+	}
+
+	for _, deployment := range deployments {
+		if err := client.Delete(ctx, deployment.ID); err != nil {
+			return fmt.Errorf("could not delete deployment %s (%s): %w", deployment.Name, deployment.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// maxListedDependents is the number of dependent deployments
+// named in the diagnostic when a workspace cannot be deleted.
+const maxListedDependents = 10
+
+// dependentsDetail explains why a workspace could not be deleted,
+// listing the deployments that block its deletion.
+func (r *WorkspaceResource) dependentsDetail(ctx context.Context, accountID uuid.UUID, workspaceID uuid.UUID, deleteErr error) string {
+	detail := fmt.Sprintf("Could not delete Workspace, as objects in it depend on it: %s\n\n", deleteErr)
+
+	var deployments []*api.Deployment
+	client, err := r.client.Deployments(accountID, workspaceID)
+	if err == nil {
+		deployments, err = client.List(ctx)
+	}
+
+	switch {
+	case err != nil:
+		detail += fmt.Sprintf("The dependent deployments could not be listed: %s\n\n", err)
+	case len(deployments) > 0:
+		detail += fmt.Sprintf("The Workspace still has %d deployment(s):\n", len(deployments))
+		for i, deployment := range deployments {
+			if i == maxListedDependents {
+				detail += fmt.Sprintf("- ... and %d more\n", len(deployments)-maxListedDependents)
+
+				break
+			}
+
+			detail += fmt.Sprintf("- %s (%s)\n", deployment.Name, deployment.ID)
+		}
+		detail += "\n"
+	}
+
+	return detail + "Delete the dependent objects first, or set force = true and apply it before destroying the Workspace to delete its deployments along with it."
+}
+
 // ImportState imports the resource into Terraform state.
 func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if strings.HasPrefix(req.ID, "handle/") {
-		handle := strings.TrimPrefix(req.ID, "handle/")
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("handle"), handle)...)
-	} else {
+	// we'll allow input values in the form of:
+	// - "<workspace_id>"
+	// - "handle:<handle>"
+	// - "handle/<handle>", kept for backwards compatibility
+	handle, isHandle := strings.CutPrefix(req.ID, "handle:")
+	if !isHandle {
+		handle, isHandle = strings.CutPrefix(req.ID, "handle/")
+	}
+
+	if !isHandle {
+		if _, err := uuid.Parse(req.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing Workspace ID",
+				fmt.Sprintf("Could not parse workspace ID to UUID, expected a workspace UUID or handle:<handle>, got: %s", req.ID),
+			)
+
+			return
+		}
+
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+
+		return
+	}
+
+	// Resolve the handle to the workspace's ID, so that the
+	// imported state is keyed by ID like any other workspace.
+	client, err := r.client.Workspaces(uuid.Nil)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace", err))
+
+		return
+	}
+
+	workspace, err := client.GetByHandle(ctx, handle)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.Diagnostics.Append(helpers.NotFoundDiagnostic("Workspace", err))
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace", "get", err))
+
+		return
 	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), workspace.ID.String())...)
 }
@@ -2,9 +2,12 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,11 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/utils"
 )
 
-var _ = resource.ResourceWithConfigure(&WorkspaceAccessResource{})
+var (
+	_ = resource.ResourceWithConfigure(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithConfigValidators(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkspaceAccessResource{})
+	_ = resource.ResourceWithImportState(&WorkspaceAccessResource{})
+)
 
 type WorkspaceAccessResource struct {
 	client api.PrefectClient
@@ -31,6 +40,10 @@ type WorkspaceAccessResourceModel struct {
 	AccessorID      customtypes.UUIDValue `tfsdk:"accessor_id"`
 	WorkspaceRoleID customtypes.UUIDValue `tfsdk:"workspace_role_id"`
 
+	TeamID           customtypes.UUIDValue `tfsdk:"team_id"`
+	UserID           customtypes.UUIDValue `tfsdk:"user_id"`
+	ServiceAccountID customtypes.UUIDValue `tfsdk:"service_account_id"`
+
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 }
@@ -73,6 +86,9 @@ func (r *WorkspaceAccessResource) Schema(_ context.Context, _ resource.SchemaReq
 			"(User, Service Account or Team) with a Workspace Role. This resource specifies an actor's access level " +
 			"to a specific Workspace in the Account.\n" +
 			"\n" +
+			"The accessor can be set either with exactly one of `team_id`, `user_id` or `service_account_id`, " +
+			"or with the `accessor_type` and `accessor_id` attributes. Changing the accessor replaces the grant.\n" +
+			"\n" +
 			"Use this resource in conjunction with the `workspace_role` resource or data source to manage access to Workspaces.",
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
@@ -86,36 +102,123 @@ func (r *WorkspaceAccessResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"accessor_type": schema.StringAttribute{
-				Required:    true,
-				Description: "USER | SERVICE_ACCOUNT | TEAM",
+				Optional:    true,
+				Computed:    true,
+				Description: "USER | SERVICE_ACCOUNT | TEAM. Required with `accessor_id`.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(utils.ServiceAccount, utils.User, utils.Team),
+					stringvalidator.AlsoRequires(path.MatchRoot("accessor_id")),
 				},
 			},
 			"accessor_id": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of accessor to the workspace. This can be an `account_member.user_id`, `service_account.id` or `team.id`. Required with `accessor_type`.",
+				Validators: []validator.String{
+					customvalidators.UUIDValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("accessor_type")),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
 				CustomType:  customtypes.UUIDType{},
-				Description: "ID (UUID) of accessor to the workspace. This can be an `account_member.user_id` or `service_account.id`",
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the Team to grant access to",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the User to grant access to. This corresponds to an `account_member.user_id`",
+			},
+			"service_account_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the Service Account to grant access to",
 			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Account ID (UUID) where the workspace is located",
 			},
 			"workspace_id": schema.StringAttribute{
 				Optional:    true,
+				Computed:    true,
 				CustomType:  customtypes.UUIDType{},
-				Description: "Workspace ID (UUID) to grant access to",
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID) to grant access to, defaults to the workspace set in the provider",
 			},
 			"workspace_role_id": schema.StringAttribute{
 				Required:    true,
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Workspace Role ID (UUID) to grant to accessor",
 			},
 		},
 	}
 }
 
+// ConfigValidators returns the validators applied to the resource configuration.
+func (r *WorkspaceAccessResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("accessor_id"),
+			path.MatchRoot("team_id"),
+			path.MatchRoot("user_id"),
+			path.MatchRoot("service_account_id"),
+		),
+	}
+}
+
+// accessorFromModel returns the accessor type and ID of the model,
+// preferring the dedicated accessor attributes when they are known.
+func accessorFromModel(model *WorkspaceAccessResourceModel) (string, customtypes.UUIDValue) {
+	switch {
+	case !model.TeamID.IsNull() && !model.TeamID.IsUnknown():
+		return utils.Team, model.TeamID
+	case !model.UserID.IsNull() && !model.UserID.IsUnknown():
+		return utils.User, model.UserID
+	case !model.ServiceAccountID.IsNull() && !model.ServiceAccountID.IsUnknown():
+		return utils.ServiceAccount, model.ServiceAccountID
+	default:
+		return model.AccessorType.ValueString(), model.AccessorID
+	}
+}
+
+// ModifyPlan replaces the grant when its accessor changes, as a
+// Workspace Access is specific to a single accessor.
+func (r *WorkspaceAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to replace on creation or deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WorkspaceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedType, plannedID := accessorFromModel(&plan)
+	if plannedID.IsUnknown() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("accessor_id"))
+
+		return
+	}
+
+	currentType, currentID := accessorFromModel(&state)
+	if plannedType != currentType || plannedID.ValueUUID() != currentID.ValueUUID() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("accessor_id"))
+	}
+}
+
 // copyWorkspaceAccessToModel copies the API resource to the Terraform model.
 // Note that api.WorkspaceAccess represents a combined model for all accessor types,
 // meaning accessory-specific attributes like BotID and UserID will be conditionally nil
@@ -125,11 +228,23 @@ func copyWorkspaceAccessToModel(access *api.WorkspaceAccess, model *WorkspaceAcc
 	model.WorkspaceRoleID = customtypes.NewUUIDValue(access.WorkspaceRoleID)
 	model.WorkspaceID = customtypes.NewUUIDValue(access.WorkspaceID)
 
-	if access.BotID != nil {
+	model.TeamID = customtypes.NewUUIDNull()
+	model.UserID = customtypes.NewUUIDNull()
+	model.ServiceAccountID = customtypes.NewUUIDNull()
+
+	switch {
+	case access.BotID != nil:
+		model.AccessorType = types.StringValue(utils.ServiceAccount)
 		model.AccessorID = customtypes.NewUUIDValue(*access.BotID)
-	}
-	if access.UserID != nil {
+		model.ServiceAccountID = model.AccessorID
+	case access.UserID != nil:
+		model.AccessorType = types.StringValue(utils.User)
 		model.AccessorID = customtypes.NewUUIDValue(*access.UserID)
+		model.UserID = model.AccessorID
+	case access.TeamID != nil:
+		model.AccessorType = types.StringValue(utils.Team)
+		model.AccessorID = customtypes.NewUUIDValue(*access.TeamID)
+		model.TeamID = model.AccessorID
 	}
 }
 
@@ -150,9 +265,9 @@ func (r *WorkspaceAccessResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	accessorType := config.AccessorType.ValueString()
+	accessorType, accessorID := accessorFromModel(&config)
 
-	workspaceAccess, err := client.Upsert(ctx, accessorType, config.AccessorID.ValueUUID(), config.WorkspaceRoleID.ValueUUID())
+	workspaceAccess, err := client.Upsert(ctx, accessorType, accessorID.ValueUUID(), config.WorkspaceRoleID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "create", err))
 
@@ -191,12 +306,20 @@ func (r *WorkspaceAccessResource) Read(ctx context.Context, req resource.ReadReq
 			"Error parsing Workspace Role ID",
 			fmt.Sprintf("Could not parse Workspace Access ID to UUID, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
 	accessorType := state.AccessorType.ValueString()
 
 	workspaceAccess, err := client.Get(ctx, accessorType, accessID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "read", err))
 
 		return
@@ -226,9 +349,9 @@ func (r *WorkspaceAccessResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	accessorType := plan.AccessorType.ValueString()
+	accessorType, accessorID := accessorFromModel(&plan)
 
-	workspaceAccess, err := client.Upsert(ctx, accessorType, plan.AccessorID.ValueUUID(), plan.WorkspaceRoleID.ValueUUID())
+	workspaceAccess, err := client.Upsert(ctx, accessorType, accessorID.ValueUUID(), plan.WorkspaceRoleID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "update", err))
 
@@ -283,3 +406,76 @@ func (r *WorkspaceAccessResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// ImportState imports the resource into Terraform state.
+// The import ID is a composite of the workspace ID, accessor type and
+// Workspace Access ID, in the form `workspace_id,accessor_type,id`.
+func (r *WorkspaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/accessor_type/id"
+	// - "workspace_id,accessor_type,id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "accessor_type", "id") {
+		var accessorType types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("accessor_type"), &accessorType)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !isWorkspaceAccessorType(accessorType.ValueString()) {
+			resp.Diagnostics.AddError(
+				"Unexpected Accessor Type",
+				fmt.Sprintf("Expected accessor type to be one of %s, %s or %s, got: %s", utils.User, utils.ServiceAccount, utils.Team, accessorType.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace_id,accessor_type,id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	workspaceID, accessorType, accessID := parts[0], parts[1], parts[2]
+
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace ID",
+			fmt.Sprintf("Could not parse workspace ID to UUID, got: %s", workspaceID),
+		)
+
+		return
+	}
+
+	if !isWorkspaceAccessorType(accessorType) {
+		resp.Diagnostics.AddError(
+			"Unexpected Accessor Type",
+			fmt.Sprintf("Expected accessor type to be one of %s, %s or %s, got: %s", utils.User, utils.ServiceAccount, utils.Team, accessorType),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(accessID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace Access ID",
+			fmt.Sprintf("Could not parse Workspace Access ID to UUID, got: %s", accessID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("accessor_type"), accessorType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessID)...)
+}
+
+// isWorkspaceAccessorType reports whether accessorType is a valid accessor type.
+func isWorkspaceAccessorType(accessorType string) bool {
+	return accessorType == utils.User || accessorType == utils.ServiceAccount || accessorType == utils.Team
+}
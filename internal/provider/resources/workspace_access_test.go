@@ -52,6 +52,24 @@ resource "prefect_workspace_access" "bot_access" {
 }`, botName)
 }
 
+func fixtureAccWorkspaceAccessResourceServiceAccountIDForBot(botName string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace_role" "runner" {
+	name = "Runner"
+}
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_service_account" "bot" {
+	name = "%s"
+}
+resource "prefect_workspace_access" "bot_access" {
+	service_account_id = prefect_service_account.bot.id
+	workspace_id = data.prefect_workspace.evergreen.id
+	workspace_role_id = data.prefect_workspace_role.runner.id
+}`, botName)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_bot_workspace_access(t *testing.T) {
 	accessResourceName := "prefect_workspace_access.bot_access"
@@ -92,10 +110,42 @@ func TestAccResource_bot_workspace_access(t *testing.T) {
 					resource.TestCheckResourceAttrPair(accessResourceName, "workspace_role_id", runnerRoleDatsourceName, "id"),
 				),
 			},
+			{
+				Config: fixtureAccWorkspaceAccessResourceServiceAccountIDForBot(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Check that switching to the dedicated accessor attribute keeps the same grant
+					testAccCheckWorkspaceAccessExists(accessResourceName, workspaceDatsourceName, utils.ServiceAccount, &workspaceAccess),
+					testAccCheckWorkspaceAccessValuesForBot(&workspaceAccess, botResourceName, runnerRoleDatsourceName),
+					resource.TestCheckResourceAttrPair(accessResourceName, "service_account_id", botResourceName, "id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "accessor_id", botResourceName, "id"),
+					resource.TestCheckResourceAttr(accessResourceName, "accessor_type", utils.ServiceAccount),
+				),
+			},
+			// Import State checks - import by workspace_id,accessor_type,id
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getWorkspaceAccessImportStateID(accessResourceName),
+				ResourceName:      accessResourceName,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func getWorkspaceAccessImportStateID(accessResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		workspaceAccessResource, exists := state.RootModule().Resources[accessResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", accessResourceName)
+		}
+
+		workspaceID := workspaceAccessResource.Primary.Attributes["workspace_id"]
+		accessorType := workspaceAccessResource.Primary.Attributes["accessor_type"]
+
+		return fmt.Sprintf("%s,%s,%s", workspaceID, accessorType, workspaceAccessResource.Primary.ID), nil
+	}
+}
+
 func testAccCheckWorkspaceAccessExists(accessResourceName string, workspaceDatasourceName string, accessorType string, access *api.WorkspaceAccess) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workspaceAccessResource, exists := state.RootModule().Resources[accessResourceName]
@@ -2,9 +2,13 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,9 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
 var (
@@ -22,6 +29,9 @@ var (
 	_ = resource.ResourceWithImportState(&WorkspaceRoleResource{})
 )
 
+// workspaceScopeRegex matches the format of Prefect Cloud workspace scopes, e.g. `see_flows`.
+var workspaceScopeRegex = regexp.MustCompile(`^[a-z][a-z_]*$`)
+
 // WorkspaceRoleResource contains state for the resource.
 type WorkspaceRoleResource struct {
 	client api.PrefectClient
@@ -35,7 +45,7 @@ type WorkspaceRoleResourceModel struct {
 
 	Name            types.String          `tfsdk:"name"`
 	Description     types.String          `tfsdk:"description"`
-	Scopes          types.List            `tfsdk:"scopes"`
+	Scopes          types.Set             `tfsdk:"scopes"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
 	InheritedRoleID customtypes.UUIDValue `tfsdk:"inherited_role_id"`
 }
@@ -79,7 +89,10 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 			"an accessor (User or Service Account) to grant access to the Workspace.\n" +
 			"\n" +
 			"To obtain a list of available scopes, please refer to the `GET /api/workspace_scopes` " +
-			"[API](https://app.prefect.cloud/api/docs#tag/Workspace-Scopes/operation/get_workspace_scopes_api_workspace_scopes_get)",
+			"[API](https://app.prefect.cloud/api/docs#tag/Workspace-Scopes/operation/get_workspace_scopes_api_workspace_scopes_get)\n" +
+			"\n" +
+			"The default Workspace Roles (e.g. `Owner`, `Developer` or `Viewer`) are managed by Prefect " +
+			"and are read-only; use the `workspace_role` data source to reference them instead.",
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -95,11 +108,17 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"updated": schema.StringAttribute{
 				Computed:    true,
 				CustomType:  customtypes.TimestampType{},
 				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					helpers.EquivalentTimestamp(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -111,10 +130,18 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Description of the Workspace Role",
 				Default:     stringdefault.StaticString(""),
 			},
-			"scopes": schema.ListAttribute{
-				Description: "List of scopes linked to the Workspace Role",
+			"scopes": schema.SetAttribute{
+				Description: "Set of scopes linked to the Workspace Role",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							workspaceScopeRegex,
+							"must be a non-empty scope name containing only lowercase letters and underscores, e.g. `see_flows`",
+						),
+					),
+				},
 			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
@@ -123,6 +150,7 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 			},
 			"inherited_role_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
 				Description: "Workspace Role ID (UUID), whose permissions are inherited by this Workspace Role",
 				Optional:    true,
 			},
@@ -150,7 +178,7 @@ func copyWorkspaceRoleToModel(_ context.Context, role *api.WorkspaceRole, model
 	// which will include any children scopes on the Prefect Cloud side.
 
 	//nolint:gocritic
-	// scopes, diags := types.ListValueFrom(ctx, types.StringType, role.Scopes)
+	// scopes, diags := types.SetValueFrom(ctx, types.StringType, role.Scopes)
 	// if diags.HasError() {
 	// 	return diags
 	// }
@@ -159,6 +187,19 @@ func copyWorkspaceRoleToModel(_ context.Context, role *api.WorkspaceRole, model
 	return nil
 }
 
+// systemWorkspaceRoleDiagnostic returns an error diagnostic for when a
+// practitioner attempts to manage one of the default Workspace Roles.
+//
+//nolint:ireturn // required by Terraform API
+func systemWorkspaceRoleDiagnostic(name string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("name"),
+		"Cannot manage a system Workspace Role",
+		fmt.Sprintf("The Workspace Role %q is a default role managed by Prefect, and is read-only. ", name)+
+			"Use the `prefect_workspace_role` data source to reference it, or choose a different name for a custom role.",
+	)
+}
+
 func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model WorkspaceRoleResourceModel
 
@@ -184,6 +225,26 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Default Workspace Roles have no Account ID, and cannot be managed by the provider,
+	// so we'll reject a role that collides with one of them.
+	existingRoles, err := client.List(ctx, []string{model.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching Workspace Roles",
+			fmt.Sprintf("Could not fetch Workspace Roles, unexpected error: %s", err),
+		)
+
+		return
+	}
+
+	for _, existingRole := range existingRoles {
+		if existingRole.AccountID == nil {
+			resp.Diagnostics.Append(systemWorkspaceRoleDiagnostic(existingRole.Name))
+
+			return
+		}
+	}
+
 	role, err := client.Create(ctx, api.WorkspaceRoleUpsert{
 		Name:            model.Name.ValueString(),
 		Description:     model.Description.ValueString(),
@@ -237,10 +298,18 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 			"Error parsing Workspace Role ID",
 			fmt.Sprintf("Could not parse Workspace Role ID to UUID, unexpected error: %s", err.Error()),
 		)
+
+		return
 	}
 
 	role, err := client.Get(ctx, roleID)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error refreshing Workspace Role state",
 			fmt.Sprintf("Could not read Workspace Role, unexpected error: %s", err),
@@ -249,6 +318,13 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// This can only happen when importing one of the default Workspace Roles.
+	if role.AccountID == nil {
+		resp.Diagnostics.Append(systemWorkspaceRoleDiagnostic(role.Name))
+
+		return
+	}
+
 	resp.Diagnostics.Append(copyWorkspaceRoleToModel(ctx, role, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -380,6 +456,15 @@ func (r *WorkspaceRoleResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState allows Terraform to start managing a Workspace Role resource.
 func (r *WorkspaceRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace Role ID",
+			fmt.Sprintf("Could not parse Workspace Role ID to UUID, expected a Workspace Role UUID, got: %s", req.ID),
+		)
+
+		return
+	}
+
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -33,6 +34,22 @@ resource "prefect_workspace_role" "role" {
 }`, name, name)
 }
 
+func fixtureAccWorkspaceRoleResourceSystemRole() string {
+	return `
+resource "prefect_workspace_role" "role" {
+	name = "Developer"
+	scopes = ["see_blocks"]
+}`
+}
+
+func fixtureAccWorkspaceRoleResourceInvalidScope(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace_role" "role" {
+	name = "%s"
+	scopes = ["see_blocks", ""]
+}`, name)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_workspace_role(t *testing.T) {
 	resourceName := "prefect_workspace_role.role"
@@ -46,6 +63,16 @@ func TestAccResource_workspace_role(t *testing.T) {
 		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
 		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
 		Steps: []resource.TestStep{
+			{
+				// Check that the default workspace roles cannot be managed
+				Config:      fixtureAccWorkspaceRoleResourceSystemRole(),
+				ExpectError: regexp.MustCompile("Cannot manage a system Workspace Role"),
+			},
+			{
+				// Check that scopes are validated
+				Config:      fixtureAccWorkspaceRoleResourceInvalidScope(randomName),
+				ExpectError: regexp.MustCompile("must be a non-empty scope name"),
+			},
 			{
 				// Check creation + existence of the workspace role resource
 				Config: fixtureAccWorkspaceRoleResource(randomName),
@@ -55,8 +82,8 @@ func TestAccResource_workspace_role(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", randomName),
 					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("%s description", randomName)),
 					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "scopes.0", "see_blocks"),
-					resource.TestCheckResourceAttr(resourceName, "scopes.1", "see_artifacts"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "see_blocks"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "see_artifacts"),
 				),
 			},
 			{
@@ -68,9 +95,9 @@ func TestAccResource_workspace_role(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", randomName),
 					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("description for %s", randomName)),
 					resource.TestCheckResourceAttr(resourceName, "scopes.#", "3"),
-					resource.TestCheckResourceAttr(resourceName, "scopes.0", "see_workers"),
-					resource.TestCheckResourceAttr(resourceName, "scopes.1", "see_variables"),
-					resource.TestCheckResourceAttr(resourceName, "scopes.2", "see_work_queues"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "see_workers"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "see_variables"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "see_work_queues"),
 				),
 			},
 			// Import State checks - import by ID (default)
@@ -0,0 +1,399 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customvalidators"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/utils"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WorkspaceServiceAccountAccessResource{})
+	_ = resource.ResourceWithImportState(&WorkspaceServiceAccountAccessResource{})
+)
+
+type WorkspaceServiceAccountAccessResource struct {
+	client api.PrefectClient
+}
+
+type WorkspaceServiceAccountAccessResourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	ServiceAccountID customtypes.UUIDValue `tfsdk:"service_account_id"`
+	ActorID          customtypes.UUIDValue `tfsdk:"actor_id"`
+	WorkspaceRoleID  customtypes.UUIDValue `tfsdk:"workspace_role_id"`
+
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+}
+
+// NewWorkspaceServiceAccountAccessResource returns a new WorkspaceServiceAccountAccessResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkspaceServiceAccountAccessResource() resource.Resource {
+	return &WorkspaceServiceAccountAccessResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkspaceServiceAccountAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_service_account_access"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WorkspaceServiceAccountAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected api.PrefectClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkspaceServiceAccountAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The resource `workspace_service_account_access` grants a Service Account a Workspace Role " +
+			"on a specific Workspace in the Account.\n" +
+			"\n" +
+			"It is a focused alternative to the `workspace_access` resource for the most common case of giving " +
+			"a Service Account, such as a CI bot, access to a Workspace. The actor of the Service Account is " +
+			"resolved by the provider and exposed as `actor_id`, to grant it access to individual Work Pools " +
+			"or Deployments. Changing the Service Account or the Workspace replaces the grant, while changing " +
+			"the `workspace_role_id` updates it in place.",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workspace Access ID (UUID)",
+				// attributes which are not configurable + should not show updates from the existing state value
+				// should implement `UseStateForUnknown()`
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				Required:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "ID (UUID) of the Service Account to grant access to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actor_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Actor ID (UUID) of the Service Account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_role_id": schema.StringAttribute{
+				Required:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace Role ID (UUID) to grant to the Service Account",
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Account ID (UUID) where the workspace is located",
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Validators:  []validator.String{customvalidators.UUIDValidator{}},
+				Description: "Workspace ID (UUID) to grant access to, defaults to the workspace set in the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// copyWorkspaceServiceAccountAccessToModel copies the API resource to the Terraform model.
+func copyWorkspaceServiceAccountAccessToModel(access *api.WorkspaceAccess, model *WorkspaceServiceAccountAccessResourceModel) {
+	model.ID = types.StringValue(access.ID.String())
+	model.WorkspaceRoleID = customtypes.NewUUIDValue(access.WorkspaceRoleID)
+	model.WorkspaceID = customtypes.NewUUIDValue(access.WorkspaceID)
+
+	if access.BotID != nil {
+		model.ServiceAccountID = customtypes.NewUUIDValue(*access.BotID)
+	}
+
+	if access.ActorID != nil {
+		model.ActorID = customtypes.NewUUIDValue(*access.ActorID)
+	}
+}
+
+// resolveActorID sets the actor of the Service Account of the model,
+// when it was not returned with the Workspace Access.
+func (r *WorkspaceServiceAccountAccessResource) resolveActorID(ctx context.Context, model *WorkspaceServiceAccountAccessResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !model.ActorID.IsNull() && !model.ActorID.IsUnknown() {
+		return diags
+	}
+
+	client, err := r.client.ServiceAccounts(model.AccountID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Service Account", err))
+
+		return diags
+	}
+
+	serviceAccount, err := client.Get(ctx, model.ServiceAccountID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			diags.AddAttributeError(
+				path.Root("service_account_id"),
+				"Service Account not found",
+				fmt.Sprintf("Could not find a Service Account with ID %s", model.ServiceAccountID.ValueString()),
+			)
+
+			return diags
+		}
+
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Service Account", "get", err))
+
+		return diags
+	}
+
+	model.ActorID = customtypes.NewUUIDValue(serviceAccount.ActorID)
+
+	return diags
+}
+
+// Create grants the Workspace Role to the Service Account and inserts it into the State.
+func (r *WorkspaceServiceAccountAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WorkspaceServiceAccountAccessResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolving the actor first reports a missing Service Account
+	// on its attribute, rather than as an error of the grant.
+	resp.Diagnostics.Append(r.resolveActorID(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkspaceAccess(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Access", err))
+
+		return
+	}
+
+	workspaceAccess, err := client.Upsert(ctx, utils.ServiceAccount, plan.ServiceAccountID.ValueUUID(), plan.WorkspaceRoleID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "create", err))
+
+		return
+	}
+
+	copyWorkspaceServiceAccountAccessToModel(workspaceAccess, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WorkspaceServiceAccountAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkspaceServiceAccountAccessResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkspaceAccess(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Access", err))
+
+		return
+	}
+
+	accessID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Workspace Access ID",
+			fmt.Sprintf("Could not parse Workspace Access ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	workspaceAccess, err := client.Get(ctx, utils.ServiceAccount, accessID)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "read", err))
+
+		return
+	}
+
+	copyWorkspaceServiceAccountAccessToModel(workspaceAccess, &state)
+
+	// The actor is not known yet after an import
+	resp.Diagnostics.Append(r.resolveActorID(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WorkspaceServiceAccountAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkspaceServiceAccountAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkspaceAccess(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Access", err))
+
+		return
+	}
+
+	workspaceAccess, err := client.Upsert(ctx, utils.ServiceAccount, plan.ServiceAccountID.ValueUUID(), plan.WorkspaceRoleID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "update", err))
+
+		return
+	}
+
+	copyWorkspaceServiceAccountAccessToModel(workspaceAccess, &plan)
+
+	resp.Diagnostics.Append(r.resolveActorID(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the grant and removes the Terraform state on success.
+func (r *WorkspaceServiceAccountAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkspaceServiceAccountAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.WorkspaceAccess(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Access", err))
+
+		return
+	}
+
+	accessID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error parsing Workspace Access ID",
+			fmt.Sprintf("Could not parse Workspace Access ID to UUID, unexpected error: %s", err.Error()),
+		)
+
+		return
+	}
+
+	err = client.Delete(ctx, utils.ServiceAccount, accessID)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Access", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+// The import ID is a composite of the workspace ID and the
+// Workspace Access ID, in the form `workspace_id,id`.
+func (r *WorkspaceServiceAccountAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// we'll allow input values in the form of:
+	// - "account_id/workspace_id/id"
+	// - "workspace_id,id"
+	if helpers.ImportWorkspaceScopedState(ctx, r.client, req, resp, "id") {
+		return
+	}
+
+	workspaceID, accessID, found := strings.Cut(req.ID, ",")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace_id,id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace ID",
+			fmt.Sprintf("Could not parse workspace ID to UUID, got: %s", workspaceID),
+		)
+
+		return
+	}
+
+	if _, err := uuid.Parse(accessID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing Workspace Access ID",
+			fmt.Sprintf("Could not parse Workspace Access ID to UUID, got: %s", accessID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessID)...)
+}
@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccWorkspaceServiceAccountAccess(botName string, roleName string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace_role" "role" {
+	name = "%s"
+}
+data "prefect_workspace" "evergreen" {
+	handle = "github-ci-tests"
+}
+resource "prefect_service_account" "bot" {
+	name = "%s"
+}
+resource "prefect_workspace_service_account_access" "bot_access" {
+	service_account_id = prefect_service_account.bot.id
+	workspace_id = data.prefect_workspace.evergreen.id
+	workspace_role_id = data.prefect_workspace_role.role.id
+}`, roleName, botName)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_workspace_service_account_access(t *testing.T) {
+	accessResourceName := "prefect_workspace_service_account_access.bot_access"
+	botResourceName := "prefect_service_account.bot"
+	workspaceDatsourceName := "data.prefect_workspace.evergreen"
+	roleDatsourceName := "data.prefect_workspace_role.role"
+
+	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the grant, with the actor of the service account resolved
+				Config: fixtureAccWorkspaceServiceAccountAccess(randomName, "Developer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "service_account_id", botResourceName, "id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "actor_id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "workspace_id", workspaceDatsourceName, "id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "workspace_role_id", roleDatsourceName, "id"),
+				),
+			},
+			{
+				// Check updating the role of the grant in place
+				Config: fixtureAccWorkspaceServiceAccountAccess(randomName, "Runner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accessResourceName, "actor_id", botResourceName, "actor_id"),
+					resource.TestCheckResourceAttrPair(accessResourceName, "workspace_role_id", roleDatsourceName, "id"),
+				),
+			},
+			// Import State checks - import by workspace_id,id
+			{
+				ImportState:       true,
+				ImportStateIdFunc: getWorkspaceServiceAccountAccessImportStateID(accessResourceName),
+				ResourceName:      accessResourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getWorkspaceServiceAccountAccessImportStateID(accessResourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		accessResource, exists := state.RootModule().Resources[accessResourceName]
+		if !exists {
+			return "", fmt.Errorf("Resource not found in state: %s", accessResourceName)
+		}
+
+		attributes := accessResource.Primary.Attributes
+
+		return fmt.Sprintf("%s,%s", attributes["workspace_id"], attributes["id"]), nil
+	}
+}
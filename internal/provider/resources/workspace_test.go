@@ -3,6 +3,8 @@ package resources_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -13,22 +15,40 @@ import (
 	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
 )
 
-func fixtureAccWorkspaceCreate(name string) string {
+func fixtureAccWorkspaceCreate(name string, handle string) string {
 	return fmt.Sprintf(`
 resource "prefect_workspace" "workspace" {
 	name = "%s"
 	handle = "%s"
 }
-`, name, name)
+`, name, handle)
 }
 
-func fixtureAccWorkspaceUpdate(name string, description string) string {
+func fixtureAccWorkspaceUpdate(name string, handle string, description string) string {
 	return fmt.Sprintf(`
 resource "prefect_workspace" "workspace" {
 	name = "%s"
 	handle = "%s"
 	description = "%s"
-}`, name, name, description)
+}`, name, handle, description)
+}
+
+func fixtureAccWorkspaceTags(name string, handle string, description string, tags string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace" "workspace" {
+	name = "%s"
+	handle = "%s"
+	description = "%s"
+	tags = %s
+}`, name, handle, description, tags)
+}
+
+func fixtureAccWorkspaceInvalidHandle(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace" "workspace" {
+	name = "%s"
+	handle = "Invalid_Handle"
+}`, name)
 }
 
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
@@ -36,6 +56,9 @@ func TestAccResource_workspace(t *testing.T) {
 	resourceName := "prefect_workspace.workspace"
 	randomName := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	randomName2 := testutils.TestAccPrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	// Handles only allow lowercase alphanumeric characters and hyphens
+	randomHandle := strings.ReplaceAll(randomName, "_", "-")
+	randomHandle2 := strings.ReplaceAll(randomName2, "_", "-")
 	emptyDescription := ""
 	randomDescription := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
@@ -47,34 +70,97 @@ func TestAccResource_workspace(t *testing.T) {
 		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
 		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
 		Steps: []resource.TestStep{
+			{
+				// Check that an invalid handle is rejected at plan time
+				Config:      fixtureAccWorkspaceInvalidHandle(randomName),
+				ExpectError: regexp.MustCompile("must contain only lowercase alphanumeric characters and hyphens"),
+			},
 			{
 				// Check creation + existence of the workspace resource
-				Config: fixtureAccWorkspaceCreate(randomName),
+				Config: fixtureAccWorkspaceCreate(randomName, randomHandle),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckWorkspaceExists(resourceName, &workspace),
-					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName, Handle: randomName, Description: &emptyDescription}),
+					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName, Handle: randomHandle, Description: &emptyDescription}),
 					resource.TestCheckResourceAttr(resourceName, "name", randomName),
-					resource.TestCheckResourceAttr(resourceName, "handle", randomName),
-					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "handle", randomHandle),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
 				),
 			},
 			{
-				// Check update of existing workspace resource
-				Config: fixtureAccWorkspaceUpdate(randomName2, randomDescription),
+				// Check in-place update of existing workspace resource, including the handle
+				Config: fixtureAccWorkspaceUpdate(randomName2, randomHandle2, randomDescription),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckWorkspaceExists(resourceName, &workspace),
-					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName2, Handle: randomName2, Description: &randomDescription}),
+					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName2, Handle: randomHandle2, Description: &randomDescription}),
 					resource.TestCheckResourceAttr(resourceName, "name", randomName2),
-					resource.TestCheckResourceAttr(resourceName, "handle", randomName2),
+					resource.TestCheckResourceAttr(resourceName, "handle", randomHandle2),
+					resource.TestCheckResourceAttr(resourceName, "description", randomDescription),
+				),
+			},
+			{
+				// Check that an empty description is set, rather than cleared
+				Config: fixtureAccWorkspaceUpdate(randomName2, randomHandle2, emptyDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName2, Handle: randomHandle2, Description: &emptyDescription}),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				// Check that removing an empty description from the configuration clears it
+				Config: fixtureAccWorkspaceCreate(randomName2, randomHandle2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					testAccCheckWorkspaceDescriptionCleared(&workspace),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+				),
+			},
+			{
+				// Check that the description is changed again
+				Config: fixtureAccWorkspaceUpdate(randomName2, randomHandle2, randomDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					testAccCheckWorkspaceValues(&workspace, &api.Workspace{Name: randomName2, Handle: randomHandle2, Description: &randomDescription}),
 					resource.TestCheckResourceAttr(resourceName, "description", randomDescription),
 				),
 			},
+			{
+				// Check that removing the description from the configuration clears it
+				Config: fixtureAccWorkspaceCreate(randomName2, randomHandle2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					testAccCheckWorkspaceDescriptionCleared(&workspace),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+				),
+			},
+			{
+				// Check that tags are added to the workspace
+				Config: fixtureAccWorkspaceTags(randomName2, randomHandle2, randomDescription, `["team:data", "env:ci"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "team:data"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "env:ci"),
+				),
+			},
+			{
+				// Check that tag additions and removals are reconciled on update
+				Config: fixtureAccWorkspaceTags(randomName2, randomHandle2, randomDescription, `["team:data", "owner:platform"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "team:data"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "owner:platform"),
+				),
+			},
 			// Import State checks - import by handle
 			{
 				ImportState:         true,
 				ResourceName:        resourceName,
-				ImportStateId:       randomName2,
-				ImportStateIdPrefix: "handle/",
+				ImportStateId:       randomHandle2,
+				ImportStateIdPrefix: "handle:",
 				ImportStateVerify:   true,
 			},
 			// Import State checks - import by ID (default)
@@ -113,6 +199,16 @@ func testAccCheckWorkspaceExists(workspaceResourceName string, workspace *api.Wo
 	}
 }
 
+func testAccCheckWorkspaceDescriptionCleared(fetchedWorkspace *api.Workspace) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedWorkspace.Description != nil && *fetchedWorkspace.Description != "" {
+			return fmt.Errorf("Expected workspace description to be cleared, got: %s", *fetchedWorkspace.Description)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckWorkspaceValues(fetchedWorkspace *api.Workspace, valuesToCheck *api.Workspace) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if fetchedWorkspace.Name != valuesToCheck.Name {
@@ -0,0 +1,77 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
+)
+
+func workspaceModel(name string, handle string, description string, tags ...string) resources.WorkspaceResourceModel {
+	tagValues := make([]attr.Value, 0, len(tags))
+	for _, tag := range tags {
+		tagValues = append(tagValues, types.StringValue(tag))
+	}
+
+	return resources.WorkspaceResourceModel{
+		Name:        types.StringValue(name),
+		Handle:      types.StringValue(handle),
+		Description: types.StringValue(description),
+		Tags:        types.SetValueMust(types.StringType, tagValues),
+	}
+}
+
+func TestWorkspaceUpdatePayload(t *testing.T) {
+	t.Parallel()
+
+	state := workspaceModel("workspace", "workspace", "old description", "team:data")
+
+	unknownDescription := workspaceModel("workspace", "workspace", "", "team:data")
+	unknownDescription.Description = types.StringUnknown()
+
+	tests := map[string]struct {
+		plan     resources.WorkspaceResourceModel
+		expected string
+	}{
+		"description only": {
+			plan:     workspaceModel("workspace", "workspace", "new description", "team:data"),
+			expected: `{"description":"new description"}`,
+		},
+		"name and handle": {
+			plan:     workspaceModel("renamed", "renamed", "old description", "team:data"),
+			expected: `{"name":"renamed","handle":"renamed"}`,
+		},
+		"tags removed": {
+			plan:     workspaceModel("workspace", "workspace", "old description"),
+			expected: `{"tags":[]}`,
+		},
+		"unknown description": {
+			plan:     unknownDescription,
+			expected: `{}`,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, diags := resources.WorkspaceUpdatePayload(context.Background(), &test.plan, &state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			encoded, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("failed to encode payload: %s", err)
+			}
+
+			if string(encoded) != test.expected {
+				t.Errorf("expected payload %s, got %s", test.expected, encoded)
+			}
+		})
+	}
+}
@@ -10,12 +10,33 @@ import (
 // PrefectProvider implements the Prefect Terraform provider.
 type PrefectProvider struct {
 	client *client.Client
+
+	// version is the provider version, which is set at build time.
+	version string
 }
 
 // PrefectProviderModel maps provider schema data to a Go type.
 type PrefectProviderModel struct {
-	Endpoint    types.String          `tfsdk:"endpoint"`
-	APIKey      types.String          `tfsdk:"api_key"`
-	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
-	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	Endpoint      types.String          `tfsdk:"endpoint"`
+	BasePath      types.String          `tfsdk:"base_path"`
+	APIKey        types.String          `tfsdk:"api_key"`
+	APIKeyFile    types.String          `tfsdk:"api_key_file"`
+	AuthToken     types.String          `tfsdk:"auth_token"`
+	AccountID     customtypes.UUIDValue `tfsdk:"account_id"`
+	AccountHandle types.String          `tfsdk:"account_handle"`
+	WorkspaceID   customtypes.UUIDValue `tfsdk:"workspace_id"`
+	VerifyAccount types.Bool            `tfsdk:"verify_account"`
+
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryBaseDelay    types.String  `tfsdk:"retry_base_delay"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	RequestTimeout    types.String  `tfsdk:"request_timeout"`
+
+	Headers            types.Map    `tfsdk:"headers"`
+	UserAgentSuffix    types.String `tfsdk:"user_agent_suffix"`
+	APIVersion         types.String `tfsdk:"api_version"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	CACertificateFile  types.String `tfsdk:"ca_certificate_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
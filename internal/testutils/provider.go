@@ -22,7 +22,7 @@ const TestAccPrefix = "terraform_acc_"
 // TestAccProvider defines the actual Provider, which is used during acceptance testing.
 // This is the same Provider that is used by the CLI, and is used by
 // custom test functions, primarily to access the underlying HTTP client.
-var TestAccProvider provider.Provider = prefectProvider.New()
+var TestAccProvider provider.Provider = prefectProvider.New("test")
 
 // TestAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
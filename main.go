@@ -11,11 +11,14 @@ import (
 
 const providerAddress = "registry.terraform.io/prefecthq/prefect"
 
+// version is set at build time through ldflags (see .goreleaser.yml).
+var version = "dev"
+
 // Run "go generate" to generate the docs
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --rendered-provider-name Prefect --provider-name prefect
 
 func main() {
-	providerServer := providerserver.NewProtocol6(&provider.PrefectProvider{})
+	providerServer := providerserver.NewProtocol6(provider.New(version))
 
 	err := tf6server.Serve(providerAddress, providerServer)
 	if err != nil {